@@ -1,7 +1,10 @@
 package appfeatures
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 )
 
@@ -33,8 +36,14 @@ type Errors struct {
 
 // FeatureSet holds all supported features and feature related errors.
 type FeatureSet struct {
-	Supported Features
-	Errors    Errors
+	Supported Features `json:"supported" doc:"The supported features."`
+	Errors    Errors   `json:"errors" doc:"The errors of the features that could not be activated."`
+}
+
+// errorData describes the encoded form of a feature-based error.
+type errorData struct {
+	Feature Features `json:"feature"`
+	Error   string   `json:"error"`
 }
 
 // featureMap holds a list of descriptions for each feature.
@@ -79,17 +88,29 @@ func NilFeatureSet() FeatureSet {
 	return FeatureSet{}
 }
 
+// Clone returns a copy of the feature set, which can be modified
+// without affecting the original feature set.
+func (c FeatureSet) Clone() FeatureSet {
+	clone := FeatureSet{Supported: c.Supported}
+
+	for _, e := range c.Errors.errors {
+		clone.Errors.Append(&e)
+	}
+
+	return clone
+}
+
 // Add adds the provided features to the existing features.
-func (c Features) Add(features ...Features) {
+func (c *Features) Add(features ...Features) {
 	for _, f := range features {
-		c |= f
+		*c |= f
 	}
 }
 
 // Remove removes the provided features from the existing features.
-func (c Features) Remove(features ...Features) {
+func (c *Features) Remove(features ...Features) {
 	for _, f := range features {
-		c &= f
+		*c &^= f
 	}
 }
 
@@ -159,11 +180,62 @@ func (c *Errors) Append(e *Error) {
 	c.errors[e.Feature] = *e
 }
 
+// Remove removes the feature errors of the provided features from the feature error list.
+func (c *Errors) Remove(features Features) {
+	for feature := range c.errors {
+		if feature&features != 0 {
+			delete(c.errors, feature)
+		}
+	}
+
+	if len(c.errors) == 0 {
+		c.errors = nil
+	}
+}
+
 // Exists checks and returns all feature based errors.
 func (c *Errors) Exists() (map[Features]Error, bool) {
 	return c.errors, c.errors != nil
 }
 
+// MarshalJSON encodes the feature errors as a list of features
+// and their respective error messages.
+func (c Errors) MarshalJSON() ([]byte, error) {
+	encoded := make([]errorData, 0, len(c.errors))
+
+	for _, e := range c.errors {
+		message := ""
+		if e.FeatureErrors != nil {
+			message = e.FeatureErrors.Error()
+		}
+
+		encoded = append(encoded, errorData{Feature: e.Feature, Error: message})
+	}
+
+	slices.SortFunc(encoded, func(a, b errorData) int {
+		return int(a.Feature) - int(b.Feature)
+	})
+
+	return json.Marshal(encoded)
+}
+
+// UnmarshalJSON decodes a list of features and their respective error messages
+// into the feature errors.
+func (c *Errors) UnmarshalJSON(data []byte) error {
+	var decoded []errorData
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	c.errors = nil
+	for _, e := range decoded {
+		c.Append(NewError(e.Feature, errors.New(e.Error)))
+	}
+
+	return nil
+}
+
 // Error returns a text representation of the feature error.
 func (c *Error) Error() string {
 	return fmt.Sprintf(
@@ -1,9 +1,19 @@
 package bluetooth
 
-import "github.com/google/uuid"
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // Adapter describes a function call interface to invoke adapter related functions.
 type Adapter interface {
+	// WithContext returns a copy of the adapter function call interface, whose
+	// method calls are bound to the provided context. Cancelling the context
+	// aborts any pending method call.
+	WithContext(ctx context.Context) Adapter
+
 	// StartDiscovery will put the adapter into "discovering" mode, which means
 	// the bluetooth device will be able to discover other bluetooth devices
 	// that are in pairing mode.
@@ -13,20 +23,168 @@ type Adapter interface {
 	// no longer be able to discover other bluetooth devices that are in pairing mode.
 	StopDiscovery() error
 
+	// Discover starts a scoped discovery session, and returns a channel which receives
+	// the devices that are found or updated during the discovery. Devices which were known
+	// before the discovery started are only sent after they are seen during the discovery.
+	// The discovery is stopped, and the channel is closed, when the context is done or the
+	// timeout (if any) passes.
+	// Concurrent discovery sessions on the same adapter are reference-counted, so that
+	// the adapter keeps discovering until all discovery sessions have ended.
+	Discover(ctx context.Context, opts DiscoveryOptions) (<-chan DiscoveryEvent, error)
+
+	// SetDiscoveryFilter sets the filter which is applied to the devices that are found
+	// during discovery. The filter is applied to subsequent calls to StartDiscovery(),
+	// and is cleared if an empty filter is provided.
+	SetDiscoveryFilter(filter DiscoveryFilter) error
+
+	// DiscoveryFilters returns the keys of the discovery filter parameters
+	// (for example, "RSSI" and "Transport") that are supported by the system.
+	DiscoveryFilters() ([]string, error)
+
 	// SetPoweredState sets the powered state of the adapter.
 	SetPoweredState(enable bool) error
 
+	// UnblockAndPowerOn removes the soft-block (rfkill) on the adapter if it is
+	// soft-blocked, and then powers on the adapter. An error is returned if the adapter
+	// is hard-blocked, since a hard-block can only be removed via a hardware switch.
+	UnblockAndPowerOn() error
+
 	// SetDiscoverableState sets the discoverable state of the adapter.
 	SetDiscoverableState(enable bool) error
 
 	// SetPairableState sets the pairable state of the adapter.
 	SetPairableState(enable bool) error
 
+	// SetAlias sets the user-assigned name of the adapter.
+	// If an empty alias is provided, the alias is reset to the system-assigned name.
+	SetAlias(alias string) error
+
+	// SetDiscoverableTimeout sets the timeout (in seconds) after which the adapter
+	// is no longer discoverable. A timeout of zero disables the timeout.
+	SetDiscoverableTimeout(timeout uint32) error
+
+	// SetPairableTimeout sets the timeout (in seconds) after which the adapter
+	// is no longer pairable. A timeout of zero disables the timeout.
+	SetPairableTimeout(timeout uint32) error
+
 	// Properties returns all the properties of the adapter.
 	Properties() (AdapterData, error)
 
 	// Devices returns all the devices associated with the adapter
 	Devices() ([]DeviceData, error)
+
+	// ConnectDevice connects to a device with the provided address, without discovering
+	// the device first. The device object is created if it does not exist. The address
+	// type can be AddressTypePublic or AddressTypeRandom to connect to an LE device,
+	// otherwise the device is connected to over BR/EDR.
+	ConnectDevice(address MacAddress, addressType AddressType) error
+
+	// PruneDevices removes the stale devices of the adapter, which are devices that are
+	// not paired, trusted or connected, and which have not been seen for the duration
+	// specified in the options. If a dry-run is requested, the stale devices are only listed.
+	// After the devices are removed, a prune event with the returned result is published.
+	PruneDevices(opts PruneOptions) (PruneResult, error)
+}
+
+// DiscoveryTransport describes the transport type which is used to discover devices.
+type DiscoveryTransport string
+
+// The different discovery transport types.
+const (
+	DiscoveryTransportAuto  DiscoveryTransport = "auto"
+	DiscoveryTransportBREDR DiscoveryTransport = "bredr"
+	DiscoveryTransportLE    DiscoveryTransport = "le"
+)
+
+// DiscoveryFilter holds the parameters to filter the devices which are found during discovery.
+// Parameters which are not set are not applied to the filter.
+type DiscoveryFilter struct {
+	// UUIDs holds the service UUIDs, of which any one must be advertised by a device
+	// for it to be found.
+	UUIDs uuid.UUIDs `json:"uuids,omitempty" codec:"UUIDs,omitempty" doc:"The service UUIDs, of which any one must be advertised by a device for it to be found."`
+
+	// RSSI holds the signal strength threshold, below which devices are not found.
+	// This cannot be set along with Pathloss.
+	RSSI *int16 `json:"rssi,omitempty" codec:"RSSI,omitempty" doc:"The signal strength threshold, below which devices are not found. This cannot be set along with **pathloss**."`
+
+	// Pathloss holds the pathloss threshold, above which devices are not found.
+	// This cannot be set along with RSSI.
+	Pathloss *uint16 `json:"pathloss,omitempty" codec:"Pathloss,omitempty" doc:"The pathloss threshold, above which devices are not found. This cannot be set along with **rssi**."`
+
+	// Transport holds the transport type which is used to discover devices.
+	Transport DiscoveryTransport `json:"transport,omitempty" codec:"Transport,omitempty" enum:"auto,bredr,le" doc:"The transport type which is used to discover devices."`
+
+	// DuplicateData indicates whether device events are sent each time advertisement
+	// data is received from a device, even if the data has not changed.
+	DuplicateData *bool `json:"duplicate_data,omitempty" codec:"DuplicateData,omitempty" doc:"Indicates whether device events are sent each time advertisement data is received from a device, even if the data has not changed."`
+
+	// Discoverable indicates whether the adapter is made discoverable while discovering,
+	// and whether only devices which are in discoverable mode are found.
+	Discoverable *bool `json:"discoverable,omitempty" codec:"Discoverable,omitempty" doc:"Indicates whether the adapter is made discoverable while discovering, and whether only devices which are in discoverable mode are found."`
+
+	// Pattern holds a prefix of the address or the name of a device, which must match
+	// for the device to be found.
+	Pattern string `json:"pattern,omitempty" codec:"Pattern,omitempty" doc:"A prefix of the address or the name of a device, which must match for the device to be found."`
+}
+
+// DiscoveryOptions holds the options for a scoped discovery session.
+type DiscoveryOptions struct {
+	// Timeout holds the duration after which the discovery is stopped.
+	// If it is zero, the discovery runs until its context is done.
+	Timeout time.Duration `json:"timeout,omitempty" doc:"The duration after which the discovery is stopped. If it is zero, the discovery runs until it is cancelled."`
+
+	// Filter holds the discovery filter, which is set on the adapter before the
+	// discovery is started. Note that the filter is shared by all discovery sessions
+	// of the adapter. If it is nil, the existing filter of the adapter is used.
+	Filter *DiscoveryFilter `json:"filter,omitempty" doc:"The discovery filter, which is set on the adapter before the discovery is started."`
+}
+
+// DiscoveryEvent holds a device which was found or updated during a scoped discovery session.
+type DiscoveryEvent struct {
+	// Action indicates whether the device was found ("added"), or updated ("updated").
+	Action EventAction `json:"action,omitempty" enum:"added,updated" doc:"Indicates whether the device was found or updated."`
+
+	// Device holds the properties of the device.
+	Device DeviceData `json:"device,omitempty" doc:"The properties of the device."`
+}
+
+// PruneOptions holds the options to remove stale devices from an adapter.
+type PruneOptions struct {
+	// OlderThan holds the duration, for which a device must not have been seen
+	// for it to be removed. Note that devices are considered to be seen only when they
+	// are found while discovering, or when their signal strength or advertising data
+	// change. Devices which were not seen since the session started are always removed.
+	OlderThan time.Duration `json:"older_than,omitempty" doc:"The duration, for which a device must not have been seen for it to be removed."`
+
+	// DryRun indicates whether the stale devices are only listed, and not removed.
+	DryRun bool `json:"dry_run,omitempty" doc:"Indicates whether the stale devices are only listed, and not removed."`
+}
+
+// PruneResult holds the summary of the stale devices which were removed from an adapter.
+type PruneResult struct {
+	// Address holds the Bluetooth MAC address of the adapter.
+	Address MacAddress `json:"address,omitempty" doc:"The Bluetooth MAC address of the adapter."`
+
+	// DryRun indicates whether the stale devices were only listed, and not removed.
+	DryRun bool `json:"dry_run,omitempty" doc:"Indicates whether the stale devices were only listed, and not removed."`
+
+	// Devices holds the stale devices which were removed, or which
+	// would have been removed if this was not a dry-run.
+	Devices []DeviceData `json:"devices,omitempty" doc:"The stale devices which were removed, or which would have been removed if this was not a dry-run."`
+}
+
+// DefaultAdapterEventData holds the default adapter event information.
+// This is published when the default adapter of the session changes.
+type DefaultAdapterEventData struct {
+	// Address holds the Bluetooth MAC address of the default adapter.
+	// If no adapters exist, this is empty.
+	Address MacAddress `json:"address,omitempty" doc:"The Bluetooth MAC address of the default adapter. If no adapters exist, this is empty."`
+
+	// UniqueName holds the unique name of the default adapter, for example "hci0".
+	UniqueName string `json:"unique_name,omitempty" doc:"The unique name of the default adapter."`
+
+	// Previous holds the Bluetooth MAC address of the previous default adapter.
+	Previous MacAddress `json:"previous,omitempty" doc:"The Bluetooth MAC address of the previous default adapter."`
 }
 
 // AdapterData holds the static bluetooth adapter information installed for a system.
@@ -36,11 +194,6 @@ type AdapterData struct {
 	// and optionally appended by a number if more adapters are present.
 	Name string `json:"name,omitempty" codec:"Name,omitempty" doc:"The system-assigned name of the adapter. This usually can be the hostname of the PC, and optionally appended by a number if more adapters are present."`
 
-	// Alias holds the optional or user-assigned name for the adapter.
-	// Usually valid for Linux systems, may be empty or equate to "Name"
-	// for other systems.
-	Alias string `json:"alias,omitempty" codec:"Alias,omitempty" doc:"The optional or user-assigned name for the adapter. Usually valid for Linux systems, may be empty or equate to **name** for other systems."`
-
 	// UniqueName holds a unique name for the adapter.
 	// For example, on Linux it can be "hci0".
 	// For other systems, it can equate to "Name".
@@ -49,6 +202,24 @@ type AdapterData struct {
 	// UUIDs holds all the supported profile uuids.
 	UUIDs uuid.UUIDs `json:"uuids,omitempty" codec:"UUIDs,omitempty" doc:"All the supported Bluetooth service profile UUIDs."`
 
+	// Modalias holds the device ID information of the adapter.
+	Modalias Modalias `json:"modalias,omitempty" codec:"Modalias,omitempty" doc:"The device ID information of the adapter, for example 'usb:v1D6Bp0246d0525'."`
+
+	// Roles holds the supported roles of the adapter,
+	// for example, "central", "peripheral" and "central-peripheral".
+	Roles []string `json:"roles,omitempty" codec:"Roles,omitempty" doc:"The supported roles of the adapter, for example 'central', 'peripheral' and 'central-peripheral'."`
+
+	// ExperimentalFeatures holds the UUIDs of the experimental features
+	// which are enabled on the adapter.
+	ExperimentalFeatures uuid.UUIDs `json:"experimental_features,omitempty" codec:"ExperimentalFeatures,omitempty" doc:"The UUIDs of the experimental features which are enabled on the adapter."`
+
+	// Manufacturer holds the company identifier of the adapter's manufacturer.
+	Manufacturer uint16 `json:"manufacturer,omitempty" codec:"Manufacturer,omitempty" doc:"The company identifier of the adapter's manufacturer."`
+
+	// Version holds the Bluetooth core specification version supported by the adapter.
+	// For example, a value of 0x0c indicates version 5.3.
+	Version uint8 `json:"version,omitempty" codec:"Version,omitempty" doc:"The Bluetooth core specification version supported by the adapter. For example, a value of 12 indicates version 5.3."`
+
 	AdapterEventData
 }
 
@@ -58,6 +229,14 @@ type AdapterEventData struct {
 	// Address holds the Bluetooth MAC address of the adapter.
 	Address MacAddress `json:"address,omitempty" codec:"Address,omitempty" doc:"The Bluetooth MAC address of the adapter."`
 
+	// Alias holds the optional or user-assigned name for the adapter.
+	// Usually valid for Linux systems, may be empty or equate to "Name"
+	// for other systems.
+	Alias string `json:"alias,omitempty" codec:"Alias,omitempty" doc:"The optional or user-assigned name for the adapter. Usually valid for Linux systems, may be empty or equate to **name** for other systems."`
+
+	// Class holds the class of device of the adapter.
+	Class uint32 `json:"class,omitempty" codec:"Class,omitempty" doc:"The class of device of the adapter."`
+
 	// Discoverable indicates whether the adapter is discoverable by other devices.
 	Discoverable bool `json:"discoverable,omitempty" codec:"Discoverable,omitempty" doc:"Indicates whether the adapter is discoverable by other devices."`
 
@@ -69,4 +248,21 @@ type AdapterEventData struct {
 
 	// Discovering indicates whether the adapter is discovering devices.
 	Discovering bool `json:"discovering,omitempty" codec:"Discovering,omitempty" doc:"Indicates whether the adapter is discovering devices."`
+
+	// SoftBlocked indicates whether the adapter is blocked via software,
+	// for example via rfkill or the system's airplane mode.
+	// Valid only on Linux systems.
+	SoftBlocked bool `json:"soft_blocked,omitempty" codec:"-" doc:"Indicates whether the adapter is blocked via software, for example via rfkill or the system's airplane mode. Valid only on Linux systems."`
+
+	// HardBlocked indicates whether the adapter is blocked via a hardware switch,
+	// for example an airplane mode switch. Valid only on Linux systems.
+	HardBlocked bool `json:"hard_blocked,omitempty" codec:"-" doc:"Indicates whether the adapter is blocked via a hardware switch, for example an airplane mode switch. Valid only on Linux systems."`
+
+	// DiscoverableTimeout holds the timeout (in seconds) after which the adapter
+	// is no longer discoverable. A value of zero indicates that there is no timeout.
+	DiscoverableTimeout uint32 `json:"discoverable_timeout,omitempty" codec:"DiscoverableTimeout,omitempty" doc:"The timeout (in seconds) after which the adapter is no longer discoverable. A value of zero indicates that there is no timeout."`
+
+	// PairableTimeout holds the timeout (in seconds) after which the adapter
+	// is no longer pairable. A value of zero indicates that there is no timeout.
+	PairableTimeout uint32 `json:"pairable_timeout,omitempty" codec:"PairableTimeout,omitempty" doc:"The timeout (in seconds) after which the adapter is no longer pairable. A value of zero indicates that there is no timeout."`
 }
@@ -0,0 +1,562 @@
+package bluetooth
+
+import "strconv"
+
+// Appearance describes the external appearance of a Bluetooth LE device,
+// as defined in the GAP Appearance values of the Bluetooth Assigned Numbers.
+// The upper 10 bits of the value hold the category, and the lower 6 bits hold the subcategory.
+type Appearance uint16
+
+// AppearanceCategory describes an appearance category and its subcategories.
+type AppearanceCategory struct {
+	// Name holds the name of the category.
+	Name string
+
+	// Subcategories holds the names of the subcategories of the category.
+	Subcategories map[uint8]string
+}
+
+// AppearanceCategories holds the GAP appearance categories and subcategories.
+// Adapted from:
+// https://bitbucket.org/bluetooth-SIG/public/src/main/assigned_numbers/core/appearance_values.yaml
+var AppearanceCategories = map[uint16]AppearanceCategory{
+	0x000: {Name: "Unknown"},
+	0x001: {Name: "Phone"},
+	0x002: {
+		Name: "Computer",
+		Subcategories: map[uint8]string{
+			0x01: "Desktop Workstation",
+			0x02: "Server-class Computer",
+			0x03: "Laptop",
+			0x04: "Handheld PC/PDA",
+			0x05: "Palm-size PC/PDA",
+			0x06: "Wearable Computer",
+			0x07: "Tablet",
+			0x08: "Docking Station",
+			0x09: "All in One",
+			0x0a: "Blade Server",
+			0x0b: "Convertible",
+			0x0c: "Detachable",
+			0x0d: "IoT Gateway",
+			0x0e: "Mini PC",
+			0x0f: "Stick PC",
+		},
+	},
+	0x003: {
+		Name: "Watch",
+		Subcategories: map[uint8]string{
+			0x01: "Sports Watch",
+			0x02: "Smartwatch",
+		},
+	},
+	0x004: {Name: "Clock"},
+	0x005: {Name: "Display"},
+	0x006: {Name: "Remote Control"},
+	0x007: {Name: "Eye-glasses"},
+	0x008: {Name: "Tag"},
+	0x009: {Name: "Keyring"},
+	0x00a: {Name: "Media Player"},
+	0x00b: {Name: "Barcode Scanner"},
+	0x00c: {
+		Name: "Thermometer",
+		Subcategories: map[uint8]string{
+			0x01: "Ear Thermometer",
+		},
+	},
+	0x00d: {
+		Name: "Heart Rate Sensor",
+		Subcategories: map[uint8]string{
+			0x01: "Heart Rate Belt",
+		},
+	},
+	0x00e: {
+		Name: "Blood Pressure",
+		Subcategories: map[uint8]string{
+			0x01: "Arm Blood Pressure",
+			0x02: "Wrist Blood Pressure",
+		},
+	},
+	0x00f: {
+		Name: "Human Interface Device",
+		Subcategories: map[uint8]string{
+			0x01: "Keyboard",
+			0x02: "Mouse",
+			0x03: "Joystick",
+			0x04: "Gamepad",
+			0x05: "Digitizer Tablet",
+			0x06: "Card Reader",
+			0x07: "Digital Pen",
+			0x08: "Barcode Scanner",
+			0x09: "Touchpad",
+			0x0a: "Presentation Remote",
+		},
+	},
+	0x010: {Name: "Glucose Meter"},
+	0x011: {
+		Name: "Running Walking Sensor",
+		Subcategories: map[uint8]string{
+			0x01: "In-Shoe Running Walking Sensor",
+			0x02: "On-Shoe Running Walking Sensor",
+			0x03: "On-Hip Running Walking Sensor",
+		},
+	},
+	0x012: {
+		Name: "Cycling",
+		Subcategories: map[uint8]string{
+			0x01: "Cycling Computer",
+			0x02: "Speed Sensor",
+			0x03: "Cadence Sensor",
+			0x04: "Power Sensor",
+			0x05: "Speed and Cadence Sensor",
+		},
+	},
+	0x013: {
+		Name: "Control Device",
+		Subcategories: map[uint8]string{
+			0x01: "Switch",
+			0x02: "Multi-switch",
+			0x03: "Button",
+			0x04: "Slider",
+			0x05: "Rotary Switch",
+			0x06: "Touch Panel",
+			0x07: "Single Switch",
+			0x08: "Double Switch",
+			0x09: "Triple Switch",
+			0x0a: "Battery Switch",
+			0x0b: "Energy Harvesting Switch",
+			0x0c: "Push Button",
+			0x0d: "Dial",
+		},
+	},
+	0x014: {
+		Name: "Network Device",
+		Subcategories: map[uint8]string{
+			0x01: "Access Point",
+			0x02: "Mesh Device",
+			0x03: "Mesh Network Proxy",
+		},
+	},
+	0x015: {
+		Name: "Sensor",
+		Subcategories: map[uint8]string{
+			0x01: "Motion Sensor",
+			0x02: "Air Quality Sensor",
+			0x03: "Temperature Sensor",
+			0x04: "Humidity Sensor",
+			0x05: "Leak Sensor",
+			0x06: "Smoke Sensor",
+			0x07: "Occupancy Sensor",
+			0x08: "Contact Sensor",
+			0x09: "Carbon Monoxide Sensor",
+			0x0a: "Carbon Dioxide Sensor",
+			0x0b: "Ambient Light Sensor",
+			0x0c: "Energy Sensor",
+			0x0d: "Color Light Sensor",
+			0x0e: "Rain Sensor",
+			0x0f: "Fire Sensor",
+			0x10: "Wind Sensor",
+			0x11: "Proximity Sensor",
+			0x12: "Multi-Sensor",
+			0x13: "Flush Mounted Sensor",
+			0x14: "Ceiling Mounted Sensor",
+			0x15: "Wall Mounted Sensor",
+			0x16: "Multisensor",
+			0x17: "Energy Meter",
+			0x18: "Flame Detector",
+			0x19: "Vehicle Tire Pressure Sensor",
+		},
+	},
+	0x016: {
+		Name: "Light Fixtures",
+		Subcategories: map[uint8]string{
+			0x01: "Wall Light",
+			0x02: "Ceiling Light",
+			0x03: "Floor Light",
+			0x04: "Cabinet Light",
+			0x05: "Desk Light",
+			0x06: "Troffer Light",
+			0x07: "Pendant Light",
+			0x08: "In-ground Light",
+			0x09: "Flood Light",
+			0x0a: "Underwater Light",
+			0x0b: "Bollard with Light",
+			0x0c: "Pathway Light",
+			0x0d: "Garden Light",
+			0x0e: "Pole-top Light",
+			0x0f: "Spotlight",
+			0x10: "Linear Light",
+			0x11: "Street Light",
+			0x12: "Shelves Light",
+			0x13: "Bay Light",
+			0x14: "Emergency Exit Light",
+			0x15: "Light Controller",
+			0x16: "Light Driver",
+			0x17: "Bulb",
+			0x18: "Low-bay Light",
+			0x19: "High-bay Light",
+		},
+	},
+	0x017: {
+		Name: "Fan",
+		Subcategories: map[uint8]string{
+			0x01: "Ceiling Fan",
+			0x02: "Axial Fan",
+			0x03: "Exhaust Fan",
+			0x04: "Pedestal Fan",
+			0x05: "Desk Fan",
+			0x06: "Wall Fan",
+		},
+	},
+	0x018: {
+		Name: "HVAC",
+		Subcategories: map[uint8]string{
+			0x01: "Thermostat",
+			0x02: "Humidifier",
+			0x03: "De-humidifier",
+			0x04: "Heater",
+			0x05: "Radiator",
+			0x06: "Boiler",
+			0x07: "Heat Pump",
+			0x08: "Infrared Heater",
+			0x09: "Radiant Panel Heater",
+			0x0a: "Fan Heater",
+			0x0b: "Air Curtain",
+		},
+	},
+	0x019: {Name: "Air Conditioning"},
+	0x01a: {Name: "Humidifier"},
+	0x01b: {
+		Name: "Heating",
+		Subcategories: map[uint8]string{
+			0x01: "Radiator",
+			0x02: "Boiler",
+			0x03: "Heat Pump",
+			0x04: "Infrared Heater",
+			0x05: "Radiant Panel Heater",
+			0x06: "Fan Heater",
+			0x07: "Air Curtain",
+		},
+	},
+	0x01c: {
+		Name: "Access Control",
+		Subcategories: map[uint8]string{
+			0x01: "Access Door",
+			0x02: "Garage Door",
+			0x03: "Emergency Exit Door",
+			0x04: "Access Lock",
+			0x05: "Elevator",
+			0x06: "Window",
+			0x07: "Entrance Gate",
+			0x08: "Door Lock",
+			0x09: "Locker",
+		},
+	},
+	0x01d: {
+		Name: "Motorized Device",
+		Subcategories: map[uint8]string{
+			0x01: "Motorized Gate",
+			0x02: "Awning",
+			0x03: "Blinds or Shades",
+			0x04: "Curtains",
+			0x05: "Screen",
+		},
+	},
+	0x01e: {
+		Name: "Power Device",
+		Subcategories: map[uint8]string{
+			0x01: "Power Outlet",
+			0x02: "Power Strip",
+			0x03: "Plug",
+			0x04: "Power Supply",
+			0x05: "LED Driver",
+			0x06: "Fluorescent Lamp Gear",
+			0x07: "HID Lamp Gear",
+			0x08: "Charge Case",
+			0x09: "Power Bank",
+		},
+	},
+	0x01f: {
+		Name: "Light Source",
+		Subcategories: map[uint8]string{
+			0x01: "Incandescent Light Bulb",
+			0x02: "LED Lamp",
+			0x03: "HID Lamp",
+			0x04: "Fluorescent Lamp",
+			0x05: "LED Array",
+			0x06: "Multi-Color LED Array",
+			0x07: "Low Voltage Halogen",
+			0x08: "OLED",
+		},
+	},
+	0x020: {
+		Name: "Window Covering",
+		Subcategories: map[uint8]string{
+			0x01: "Window Shades",
+			0x02: "Window Blinds",
+			0x03: "Window Awning",
+			0x04: "Window Curtain",
+			0x05: "Exterior Shutter",
+			0x06: "Exterior Screen",
+		},
+	},
+	0x021: {
+		Name: "Audio Sink",
+		Subcategories: map[uint8]string{
+			0x01: "Standalone Speaker",
+			0x02: "Soundbar",
+			0x03: "Bookshelf Speaker",
+			0x04: "Standmounted Speaker",
+			0x05: "Speakerphone",
+		},
+	},
+	0x022: {
+		Name: "Audio Source",
+		Subcategories: map[uint8]string{
+			0x01: "Microphone",
+			0x02: "Alarm",
+			0x03: "Bell",
+			0x04: "Horn",
+			0x05: "Broadcasting Device",
+			0x06: "Service Desk",
+			0x07: "Kiosk",
+			0x08: "Broadcasting Room",
+			0x09: "Auditorium",
+		},
+	},
+	0x023: {
+		Name: "Motorized Vehicle",
+		Subcategories: map[uint8]string{
+			0x01: "Car",
+			0x02: "Large Goods Vehicle",
+			0x03: "2-Wheeled Vehicle",
+			0x04: "Motorbike",
+			0x05: "Scooter",
+			0x06: "Moped",
+			0x07: "3-Wheeled Vehicle",
+			0x08: "Light Vehicle",
+			0x09: "Quad Bike",
+			0x0a: "Minibus",
+			0x0b: "Bus",
+			0x0c: "Trolley",
+			0x0d: "Agricultural Vehicle",
+			0x0e: "Camper / Caravan",
+			0x0f: "Recreational Vehicle / Motor Home",
+		},
+	},
+	0x024: {
+		Name: "Domestic Appliance",
+		Subcategories: map[uint8]string{
+			0x01: "Refrigerator",
+			0x02: "Freezer",
+			0x03: "Oven",
+			0x04: "Microwave",
+			0x05: "Toaster",
+			0x06: "Washing Machine",
+			0x07: "Dryer",
+			0x08: "Coffee Maker",
+			0x09: "Clothes Iron",
+			0x0a: "Curling Iron",
+			0x0b: "Hair Dryer",
+			0x0c: "Vacuum Cleaner",
+			0x0d: "Robotic Vacuum Cleaner",
+			0x0e: "Rice Cooker",
+			0x0f: "Clothes Steamer",
+		},
+	},
+	0x025: {
+		Name: "Wearable Audio Device",
+		Subcategories: map[uint8]string{
+			0x01: "Earbud",
+			0x02: "Headset",
+			0x03: "Headphones",
+			0x04: "Neck Band",
+		},
+	},
+	0x026: {
+		Name: "Aircraft",
+		Subcategories: map[uint8]string{
+			0x01: "Light Aircraft",
+			0x02: "Microlight",
+			0x03: "Paraglider",
+			0x04: "Large Passenger Aircraft",
+		},
+	},
+	0x027: {
+		Name: "AV Equipment",
+		Subcategories: map[uint8]string{
+			0x01: "Amplifier",
+			0x02: "Equalizer",
+		},
+	},
+	0x028: {
+		Name: "Display Equipment",
+		Subcategories: map[uint8]string{
+			0x01: "Television",
+			0x02: "Monitor",
+			0x03: "Projector",
+		},
+	},
+	0x029: {
+		Name: "Hearing Aid",
+		Subcategories: map[uint8]string{
+			0x01: "In-ear Hearing Aid",
+			0x02: "Behind-ear Hearing Aid",
+			0x03: "Cochlear Implant",
+		},
+	},
+	0x02a: {
+		Name: "Gaming",
+		Subcategories: map[uint8]string{
+			0x01: "Home Video Game Console",
+			0x02: "Portable Handheld Console",
+		},
+	},
+	0x02b: {
+		Name: "Signage",
+		Subcategories: map[uint8]string{
+			0x01: "Digital Signage",
+			0x02: "Electronic Label",
+		},
+	},
+	0x031: {
+		Name: "Pulse Oximeter",
+		Subcategories: map[uint8]string{
+			0x01: "Fingertip Pulse Oximeter",
+			0x02: "Wrist Worn Pulse Oximeter",
+		},
+	},
+	0x032: {Name: "Weight Scale"},
+	0x033: {
+		Name: "Personal Mobility Device",
+		Subcategories: map[uint8]string{
+			0x01: "Powered Wheelchair",
+			0x02: "Mobility Scooter",
+		},
+	},
+	0x034: {Name: "Continuous Glucose Monitor"},
+	0x035: {Name: "Insulin Pump"},
+	0x036: {Name: "Medication Delivery"},
+	0x037: {
+		Name: "Spirometer",
+		Subcategories: map[uint8]string{
+			0x01: "Handheld Spirometer",
+		},
+	},
+	0x051: {
+		Name: "Outdoor Sports Activity",
+		Subcategories: map[uint8]string{
+			0x01: "Location Display",
+			0x02: "Location and Navigation Display",
+			0x03: "Location Pod",
+			0x04: "Location and Navigation Pod",
+		},
+	},
+}
+
+// Category returns the category of the appearance.
+func (a Appearance) Category() uint16 {
+	return uint16(a) >> 6
+}
+
+// Subcategory returns the subcategory of the appearance.
+func (a Appearance) Subcategory() uint8 {
+	return uint8(a & 0x3f)
+}
+
+// CategoryName returns the name of the category of the appearance.
+// If the category is unknown, an empty string is returned.
+func (a Appearance) CategoryName() string {
+	return AppearanceCategories[a.Category()].Name
+}
+
+// SubcategoryName returns the name of the subcategory of the appearance.
+// If the subcategory is generic or unknown, an empty string is returned.
+func (a Appearance) SubcategoryName() string {
+	return AppearanceCategories[a.Category()].Subcategories[a.Subcategory()]
+}
+
+// String returns the name of the appearance, for example "Smartwatch" or "Watch".
+// If the appearance is unknown, its hexadecimal value is returned.
+func (a Appearance) String() string {
+	if name := a.SubcategoryName(); name != "" {
+		return name
+	}
+
+	if name := a.CategoryName(); name != "" {
+		return name
+	}
+
+	return "0x" + strconv.FormatUint(uint64(a), 16)
+}
+
+// DeviceTypeFromAppearance parses the device appearance and returns its type.
+func DeviceTypeFromAppearance(appearance Appearance) string {
+	if name := appearance.SubcategoryName(); name != "" {
+		return name
+	}
+
+	if name := appearance.CategoryName(); name != "" {
+		return name
+	}
+
+	return "Unknown"
+}
+
+// DeviceTypeFromServices parses the device-supported Bluetooth profile UUIDs and returns its type.
+func DeviceTypeFromServices(uuids []string) string {
+	switch {
+	case ServiceExists(uuids, HeadsetServiceClass), ServiceExists(uuids, HandsfreeServiceClass):
+		return "Headset"
+
+	case ServiceExists(uuids, AudioSinkServiceClass), ServiceExists(uuids, AdvancedAudioServiceClass):
+		return "Audio device"
+
+	case ServiceExists(uuids, HidServiceClass), ServiceExists(uuids, HidOverGattServiceClass):
+		return "Input device"
+
+	case ServiceExists(uuids, HeadsetAgwServiceClass), ServiceExists(uuids, HandsfreeAgwServiceClass):
+		return "Phone"
+
+	case ServiceExists(uuids, PanuServiceClass), ServiceExists(uuids, NapServiceClass):
+		return "Network"
+
+	case ServiceExists(uuids, HeartRateServiceClass):
+		return "Heart Rate Sensor"
+
+	case ServiceExists(uuids, CyclingSpeedServiceClass), ServiceExists(uuids, CyclingPowerServiceClass):
+		return "Cycling"
+
+	case ServiceExists(uuids, RunningSpeedServiceClass):
+		return "Running Walking Sensor"
+
+	case ServiceExists(uuids, GlucoseServiceClass):
+		return "Glucose Meter"
+
+	case ServiceExists(uuids, BloodPressureServiceClass):
+		return "Blood Pressure"
+
+	case ServiceExists(uuids, HealthThermometerServiceClass):
+		return "Thermometer"
+
+	case ServiceExists(uuids, WeightScaleServiceClass):
+		return "Weight Scale"
+	}
+
+	return "Unknown"
+}
+
+// ResolveDeviceType returns the type of the device, which is determined from
+// its appearance, then its class, and finally its device-supported Bluetooth profile UUIDs.
+func ResolveDeviceType(appearance Appearance, class uint32, uuids []string) string {
+	if appearance != 0 {
+		if deviceType := DeviceTypeFromAppearance(appearance); deviceType != "Unknown" {
+			return deviceType
+		}
+	}
+
+	if deviceType := DeviceTypeFromClass(class); deviceType != "Unknown" {
+		return deviceType
+	}
+
+	return DeviceTypeFromServices(uuids)
+}
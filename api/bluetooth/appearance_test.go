@@ -0,0 +1,129 @@
+package bluetooth
+
+import "testing"
+
+func TestAppearance(t *testing.T) {
+	tests := []struct {
+		name            string
+		appearance      Appearance
+		wantCategory    uint16
+		wantSubcategory uint8
+		wantString      string
+		wantType        string
+	}{
+		{
+			name:            "Keyboard",
+			appearance:      0x03C1,
+			wantCategory:    0x00F,
+			wantSubcategory: 0x01,
+			wantString:      "Keyboard",
+			wantType:        "Keyboard",
+		},
+		{
+			name:            "Smartwatch",
+			appearance:      0x00C2,
+			wantCategory:    0x003,
+			wantSubcategory: 0x02,
+			wantString:      "Smartwatch",
+			wantType:        "Smartwatch",
+		},
+		{
+			name:         "GenericCategory",
+			appearance:   0x0040,
+			wantCategory: 0x001,
+			wantString:   "Phone",
+			wantType:     "Phone",
+		},
+		{
+			name:            "UnknownSubcategory",
+			appearance:      0x03FF,
+			wantCategory:    0x00F,
+			wantSubcategory: 0x3F,
+			wantString:      "Human Interface Device",
+			wantType:        "Human Interface Device",
+		},
+		{
+			name:            "UnknownCategory",
+			appearance:      0xFFC1,
+			wantCategory:    0x3FF,
+			wantSubcategory: 0x01,
+			wantString:      "0xffc1",
+			wantType:        "Unknown",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if category, subcategory := test.appearance.Category(), test.appearance.Subcategory(); category != test.wantCategory ||
+				subcategory != test.wantSubcategory {
+				t.Errorf("Category(), Subcategory() = %#x, %#x, want %#x, %#x",
+					category, subcategory, test.wantCategory, test.wantSubcategory,
+				)
+			}
+
+			if s := test.appearance.String(); s != test.wantString {
+				t.Errorf("String() = %q, want %q", s, test.wantString)
+			}
+
+			if deviceType := DeviceTypeFromAppearance(test.appearance); deviceType != test.wantType {
+				t.Errorf("DeviceTypeFromAppearance() = %q, want %q", deviceType, test.wantType)
+			}
+		})
+	}
+}
+
+func TestResolveDeviceType(t *testing.T) {
+	const (
+		headsetClass = 0x240404
+		hidUUID      = "00001124-0000-1000-8000-00805f9b34fb"
+		headsetUUID  = "00001108-0000-1000-8000-00805f9b34fb"
+	)
+
+	tests := []struct {
+		name       string
+		appearance Appearance
+		class      uint32
+		uuids      []string
+		want       string
+	}{
+		{
+			name:       "AppearanceOverridesClass",
+			appearance: 0x03C1,
+			class:      headsetClass,
+			uuids:      []string{headsetUUID},
+			want:       "Keyboard",
+		},
+		{
+			name:       "UnknownAppearanceUsesClass",
+			appearance: 0xFFC1,
+			class:      headsetClass,
+			uuids:      []string{hidUUID},
+			want:       "Headset",
+		},
+		{
+			name:  "ClassOverridesUUIDs",
+			class: headsetClass,
+			uuids: []string{hidUUID},
+			want:  "Headset",
+		},
+		{
+			name:  "UUIDs",
+			uuids: []string{hidUUID},
+			want:  "Input device",
+		},
+		{
+			name: "Unknown",
+			want: "Unknown",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if deviceType := ResolveDeviceType(test.appearance, test.class, test.uuids); deviceType != test.want {
+				t.Errorf("ResolveDeviceType(%#x, %#x, %v) = %q, want %q",
+					uint16(test.appearance), test.class, test.uuids, deviceType, test.want,
+				)
+			}
+		})
+	}
+}
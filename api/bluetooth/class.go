@@ -0,0 +1,297 @@
+package bluetooth
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// ServiceClass describes the major service classes of a Class of Device,
+// which are held in bits 13 to 23 of the class.
+type ServiceClass uint32
+
+// The different major service classes.
+const (
+	ServiceClassLimitedDiscoverable ServiceClass = 1 << (iota + 13)
+	ServiceClassLEAudio
+	serviceClassReserved
+	ServiceClassPositioning
+	ServiceClassNetworking
+	ServiceClassRendering
+	ServiceClassCapturing
+	ServiceClassObjectTransfer
+	ServiceClassAudio
+	ServiceClassTelephony
+	ServiceClassInformation
+)
+
+// serviceClassMask holds the bits of the class which specify the major service classes.
+const serviceClassMask = 0xffe000
+
+// ServiceClassNames holds the names of the major service classes.
+var ServiceClassNames = map[ServiceClass]string{
+	ServiceClassLimitedDiscoverable: "Limited Discoverable Mode",
+	ServiceClassLEAudio:             "LE Audio",
+	ServiceClassPositioning:         "Positioning",
+	ServiceClassNetworking:          "Networking",
+	ServiceClassRendering:           "Rendering",
+	ServiceClassCapturing:           "Capturing",
+	ServiceClassObjectTransfer:      "Object Transfer",
+	ServiceClassAudio:               "Audio",
+	ServiceClassTelephony:           "Telephony",
+	ServiceClassInformation:         "Information",
+}
+
+// The different major device classes.
+const (
+	MajorClassMiscellaneous uint8 = 0x00
+	MajorClassComputer      uint8 = 0x01
+	MajorClassPhone         uint8 = 0x02
+	MajorClassNetwork       uint8 = 0x03
+	MajorClassAudioVideo    uint8 = 0x04
+	MajorClassPeripheral    uint8 = 0x05
+	MajorClassImaging       uint8 = 0x06
+	MajorClassWearable      uint8 = 0x07
+	MajorClassToy           uint8 = 0x08
+	MajorClassHealth        uint8 = 0x09
+	MajorClassUncategorized uint8 = 0x1f
+)
+
+// MajorClassNames holds the names of the major device classes.
+var MajorClassNames = map[uint8]string{
+	MajorClassMiscellaneous: "Miscellaneous",
+	MajorClassComputer:      "Computer",
+	MajorClassPhone:         "Phone",
+	MajorClassNetwork:       "Network Access Point",
+	MajorClassAudioVideo:    "Audio/Video",
+	MajorClassPeripheral:    "Peripheral",
+	MajorClassImaging:       "Imaging",
+	MajorClassWearable:      "Wearable",
+	MajorClassToy:           "Toy",
+	MajorClassHealth:        "Health",
+	MajorClassUncategorized: "Uncategorized",
+}
+
+// minorClassNames holds the names of the minor device classes of each major device class,
+// whose minor classes are enumerated values. The minor classes of the network, peripheral
+// and imaging major classes are bit fields, and are parsed separately.
+// Adapted from:
+// https://www.bluetooth.com/specifications/assigned-numbers/ (Class of Device)
+var minorClassNames = map[uint8]map[uint8]string{
+	MajorClassComputer: {
+		0x00: "Uncategorized",
+		0x01: "Desktop Workstation",
+		0x02: "Server-class Computer",
+		0x03: "Laptop",
+		0x04: "Handheld PC/PDA",
+		0x05: "Palm-size PC/PDA",
+		0x06: "Wearable Computer",
+		0x07: "Tablet",
+	},
+	MajorClassPhone: {
+		0x00: "Uncategorized",
+		0x01: "Cellular",
+		0x02: "Cordless",
+		0x03: "Smartphone",
+		0x04: "Wired Modem or Voice Gateway",
+		0x05: "Common ISDN Access",
+	},
+	MajorClassAudioVideo: {
+		0x00: "Uncategorized",
+		0x01: "Wearable Headset Device",
+		0x02: "Hands-free Device",
+		0x04: "Microphone",
+		0x05: "Loudspeaker",
+		0x06: "Headphones",
+		0x07: "Portable Audio",
+		0x08: "Car Audio",
+		0x09: "Set-top Box",
+		0x0a: "HiFi Audio Device",
+		0x0b: "VCR",
+		0x0c: "Video Camera",
+		0x0d: "Camcorder",
+		0x0e: "Video Monitor",
+		0x0f: "Video Display and Loudspeaker",
+		0x10: "Video Conferencing",
+		0x12: "Gaming/Toy",
+	},
+	MajorClassWearable: {
+		0x01: "Wristwatch",
+		0x02: "Pager",
+		0x03: "Jacket",
+		0x04: "Helmet",
+		0x05: "Glasses",
+		0x06: "Pin",
+	},
+	MajorClassToy: {
+		0x01: "Robot",
+		0x02: "Vehicle",
+		0x03: "Doll/Action Figure",
+		0x04: "Controller",
+		0x05: "Game",
+	},
+	MajorClassHealth: {
+		0x00: "Undefined",
+		0x01: "Blood Pressure Monitor",
+		0x02: "Thermometer",
+		0x03: "Weighing Scale",
+		0x04: "Glucose Meter",
+		0x05: "Pulse Oximeter",
+		0x06: "Heart/Pulse Rate Monitor",
+		0x07: "Health Data Display",
+		0x08: "Step Counter",
+		0x09: "Body Composition Analyzer",
+		0x0a: "Peak Flow Monitor",
+		0x0b: "Medication Monitor",
+		0x0c: "Knee Prosthesis",
+		0x0d: "Ankle Prosthesis",
+		0x0e: "Generic Health Manager",
+		0x0f: "Personal Mobility Device",
+	},
+}
+
+// networkLoadNames holds the names of the utilization levels of a network access point.
+var networkLoadNames = [...]string{
+	"Fully Available",
+	"1% to 17% Utilized",
+	"17% to 33% Utilized",
+	"33% to 50% Utilized",
+	"50% to 67% Utilized",
+	"67% to 83% Utilized",
+	"83% to 99% Utilized",
+	"No Service Available",
+}
+
+// peripheralInputNames holds the names of the input types of a peripheral, held in bits 6 and 7 of the class.
+var peripheralInputNames = [...]string{
+	"",
+	"Keyboard",
+	"Pointing Device",
+	"Combo Keyboard/Pointing Device",
+}
+
+// peripheralDeviceNames holds the names of the device types of a peripheral, held in bits 2 to 5 of the class.
+var peripheralDeviceNames = map[uint8]string{
+	0x01: "Joystick",
+	0x02: "Gamepad",
+	0x03: "Remote Control",
+	0x04: "Sensing Device",
+	0x05: "Digitizer Tablet",
+	0x06: "Card Reader",
+	0x07: "Digital Pen",
+	0x08: "Handheld Scanner",
+	0x09: "Handheld Gestural Input Device",
+}
+
+// imagingDeviceNames holds the names of the device types of an imaging device, held in bits 4 to 7 of the class.
+var imagingDeviceNames = [...]string{
+	"Display",
+	"Camera",
+	"Scanner",
+	"Printer",
+}
+
+// DeviceClass holds the decoded Class of Device of a device.
+type DeviceClass struct {
+	// MajorClass holds the major device class.
+	MajorClass uint8 `json:"major_class,omitempty" codec:"MajorClass,omitempty" doc:"The major device class."`
+
+	// MajorClassName holds the name of the major device class, for example "Audio/Video".
+	MajorClassName string `json:"major_class_name,omitempty" codec:"MajorClassName,omitempty" doc:"The name of the major device class, for example 'Audio/Video'."`
+
+	// MinorClass holds the minor device class, which is interpreted according to the major device class.
+	MinorClass uint8 `json:"minor_class,omitempty" codec:"MinorClass,omitempty" doc:"The minor device class, which is interpreted according to the major device class."`
+
+	// MinorClassName holds the name of the minor device class, for example "Headphones".
+	MinorClassName string `json:"minor_class_name,omitempty" codec:"MinorClassName,omitempty" doc:"The name of the minor device class, for example 'Headphones'."`
+
+	// Services holds the major service classes of the device.
+	Services ServiceClass `json:"services,omitempty" codec:"Services,omitempty" doc:"The major service classes of the device, as a bit field."`
+
+	// ServiceNames holds the names of the major service classes of the device, for example "Audio" or "Telephony".
+	ServiceNames []string `json:"service_names,omitempty" codec:"ServiceNames,omitempty" doc:"The names of the major service classes of the device, for example 'Audio' or 'Telephony'."`
+}
+
+// DecodeDeviceClass decodes the provided Class of Device.
+func DecodeDeviceClass(class uint32) DeviceClass {
+	major := uint8((class & 0x1f00) >> 8)
+	minor := uint8((class & 0xfc) >> 2)
+	services := ServiceClass(class & serviceClassMask)
+
+	return DeviceClass{
+		MajorClass:     major,
+		MajorClassName: MajorClassNames[major],
+		MinorClass:     minor,
+		MinorClassName: minorClassName(major, minor),
+		Services:       services,
+		ServiceNames:   services.Names(),
+	}
+}
+
+// Has returns whether all of the provided service classes are set.
+func (s ServiceClass) Has(service ServiceClass) bool {
+	return s&service == service
+}
+
+// Names returns the names of the service classes which are set, in order of their bits.
+func (s ServiceClass) Names() []string {
+	var names []string
+
+	for s != 0 {
+		service := ServiceClass(1) << bits.TrailingZeros32(uint32(s))
+		s &^= service
+
+		if name, ok := ServiceClassNames[service]; ok {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// String returns the names of the service classes which are set, separated by commas.
+func (s ServiceClass) String() string {
+	return strings.Join(s.Names(), ", ")
+}
+
+// HasService returns whether the device class has all of the provided service classes set.
+func (c DeviceClass) HasService(service ServiceClass) bool {
+	return c.Services.Has(service)
+}
+
+// minorClassName returns the name of the minor device class according to its major device class.
+func minorClassName(major, minor uint8) string {
+	switch major {
+	case MajorClassNetwork:
+		return networkLoadNames[minor>>3]
+
+	case MajorClassPeripheral:
+		var names []string
+
+		if name := peripheralInputNames[minor>>4]; name != "" {
+			names = append(names, name)
+		}
+
+		if name, ok := peripheralDeviceNames[minor&0x0f]; ok {
+			names = append(names, name)
+		}
+
+		if names == nil {
+			return "Uncategorized"
+		}
+
+		return strings.Join(names, ", ")
+
+	case MajorClassImaging:
+		var names []string
+
+		for i, name := range imagingDeviceNames {
+			if minor&(1<<(i+2)) != 0 {
+				names = append(names, name)
+			}
+		}
+
+		return strings.Join(names, ", ")
+	}
+
+	return minorClassNames[major][minor]
+}
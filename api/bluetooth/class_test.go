@@ -0,0 +1,95 @@
+package bluetooth
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeDeviceClass(t *testing.T) {
+	tests := []struct {
+		name  string
+		class uint32
+		want  DeviceClass
+	}{
+		{
+			name:  "Headset",
+			class: 0x240404,
+			want: DeviceClass{
+				MajorClass:     MajorClassAudioVideo,
+				MajorClassName: "Audio/Video",
+				MinorClass:     0x01,
+				MinorClassName: "Wearable Headset Device",
+				Services:       ServiceClassAudio | ServiceClassRendering,
+				ServiceNames:   []string{"Rendering", "Audio"},
+			},
+		},
+		{
+			name:  "Smartphone",
+			class: 0x5a020c,
+			want: DeviceClass{
+				MajorClass:     MajorClassPhone,
+				MajorClassName: "Phone",
+				MinorClass:     0x03,
+				MinorClassName: "Smartphone",
+				Services:       ServiceClassNetworking | ServiceClassCapturing | ServiceClassObjectTransfer | ServiceClassTelephony,
+				ServiceNames:   []string{"Networking", "Capturing", "Object Transfer", "Telephony"},
+			},
+		},
+		{
+			name:  "PeripheralBitField",
+			class: 0x0005c8,
+			want: DeviceClass{
+				MajorClass:     MajorClassPeripheral,
+				MajorClassName: "Peripheral",
+				MinorClass:     0x32,
+				MinorClassName: "Combo Keyboard/Pointing Device, Gamepad",
+			},
+		},
+		{
+			name:  "UnknownMajorClass",
+			class: 0x000a04,
+			want: DeviceClass{
+				MajorClass: 0x0a,
+				MinorClass: 0x01,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := DecodeDeviceClass(test.class); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("DecodeDeviceClass(%#x) = %+v, want %+v", test.class, got, test.want)
+			}
+		})
+	}
+}
+
+func TestServiceClassNames(t *testing.T) {
+	// All service class bits are set, including the reserved bit, which has no name.
+	services := ServiceClass(serviceClassMask)
+
+	want := []string{
+		"Limited Discoverable Mode",
+		"LE Audio",
+		"Positioning",
+		"Networking",
+		"Rendering",
+		"Capturing",
+		"Object Transfer",
+		"Audio",
+		"Telephony",
+		"Information",
+	}
+
+	if names := services.Names(); !reflect.DeepEqual(names, want) {
+		t.Errorf("Names() = %v, want %v", names, want)
+	}
+
+	if names := ServiceClass(0).Names(); names != nil {
+		t.Errorf("Names() without any service classes = %v, want nil", names)
+	}
+
+	if s := (ServiceClassTelephony | ServiceClassAudio).String(); s != "Audio, Telephony" {
+		t.Errorf("String() = %q, want %q", s, "Audio, Telephony")
+	}
+}
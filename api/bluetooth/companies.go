@@ -0,0 +1,4021 @@
+// Code generated by gencompanies from https://bitbucket.org/bluetooth-SIG/public/raw/main/assigned_numbers/company_identifiers/company_identifiers.yaml; DO NOT EDIT.
+
+package bluetooth
+
+// Companies holds the names of the Bluetooth SIG assigned company identifiers,
+// which are used in manufacturer specific data and Bluetooth device IDs.
+var Companies = map[uint16]string{
+	0x0000: "Ericsson AB",
+	0x0001: "Nokia Mobile Phones",
+	0x0002: "Intel Corp.",
+	0x0003: "IBM Corp.",
+	0x0004: "Toshiba Corp.",
+	0x0005: "3Com",
+	0x0006: "Microsoft",
+	0x0007: "Lucent",
+	0x0008: "Motorola",
+	0x0009: "Infineon Technologies AG",
+	0x000a: "Qualcomm Technologies International, Ltd. (QTIL)",
+	0x000b: "Silicon Wave",
+	0x000c: "Digianswer A/S",
+	0x000d: "Texas Instruments Inc.",
+	0x000e: "Parthus Technologies Inc.",
+	0x000f: "Broadcom Corporation",
+	0x0010: "Mitel Semiconductor",
+	0x0011: "Widcomm, Inc.",
+	0x0012: "Zeevo, Inc.",
+	0x0013: "Atmel Corporation",
+	0x0014: "Mitsubishi Electric Corporation",
+	0x0015: "RTX A/S",
+	0x0016: "KC Technology Inc.",
+	0x0017: "Newlogic",
+	0x0018: "Transilica, Inc.",
+	0x0019: "Rohde & Schwarz GmbH & Co. KG",
+	0x001a: "TTPCom Limited",
+	0x001b: "Signia Technologies, Inc.",
+	0x001c: "Conexant Systems Inc.",
+	0x001d: "Qualcomm",
+	0x001e: "Inventel",
+	0x001f: "AVM Berlin",
+	0x0020: "BandSpeed, Inc.",
+	0x0021: "Mansella Ltd",
+	0x0022: "NEC Corporation",
+	0x0023: "WavePlus Technology Co., Ltd.",
+	0x0024: "Alcatel",
+	0x0025: "NXP B.V.",
+	0x0026: "C Technologies",
+	0x0027: "Open Interface",
+	0x0028: "R F Micro Devices",
+	0x0029: "Hitachi Ltd",
+	0x002a: "Symbol Technologies, Inc.",
+	0x002b: "Tenovis",
+	0x002c: "Macronix International Co. Ltd.",
+	0x002d: "GCT Semiconductor",
+	0x002e: "Norwood Systems",
+	0x002f: "MewTel Technology Inc.",
+	0x0030: "ST Microelectronics",
+	0x0031: "Synopsys, Inc.",
+	0x0032: "Red-M (Communications) Ltd",
+	0x0033: "Commil Ltd",
+	0x0034: "Computer Access Technology Corporation (CATC)",
+	0x0036: "Renesas Electronics Corporation",
+	0x0037: "Mobilian Corporation",
+	0x0038: "Syntronix Corporation",
+	0x0039: "Integrated System Solution Corp.",
+	0x003a: "Panasonic Holdings Corporation",
+	0x003b: "Gennum Corporation",
+	0x003c: "BlackBerry Limited",
+	0x003d: "IPextreme, Inc.",
+	0x003e: "Systems and Chips, Inc",
+	0x003f: "Bluetooth SIG, Inc",
+	0x0040: "Seiko Epson Corporation",
+	0x0041: "Integrated Silicon Solution Taiwan, Inc.",
+	0x0042: "CONWISE Technology Corporation Ltd",
+	0x0043: "PARROT AUTOMOTIVE SAS",
+	0x0044: "Socket Mobile",
+	0x0045: "Atheros Communications, Inc.",
+	0x0046: "MediaTek, Inc.",
+	0x0047: "Bluegiga",
+	0x0048: "Marvell Technology Group Ltd.",
+	0x0049: "3DSP Corporation",
+	0x004a: "Accel Semiconductor Ltd.",
+	0x004b: "AUMOVIO Systems, Inc.",
+	0x004c: "Apple, Inc.",
+	0x004d: "Staccato Communications, Inc.",
+	0x004e: "Avago Technologies",
+	0x004f: "APT Ltd.",
+	0x0050: "SiRF Technology, Inc.",
+	0x0051: "Tzero Technologies, Inc.",
+	0x0052: "J&M Corporation",
+	0x0053: "Free2move AB",
+	0x0054: "3DiJoy Corporation",
+	0x0055: "Plantronics, Inc.",
+	0x0056: "Sony Ericsson Mobile Communications",
+	0x0057: "Harman International Industries, Inc.",
+	0x0058: "Vizio, Inc.",
+	0x0059: "Nordic Semiconductor ASA",
+	0x005a: "EM Microelectronic-Marin SA",
+	0x005b: "Ralink Technology Corporation",
+	0x005c: "Belkin International, Inc.",
+	0x005d: "Realtek Semiconductor Corporation",
+	0x005e: "Stonestreet One, LLC",
+	0x005f: "Wicentric, Inc.",
+	0x0060: "RivieraWaves S.A.S",
+	0x0061: "RDA Microelectronics",
+	0x0062: "Gibson Guitars",
+	0x0063: "MiCommand Inc.",
+	0x0064: "Band XI International, LLC",
+	0x0065: "HP, Inc.",
+	0x0067: "GN Hearing",
+	0x0068: "General Motors",
+	0x0069: "A&D Engineering, Inc.",
+	0x006a: "LTM Limited",
+	0x006b: "Polar Electro OY",
+	0x006c: "Beautiful Enterprise Co., Ltd.",
+	0x006e: "Summit Data Communications, Inc.",
+	0x006f: "Sound ID",
+	0x0070: "Monster, LLC",
+	0x0071: "connectBlue AB",
+	0x0072: "ShangHai Super Smart Electronics Co. Ltd.",
+	0x0073: "Group Sense Ltd.",
+	0x0074: "Zomm, LLC",
+	0x0075: "Samsung Electronics Co. Ltd.",
+	0x0076: "Creative Technology Ltd.",
+	0x0077: "Laird Connectivity LLC",
+	0x0078: "Nike, Inc.",
+	0x0079: "lesswire AG",
+	0x007a: "MStar Semiconductor, Inc.",
+	0x007b: "Hanlynn Technologies",
+	0x007d: "Seers Technology Co., Ltd.",
+	0x007f: "Autonet Mobile",
+	0x0080: "DeLorme Publishing Company, Inc.",
+	0x0081: "WuXi Vimicro",
+	0x0082: "DSEA A/S",
+	0x0083: "TimeKeeping Systems, Inc.",
+	0x0084: "Ludus Helsinki Ltd.",
+	0x0085: "BlueRadios, Inc.",
+	0x0086: "Equinux AG",
+	0x0087: "Garmin International, Inc.",
+	0x0089: "GN Hearing A/S",
+	0x008a: "Jawbone",
+	0x008b: "Topcon Positioning Systems, LLC",
+	0x008c: "Gimbal Inc.",
+	0x008d: "Zscan Software",
+	0x008e: "Quintic Corp",
+	0x008f: "Telit Wireless Solutions GmbH",
+	0x0090: "Funai Electric Co., Ltd.",
+	0x0091: "Advanced PANMOBIL systems GmbH & Co. KG",
+	0x0092: "ThinkOptics, Inc.",
+	0x0093: "Universal Electronics, Inc.",
+	0x0094: "Airoha Technology Corp.",
+	0x0095: "NEC Lighting, Ltd.",
+	0x0096: "ODM Technology, Inc.",
+	0x0097: "ConnecteDevice Ltd.",
+	0x0098: "zero1.tv GmbH",
+	0x0099: "i.Tech Dynamic Global Distribution Ltd.",
+	0x009a: "Alpwise",
+	0x009b: "Jiangsu Toppower Automotive Electronics Co., Ltd.",
+	0x009c: "Colorfy, Inc.",
+	0x009d: "Geoforce Inc.",
+	0x009e: "Bose Corporation",
+	0x009f: "Suunto Oy",
+	0x00a0: "Kensington Computer Products Group",
+	0x00a1: "SR-Medizinelektronik",
+	0x00a2: "Vertu Corporation Limited",
+	0x00a3: "Meta Watch Ltd.",
+	0x00a4: "LINAK A/S",
+	0x00a5: "OTL Dynamics LLC",
+	0x00a6: "Panda Ocean Inc.",
+	0x00a7: "Visteon Corporation",
+	0x00a8: "ARP Devices Limited",
+	0x00a9: "MARELLI EUROPE S.P.A.",
+	0x00aa: "CAEN RFID srl",
+	0x00ab: "Ingenieur-Systemgruppe Zahn GmbH",
+	0x00ac: "Green Throttle Games",
+	0x00ad: "Peter Systemtechnik GmbH",
+	0x00ae: "Omegawave Oy",
+	0x00af: "Cinetix",
+	0x00b0: "Passif Semiconductor Corp",
+	0x00b1: "Saris Cycling Group, Inc",
+	0x00b2: "Bekey A/S",
+	0x00b3: "Clarinox Technologies Pty. Ltd.",
+	0x00b4: "BDE Technology Co., Ltd.",
+	0x00b5: "Swirl Networks",
+	0x00b6: "Meso international",
+	0x00b7: "TreLab Ltd",
+	0x00b8: "Qualcomm Innovation Center, Inc. (QuIC)",
+	0x00b9: "Johnson Controls, Inc.",
+	0x00ba: "Starkey Hearing Technologies",
+	0x00bb: "S-Power Electronics Limited",
+	0x00bc: "Ace Sensor Inc",
+	0x00bd: "Aplix Corporation",
+	0x00be: "AAMP of America",
+	0x00bf: "Stalmart Technology Limited",
+	0x00c0: "AMICCOM Electronics Corporation",
+	0x00c1: "Shenzhen Excelsecu Data Technology Co.,Ltd",
+	0x00c2: "Geneq Inc.",
+	0x00c3: "adidas AG",
+	0x00c4: "LG Electronics",
+	0x00c5: "Onset Computer Corporation",
+	0x00c6: "Selfly BV",
+	0x00c7: "Quuppa Oy.",
+	0x00c8: "GeLo Inc",
+	0x00c9: "Evluma",
+	0x00ca: "MC10",
+	0x00cb: "Binauric SE",
+	0x00cc: "Beats Electronics",
+	0x00cd: "Microchip Technology Inc.",
+	0x00ce: "Eve Systems GmbH",
+	0x00cf: "ARCHOS SA",
+	0x00d0: "Dexcom, Inc.",
+	0x00d1: "Polar Electro Europe B.V.",
+	0x00d2: "Renesas Design Netherlands B.V.",
+	0x00d3: "Taixingbang Technology (HK) Co,. LTD.",
+	0x00d5: "Austco Communication Systems",
+	0x00d6: "Timex Group USA, Inc.",
+	0x00d7: "Qualcomm Technologies, Inc.",
+	0x00d8: "Qualcomm Connected Experiences, Inc.",
+	0x00d9: "Voyetra Turtle Beach",
+	0x00da: "txtr GmbH",
+	0x00db: "Snuza (Pty) Ltd",
+	0x00dc: "Procter & Gamble",
+	0x00dd: "Hosiden Corporation",
+	0x00de: "Muzik LLC",
+	0x00df: "Misfit Wearables Corp",
+	0x00e0: "Google",
+	0x00e1: "Danlers Ltd",
+	0x00e2: "Semilink Inc",
+	0x00e3: "inMusic Brands, Inc",
+	0x00e4: "L.S. Research, Inc.",
+	0x00e5: "Eden Software Consultants Ltd.",
+	0x00e7: "KS Technologies",
+	0x00e8: "ACTS Technologies",
+	0x00e9: "Vtrack Systems",
+	0x00ea: "Nielsen-Kellerman",
+	0x00eb: "Server Technology Inc.",
+	0x00ec: "BioResearch Associates",
+	0x00ed: "Jolly Logic, LLC",
+	0x00ee: "Above Average Outcomes, Inc.",
+	0x00ef: "Bitsplitters GmbH",
+	0x00f0: "PayPal, Inc.",
+	0x00f1: "Witron Technology Limited",
+	0x00f2: "Morse Project Inc.",
+	0x00f3: "Kent Displays Inc.",
+	0x00f4: "Nautilus Inc.",
+	0x00f5: "Smartifier Oy",
+	0x00f7: "VSN Technologies, Inc.",
+	0x00f8: "AceUni Corp., Ltd.",
+	0x00fa: "Crystal Alarm AB",
+	0x00fb: "KOUKAAM a.s.",
+	0x00fc: "Delphi Corporation",
+	0x00fd: "ValenceTech Limited",
+	0x00fe: "Stanley Black and Decker",
+	0x00ff: "Typo Products, LLC",
+	0x0100: "TomTom International BV",
+	0x0101: "Fugoo, Inc.",
+	0x0102: "Keiser Corporation",
+	0x0103: "Bang & Olufsen A/S",
+	0x0104: "PLUS Location Systems Pty Ltd",
+	0x0105: "Ubiquitous Computing Technology Corporation",
+	0x0106: "Innovative Yachtter Solutions",
+	0x0107: "Demant A/S",
+	0x0108: "Chicony Electronics Co., Ltd.",
+	0x0109: "Atus BV",
+	0x010a: "Codegate Ltd",
+	0x010b: "ERi, Inc",
+	0x010c: "Transducers Direct, LLC",
+	0x010d: "DENSO TEN Limited",
+	0x010e: "Audi AG",
+	0x010f: "HiSilicon Technologies CO., LIMITED",
+	0x0110: "Nippon Seiki Co., Ltd.",
+	0x0111: "Steelseries ApS",
+	0x0112: "Visybl Inc.",
+	0x0113: "Openbrain Technologies, Co., Ltd.",
+	0x0115: "e.solutions",
+	0x0116: "10AK Technologies",
+	0x0117: "Wimoto Technologies Inc",
+	0x0118: "Radius Networks, Inc.",
+	0x011a: "Qualcomm Labs, Inc.",
+	0x011b: "Hewlett Packard Enterprise",
+	0x011c: "Baidu",
+	0x011d: "Arendi AG",
+	0x011e: "Skoda Auto a.s.",
+	0x011f: "Volkswagen AG",
+	0x0120: "Porsche AG",
+	0x0121: "Sino Wealth Electronic Ltd.",
+	0x0122: "AirTurn, Inc.",
+	0x0123: "Kinsa, Inc",
+	0x0124: "HID Global",
+	0x0125: "SEAT es",
+	0x0126: "Promethean Ltd.",
+	0x0127: "Salutica Allied Solutions",
+	0x0128: "GPSI Group Pty Ltd",
+	0x0129: "Nimble Devices Oy",
+	0x012a: "Changzhou Yongse Infotech  Co., Ltd.",
+	0x012b: "SportIQ",
+	0x012c: "TEMEC Instruments B.V.",
+	0x012d: "Sony Corporation",
+	0x012e: "ASSA ABLOY",
+	0x012f: "Clarion Co. Inc.",
+	0x0130: "Warehouse Innovations",
+	0x0131: "Cypress Semiconductor",
+	0x0132: "MADS Inc",
+	0x0133: "Blue Maestro Limited",
+	0x0134: "Resolution Products, Ltd.",
+	0x0135: "Aireware LLC",
+	0x0136: "Silvair, Inc.",
+	0x0137: "Prestigio Plaza Ltd.",
+	0x0138: "NTEO Inc.",
+	0x0139: "Focus Systems Corporation",
+	0x013a: "Tencent Holdings Ltd.",
+	0x013b: "Allegion",
+	0x013c: "Murata Manufacturing Co., Ltd.",
+	0x013d: "WirelessWERX",
+	0x013e: "Nod, Inc.",
+	0x0140: "Alpine Electronics (China) Co., Ltd",
+	0x0141: "FedEx Services",
+	0x0142: "Grape Systems Inc.",
+	0x0143: "Bkon Connect",
+	0x0144: "Lintech GmbH",
+	0x0145: "Novatel Wireless",
+	0x0146: "Ciright",
+	0x0147: "Mighty Cast, Inc.",
+	0x0148: "Ambimat Electronics",
+	0x0149: "Perytons Ltd.",
+	0x014a: "Tivoli Audio, LLC",
+	0x014b: "Master Lock",
+	0x014c: "Mesh-Net Ltd",
+	0x014d: "HUIZHOU DESAY SV AUTOMOTIVE CO., LTD.",
+	0x014e: "Tangerine, Inc.",
+	0x014f: "B&W Group Ltd.",
+	0x0150: "Pioneer Corporation",
+	0x0151: "OnBeep",
+	0x0152: "Vernier Software & Technology",
+	0x0153: "ROL Ergo",
+	0x0154: "Pebble Technology",
+	0x0155: "NETATMO",
+	0x0156: "Accumulate AB",
+	0x0157: "Anhui Huami Information Technology Co., Ltd.",
+	0x0158: "Inmite s.r.o.",
+	0x0159: "ChefSteps, Inc.",
+	0x015a: "micas AG",
+	0x015b: "Biomedical Research Ltd.",
+	0x015c: "Pitius Tec S.L.",
+	0x015d: "Estimote, Inc.",
+	0x015e: "Unikey Technologies, Inc.",
+	0x015f: "Timer Cap Co.",
+	0x0160: "AwoX",
+	0x0161: "yikes",
+	0x0162: "MADSGlobalNZ Ltd.",
+	0x0163: "PCH International",
+	0x0164: "Qingdao Yeelink Information Technology Co., Ltd.",
+	0x0165: "Milwaukee Electric Tools",
+	0x0166: "MISHIK Pte Ltd",
+	0x0167: "Ascensia Diabetes Care US Inc.",
+	0x0168: "Spicebox LLC",
+	0x0169: "emberlight",
+	0x016a: "Copeland Cold Chain LP",
+	0x016b: "Qblinks",
+	0x016c: "MYSPHERA",
+	0x016d: "LifeScan Inc",
+	0x016e: "Volantic AB",
+	0x016f: "Podo Labs, Inc",
+	0x0170: "Roche Diabetes Care AG",
+	0x0171: "Amazon.com Services LLC",
+	0x0172: "Connovate Technology Private Limited",
+	0x0173: "Kocomojo, LLC",
+	0x0174: "Everykey Inc.",
+	0x0175: "Dynamic Controls",
+	0x0176: "SentriLock",
+	0x0177: "I-SYST inc.",
+	0x0178: "CASIO COMPUTER CO., LTD.",
+	0x0179: "LAPIS Semiconductor Co.,Ltd",
+	0x017a: "Telemonitor, Inc.",
+	0x017b: "taskit GmbH",
+	0x017c: "Mercedes-Benz Group AG",
+	0x017d: "BatAndCat",
+	0x017e: "BluDotz Ltd",
+	0x017f: "XTel Wireless ApS",
+	0x0180: "Gigaset Technologies GmbH",
+	0x0181: "Gecko Health Innovations, Inc.",
+	0x0183: "Walt Disney",
+	0x0184: "Nectar",
+	0x0187: "Seraphim Sense Ltd",
+	0x0188: "Unico RBC",
+	0x0189: "Physical Enterprises Inc.",
+	0x018a: "Able Trend Technology Limited",
+	0x018b: "Konica Minolta, Inc.",
+	0x018c: "Wilo SE",
+	0x018d: "Extron Design Services",
+	0x018e: "Google LLC",
+	0x0190: "Intelletto Technologies Inc.",
+	0x0191: "FDK CORPORATION",
+	0x0192: "Cloudleaf, Inc",
+	0x0193: "Maveric Automation LLC",
+	0x0194: "Acoustic Stream Corporation",
+	0x0195: "Zuli",
+	0x0196: "Paxton Access Ltd",
+	0x0197: "WiSilica Inc.",
+	0x0198: "VENGIT Korlatolt Felelossegu Tarsasag",
+	0x0199: "SALTO SYSTEMS S.L.",
+	0x019a: "TRON Forum",
+	0x019b: "CUBETECH s.r.o.",
+	0x019c: "Cokiya Incorporated",
+	0x019d: "CVS Health",
+	0x019e: "Ceruus",
+	0x019f: "Strainstall Ltd",
+	0x01a0: "Channel Enterprises (HK) Ltd.",
+	0x01a1: "FIAMM",
+	0x01a2: "GIGALANE.CO.,LTD",
+	0x01a4: "MSA Innovation, LLC",
+	0x01a5: "Icon Health and Fitness",
+	0x01a6: "Wille Engineering",
+	0x01a7: "ENERGOUS CORPORATION",
+	0x01a8: "Taobao",
+	0x01a9: "Canon Inc.",
+	0x01aa: "Geophysical Technology Inc.",
+	0x01ab: "Meta Platforms, Inc.",
+	0x01ac: "Trividia Health, Inc.",
+	0x01ad: "FlightSafety International",
+	0x01af: "Sunrise Micro Devices, Inc.",
+	0x01b0: "Star Micronics Co., Ltd.",
+	0x01b1: "Netizens Sp. z o.o.",
+	0x01b2: "Nymi Inc.",
+	0x01b3: "Nytec, Inc.",
+	0x01b4: "Trineo Sp. z o.o.",
+	0x01b5: "Nest Labs Inc.",
+	0x01b6: "LM Technologies Ltd",
+	0x01b7: "General Electric Company",
+	0x01b8: "i+D3 S.L.",
+	0x01b9: "HANA Micron",
+	0x01ba: "SPIA Cycling Inc.",
+	0x01bb: "Cochlear Bone Anchored Solutions AB",
+	0x01bc: "SenionLab AB",
+	0x01bd: "Syszone Co., Ltd",
+	0x01be: "Pulsate Mobile Ltd.",
+	0x01bf: "Hongkong OnMicro Electronics Limited",
+	0x01c1: "BRADATECH Corp.",
+	0x01c2: "Transenergooil AG",
+	0x01c4: "DME Microelectronics",
+	0x01c5: "Bitcraze AB",
+	0x01c6: "HASWARE Inc.",
+	0x01c7: "Abiogenix Inc.",
+	0x01c8: "Poly-Control ApS",
+	0x01c9: "Avi-on",
+	0x01ca: "Laerdal Medical AS",
+	0x01cb: "Fetch My Pet",
+	0x01cc: "Sam Labs Ltd.",
+	0x01cd: "Chengdu Synwing Technology Ltd",
+	0x01ce: "HOUWA SYSTEM DESIGN, k.k.",
+	0x01cf: "BSH",
+	0x01d0: "Primus Inter Pares Ltd",
+	0x01d1: "August Home, Inc",
+	0x01d2: "Gill Electronics",
+	0x01d3: "Sky Wave Design",
+	0x01d4: "Newlab S.r.l.",
+	0x01d5: "ELAD srl",
+	0x01d6: "G-wearables inc.",
+	0x01d8: "Code Corporation",
+	0x01d9: "Savant Systems LLC",
+	0x01da: "Logitech International SA",
+	0x01db: "Innblue Consulting",
+	0x01dd: "Koninklijke Philips N.V.",
+	0x01de: "Minelab Electronics Pty Limited",
+	0x01df: "Bison Group Ltd.",
+	0x01e0: "Widex A/S",
+	0x01e1: "Jolla Ltd",
+	0x01e3: "Caterpillar Inc",
+	0x01e4: "Freedom Innovations",
+	0x01e5: "Dynamic Devices Ltd",
+	0x01e6: "Technology Solutions (UK) Ltd",
+	0x01ea: "Advanced Application Design, Inc.",
+	0x01ec: "Spreadtrum Communications Shanghai Ltd",
+	0x01ee: "Valeo Service",
+	0x01ef: "Fullpower Technologies, Inc.",
+	0x01f0: "KloudNation",
+	0x01f1: "Zebra Technologies Corporation",
+	0x01f2: "Itron, Inc.",
+	0x01f3: "The University of Tokyo",
+	0x01f4: "UTC Fire and Security",
+	0x01f5: "Cool Webthings Limited",
+	0x01f6: "DJO Global",
+	0x01f7: "Gelliner Limited",
+	0x01f8: "Anyka (Guangzhou) Microelectronics Technology Co, LTD",
+	0x01f9: "Medtronic Inc.",
+	0x01fa: "Gozio Inc.",
+	0x01fb: "Form Lifting, LLC",
+	0x01fc: "Wahoo Fitness, LLC",
+	0x01fd: "Kontakt Micro-Location Sp. z o.o.",
+	0x01fe: "Radio Systems Corporation",
+	0x01ff: "Freescale Semiconductor, Inc.",
+	0x0200: "Verifone Systems Pte Ltd. Taiwan Branch",
+	0x0201: "AR Timing",
+	0x0202: "Rigado LLC",
+	0x0203: "Kemppi Oy",
+	0x0206: "Otter Products, LLC",
+	0x0207: "STEMP Inc.",
+	0x0208: "LumiGeek LLC",
+	0x0209: "InvisionHeart Inc.",
+	0x020a: "Macnica Inc.",
+	0x020b: "Jaguar Land Rover Limited",
+	0x020c: "CoroWare Technologies, Inc",
+	0x020e: "Omron Healthcare Co., LTD",
+	0x020f: "Comodule GMBH",
+	0x0210: "ikeGPS",
+	0x0211: "Telink Semiconductor Co. Ltd",
+	0x0212: "Interplan Co., Ltd",
+	0x0213: "Wyler AG",
+	0x0214: "IK Multimedia Production srl",
+	0x0215: "Lukoton Experience Oy",
+	0x0216: "MTI Ltd",
+	0x0217: "Tech4home, Lda",
+	0x0219: "DOTT Limited",
+	0x021a: "Blue Speck Labs, LLC",
+	0x021b: "Cisco Systems, Inc",
+	0x021c: "Mobicomm Inc",
+	0x021d: "Edamic",
+	0x021e: "Goodnet, Ltd",
+	0x021f: "Luster Leaf Products  Inc",
+	0x0220: "Manus Machina BV",
+	0x0221: "Mobiquity Networks Inc",
+	0x0222: "Praxis Dynamics",
+	0x0223: "Philip Morris Products S.A.",
+	0x0224: "Comarch SA",
+	0x0225: "Nestlé Nespresso S.A.",
+	0x0226: "Merlinia A/S",
+	0x0227: "LifeBEAM Technologies",
+	0x0228: "Twocanoes Labs, LLC",
+	0x0229: "Muoverti Limited",
+	0x022a: "Stamer Musikanlagen GMBH",
+	0x022b: "Tesla, Inc.",
+	0x022c: "Pharynks Corporation",
+	0x022d: "Lupine",
+	0x022e: "Siemens AG",
+	0x0230: "Foster Electric Company, Ltd",
+	0x0231: "ETA SA",
+	0x0232: "x-Senso Solutions Kft",
+	0x0234: "FengFan (BeiJing) Technology Co, Ltd",
+	0x0235: "Qrio Inc",
+	0x0236: "Pitpatpet Ltd",
+	0x0237: "MSHeli s.r.l.",
+	0x0238: "Trakm8 Ltd",
+	0x0239: "JIN CO, Ltd",
+	0x023a: "Alatech Tehnology",
+	0x023b: "Beijing CarePulse Electronic Technology Co, Ltd",
+	0x023d: "ViCentra B.V.",
+	0x023e: "Raven Industries",
+	0x023f: "WaveWare Technologies Inc.",
+	0x0240: "Argenox Technologies",
+	0x0241: "Bragi GmbH",
+	0x0243: "Masimo Corp",
+	0x0244: "Iotera Inc",
+	0x0245: "Endress+Hauser",
+	0x0246: "ACKme Networks, Inc.",
+	0x0247: "FiftyThree Inc.",
+	0x0248: "Parker Hannifin Corp",
+	0x024a: "Uwatec AG",
+	0x024b: "Orlan LLC",
+	0x024c: "Blue Clover Devices",
+	0x024d: "M-Way Solutions GmbH",
+	0x024e: "Microtronics Engineering GmbH",
+	0x024f: "Schneider Schreibgeräte GmbH",
+	0x0250: "Sapphire Circuits LLC",
+	0x0251: "Lumo Bodytech Inc.",
+	0x0252: "Restar Corporation",
+	0x0253: "Xicato Inc.",
+	0x0254: "Playbrush",
+	0x0255: "Dai Nippon Printing Co., Ltd.",
+	0x0256: "G24 Power Limited",
+	0x0257: "AdBabble Local Commerce Inc.",
+	0x0258: "Devialet SA",
+	0x0259: "ALTYOR",
+	0x025a: "University of Applied Sciences Valais/Haute Ecole Valaisanne",
+	0x025b: "Five Interactive, LLC dba Zendo",
+	0x025c: "NetEase（Hangzhou）Network co.Ltd.",
+	0x025d: "Lexmark International Inc.",
+	0x025e: "Fluke Corporation",
+	0x025f: "Yardarm Technologies",
+	0x0261: "SECVRE GmbH",
+	0x0262: "Glacial Ridge Technologies",
+	0x0264: "DDS, Inc.",
+	0x0265: "SMK Corporation",
+	0x0266: "Schawbel Technologies LLC",
+	0x0267: "XMI Systems SA",
+	0x0268: "Cerevo",
+	0x0269: "Torrox GmbH & Co KG",
+	0x026a: "Gemalto",
+	0x026b: "DEKA Research & Development Corp.",
+	0x026c: "Domster Tadeusz Szydlowski",
+	0x026d: "Technogym SPA",
+	0x026e: "FLEURBAEY BVBA",
+	0x026f: "Aptcode Solutions",
+	0x0270: "LSI ADL Technology",
+	0x0271: "Animas Corp",
+	0x0272: "Alps Alpine Co., Ltd.",
+	0x0273: "OCEASOFT",
+	0x0274: "Motsai Research",
+	0x0275: "Geotab",
+	0x0276: "E.G.O. Elektro-Geraetebau GmbH",
+	0x0277: "bewhere inc",
+	0x0278: "Johnson Outdoors Inc",
+	0x0279: "steute Schaltgerate GmbH & Co. KG",
+	0x027a: "Ekomini inc.",
+	0x027b: "DEFA AS",
+	0x027c: "Aseptika Ltd",
+	0x027d: "HUAWEI Technologies Co., Ltd.",
+	0x027e: "HabitAware, LLC",
+	0x027f: "ruwido austria gmbh",
+	0x0280: "ITEC corporation",
+	0x0281: "StoneL",
+	0x0282: "Sonova AG",
+	0x0283: "Maven Machines, Inc.",
+	0x0284: "Synapse Electronics",
+	0x0285: "WOWTech Canada Ltd.",
+	0x0286: "RF Code, Inc.",
+	0x0287: "Wally Ventures S.L.",
+	0x0289: "SK Telecom",
+	0x028a: "Jetro AS",
+	0x028b: "Code Gears LTD",
+	0x028c: "NANOLINK APS",
+	0x028e: "RF Digital Corp",
+	0x028f: "Church & Dwight Co., Inc",
+	0x0290: "Multibit Oy",
+	0x0291: "CliniCloud Inc",
+	0x0293: "Blue Bite",
+	0x0294: "ELIAS GmbH",
+	0x0295: "Sivantos GmbH",
+	0x0296: "Petzl",
+	0x0297: "storm power ltd",
+	0x0298: "EISST Ltd",
+	0x0299: "Inexess Technology Simma KG",
+	0x029a: "Currant, Inc.",
+	0x029b: "C2 Development, Inc.",
+	0x029c: "Blue Sky Scientific, LLC",
+	0x029d: "ALOTTAZS LABS, LLC",
+	0x029e: "Kupson spol. s r.o.",
+	0x029f: "Areus Engineering GmbH",
+	0x02a0: "Impossible Camera GmbH",
+	0x02a2: "Sera4 Ltd.",
+	0x02a3: "Itude",
+	0x02a4: "Pacific Lock Company",
+	0x02a5: "Tendyron Corporation",
+	0x02a6: "Robert Bosch GmbH",
+	0x02a7: "Illuxtron international B.V.",
+	0x02a8: "miSport Ltd.",
+	0x02a9: "Chargelib",
+	0x02aa: "Doppler Lab",
+	0x02ab: "BBPOS Limited",
+	0x02ad: "Rx Networks, Inc.",
+	0x02ae: "WeatherFlow, Inc.",
+	0x02af: "Technicolor USA Inc.",
+	0x02b0: "Bestechnic(Shanghai),Ltd",
+	0x02b1: "Raden Inc",
+	0x02b2: "Oura Health Oy",
+	0x02b3: "CLABER S.P.A.",
+	0x02b4: "Hyginex, Inc.",
+	0x02b5: "HANSHIN ELECTRIC RAILWAY CO.,LTD.",
+	0x02b6: "Schneider Electric",
+	0x02b7: "Oort Technologies LLC",
+	0x02b8: "Chrono Therapeutics",
+	0x02b9: "Rinnai Corporation",
+	0x02ba: "Swissprime Technologies AG",
+	0x02bb: "YOKOWO CO., LTD.",
+	0x02bc: "Genevac Ltd",
+	0x02bd: "Chemtronics",
+	0x02be: "Seguro Technology Sp. z o.o.",
+	0x02c0: "Dash Robotics",
+	0x02c1: "LINE Corporation",
+	0x02c2: "Guillemot Corporation",
+	0x02c3: "Techtronic Power Tools Technology Limited",
+	0x02c4: "Wilson Sporting Goods",
+	0x02c5: "Lenovo (Singapore) Pte Ltd.",
+	0x02c6: "Ayatan Sensors",
+	0x02c7: "Electronics Tomorrow Limited",
+	0x02c8: "OneSpan",
+	0x02c9: "PayRange Inc.",
+	0x02ca: "ABOV Semiconductor",
+	0x02cb: "AINA-Wireless Inc.",
+	0x02cd: "BMA ergonomics b.v.",
+	0x02ce: "Teva Branded Pharmaceutical Products R&D, Inc.",
+	0x02cf: "Anima",
+	0x02d0: "3M",
+	0x02d1: "Empatica Srl",
+	0x02d2: "Afero, Inc.",
+	0x02d3: "Powercast Corporation",
+	0x02d4: "Secuyou ApS",
+	0x02d5: "OMRON Corporation",
+	0x02d6: "Send Solutions",
+	0x02d7: "NIPPON SYSTEMWARE CO.,LTD.",
+	0x02d8: "Neosfar",
+	0x02d9: "Fliegl Agrartechnik GmbH",
+	0x02da: "Gilvader",
+	0x02db: "Digi International Inc (R)",
+	0x02dc: "DeWalch Technologies, Inc.",
+	0x02dd: "Flint Rehabilitation Devices, LLC",
+	0x02de: "Samsung SDS Co., Ltd.",
+	0x02df: "Blur Product Development",
+	0x02e0: "University of Michigan",
+	0x02e1: "Victron Energy BV",
+	0x02e2: "NTT docomo",
+	0x02e3: "Carmanah Technologies Corp.",
+	0x02e4: "Bytestorm Ltd.",
+	0x02e5: "Espressif Systems (Shanghai) Co., Ltd.",
+	0x02e6: "Unwire",
+	0x02e7: "Connected Yard, Inc.",
+	0x02e8: "American Music Environments",
+	0x02e9: "Sensogram Technologies, Inc.",
+	0x02ea: "Fujitsu Limited",
+	0x02eb: "Ardic Technology",
+	0x02ed: "HTC Corporation",
+	0x02ee: "Citizen Holdings Co., Ltd.",
+	0x02ef: "SMART-INNOVATION.inc",
+	0x02f0: "Blackrat Software",
+	0x02f1: "The Idea Cave, LLC",
+	0x02f2: "GoPro, Inc.",
+	0x02f3: "AuthAir, Inc",
+	0x02f4: "Vensi, Inc.",
+	0x02f5: "Indagem Tech LLC",
+	0x02f6: "Intemo Technologies",
+	0x02f8: "Runteq Oy Ltd",
+	0x02f9: "IMAGINATION TECHNOLOGIES LTD",
+	0x02fb: "Clarius Mobile Health Corp.",
+	0x02fc: "Shanghai Frequen Microelectronics Co., Ltd.",
+	0x02fe: "Lierda Science & Technology Group Co., Ltd.",
+	0x02ff: "Silicon Laboratories",
+	0x0300: "World Moto Inc.",
+	0x0301: "Giatec Scientific Inc.",
+	0x0302: "Loop Devices, Inc",
+	0x0303: "IACA electronique",
+	0x0304: "Oura Health Ltd",
+	0x0305: "Swipp ApS",
+	0x0306: "Life Laboratory Inc.",
+	0x0307: "FUJI INDUSTRIAL CO.,LTD.",
+	0x0308: "Surefire, LLC",
+	0x0309: "Dolby Labs",
+	0x030a: "Ellisys",
+	0x030b: "Magnitude Lighting Converters",
+	0x030c: "Hilti AG",
+	0x030d: "Devdata S.r.l.",
+	0x030f: "Shortcut Labs",
+	0x0310: "SGL Italia S.r.l.",
+	0x0311: "PEEQ DATA",
+	0x0312: "Ducere Technologies Pvt Ltd",
+	0x0313: "DiveNav, Inc.",
+	0x0314: "RIIG AI Sp. z o.o.",
+	0x0315: "Thermo Fisher Scientific",
+	0x0316: "AG Measurematics Pvt. Ltd.",
+	0x0317: "CHUO Electronics CO., LTD.",
+	0x0318: "Aspenta International",
+	0x0319: "Eugster Frismag AG",
+	0x031a: "Wurth Elektronik eiSos GmbH & Co. KG",
+	0x031b: "HQ Inc",
+	0x031c: "Lab Sensor Solutions",
+	0x031d: "Enterlab ApS",
+	0x031e: "Eyefi, Inc.",
+	0x031f: "MetaSystem S.p.A.",
+	0x0320: "SONO ELECTRONICS. CO., LTD",
+	0x0323: "Rotor Bike Components",
+	0x0324: "Astro, Inc.",
+	0x0326: "Healthwear Technologies (Changzhou)Ltd",
+	0x0327: "Essex Electronics",
+	0x0328: "Grundfos A/S",
+	0x0329: "Eargo, Inc.",
+	0x032a: "Electronic Design Lab",
+	0x032b: "ESYLUX",
+	0x032c: "NIPPON SMT.CO.,Ltd",
+	0x032d: "BM innovations GmbH",
+	0x032e: "indoormap",
+	0x032f: "OttoQ Inc",
+	0x0330: "North Pole Engineering",
+	0x0331: "3flares Technologies Inc.",
+	0x0333: "Mul-T-Lock",
+	0x0334: "Airthings ASA",
+	0x0335: "Enlighted Inc",
+	0x0336: "GISTIC",
+	0x0337: "AJP2 Holdings, LLC",
+	0x0338: "COBI GmbH",
+	0x0339: "Blue Sky Scientific, LLC",
+	0x033a: "Appception, Inc.",
+	0x033b: "Courtney Thorne Limited",
+	0x033d: "TPV Technology Limited",
+	0x033e: "Monitra SA",
+	0x033f: "Automation Components, Inc.",
+	0x0341: "Etesian Technologies LLC",
+	0x0342: "GERTEC BRASIL LTDA.",
+	0x0343: "Drekker Development Pty. Ltd.",
+	0x0344: "Whirl Inc",
+	0x0345: "Locus Positioning",
+	0x0346: "Acuity Brands Lighting, Inc",
+	0x0347: "Prevent Biometrics",
+	0x0349: "VersaMe",
+	0x034b: "Libratone A/S",
+	0x034c: "HM Electronics, Inc.",
+	0x034d: "TASER International, Inc.",
+	0x034f: "Heartland Payment Systems",
+	0x0350: "Bitstrata Systems Inc.",
+	0x0351: "Pieps GmbH",
+	0x0352: "iRiding(Xiamen)Technology Co.,Ltd.",
+	0x0353: "Alpha Audiotronics, Inc.",
+	0x0354: "TOPPAN, Inc.",
+	0x0355: "Sigma Designs, Inc.",
+	0x0356: "Spectrum Brands, Inc.",
+	0x0357: "Polymap Wireless",
+	0x0358: "MagniWare Ltd.",
+	0x0359: "Novotec Medical GmbH",
+	0x035a: "Phillips-Medisize A/S",
+	0x035b: "Matrix Inc.",
+	0x035c: "Eaton Corporation",
+	0x035d: "KYS",
+	0x035e: "Naya Health, Inc.",
+	0x035f: "Acromag",
+	0x0360: "Insulet Corporation",
+	0x0361: "Wellinks Inc.",
+	0x0362: "ON Semiconductor",
+	0x0363: "FREELAP SA",
+	0x0364: "Favero Electronics Srl",
+	0x0365: "BioMech Sensor LLC",
+	0x0366: "BOLTT Sports technologies Private limited",
+	0x0368: "Metormote AB",
+	0x0369: "littleBits",
+	0x036a: "SetPoint Medical",
+	0x036b: "BRControls Products BV",
+	0x036c: "Zipcar",
+	0x036d: "AirBolt Pty Ltd",
+	0x036e: "MOTIVE TECHNOLOGIES, INC.",
+	0x036f: "Motiv, Inc.",
+	0x0370: "Wazombi Labs OÜ",
+	0x0372: "Nixie Labs, Inc.",
+	0x0373: "AppNearMe Ltd",
+	0x0374: "Holman Industries",
+	0x0375: "Expain AS",
+	0x0376: "Electronic Temperature Instruments Ltd",
+	0x0377: "Plejd AB",
+	0x0378: "Propeller Health",
+	0x0379: "Shenzhen iMCO Electronic Technology Co.,Ltd",
+	0x037a: "Algoria",
+	0x037b: "Apption Labs Inc.",
+	0x037c: "Cronologics Corporation",
+	0x037d: "MICRODIA Ltd.",
+	0x037e: "lulabytes S.L.",
+	0x037f: "Société des Produits Nestlé S.A.",
+	0x0380: "LLC \"MEGA-F service\"",
+	0x0381: "Sharp Corporation",
+	0x0382: "Precision Outcomes Ltd",
+	0x0383: "Kronos Incorporated",
+	0x0385: "Embedded Electronic Solutions Ltd. dba e2Solutions",
+	0x0386: "Aterica Inc.",
+	0x0387: "BluStor PMC, Inc.",
+	0x0388: "Kapsch TrafficCom AB",
+	0x0389: "ActiveBlu Corporation",
+	0x038a: "Kohler Mira Limited",
+	0x038b: "Noke",
+	0x038c: "Appion Inc.",
+	0x038d: "Resmed Ltd",
+	0x038e: "Crownstone B.V.",
+	0x038f: "Xiaomi Inc.",
+	0x0390: "INFOTECH s.r.o.",
+	0x0391: "Thingsquare AB",
+	0x0392: "T&D",
+	0x0393: "LAVAZZA S.p.A.",
+	0x0395: "SDATAWAY",
+	0x0396: "BLOKS GmbH",
+	0x0397: "LEGO System A/S",
+	0x0398: "Thetatronics Ltd",
+	0x0399: "Nikon Corporation",
+	0x039a: "NeST",
+	0x039b: "South Silicon Valley Microelectronics",
+	0x039c: "ALE International",
+	0x039d: "CareView Communications, Inc.",
+	0x039e: "SchoolBoard Limited",
+	0x039f: "Molex Corporation",
+	0x03a0: "IVT Wireless Limited",
+	0x03a1: "Alpine Labs LLC",
+	0x03a2: "Candura Instruments",
+	0x03a3: "SmartMovt Technology Co., Ltd",
+	0x03a4: "Token Zero Ltd",
+	0x03a5: "ACE CAD Enterprise Co., Ltd. (ACECAD)",
+	0x03a6: "Medela, Inc",
+	0x03a7: "AeroScout",
+	0x03a8: "Esrille Inc.",
+	0x03aa: "Exon Sp. z o.o.",
+	0x03ab: "Meizu Technology Co., Ltd.",
+	0x03ad: "XiQ",
+	0x03ae: "Allswell Inc.",
+	0x03af: "Comm-N-Sense Corp DBA Verigo",
+	0x03b0: "VIBRADORM GmbH",
+	0x03b1: "Otodata Wireless Network Inc.",
+	0x03b2: "Propagation Systems Limited",
+	0x03b3: "Midwest Instruments & Controls",
+	0x03b4: "Alpha Nodus, inc.",
+	0x03b5: "petPOMM, Inc",
+	0x03b6: "Mattel",
+	0x03b7: "Airbly Inc.",
+	0x03b8: "A-Safe Limited",
+	0x03b9: "FREDERIQUE CONSTANT SA",
+	0x03ba: "Maxscend Microelectronics Company Limited",
+	0x03bb: "Abbott",
+	0x03bc: "ASB Bank Ltd",
+	0x03bd: "amadas",
+	0x03be: "Applied Science, Inc.",
+	0x03bf: "iLumi Solutions Inc.",
+	0x03c0: "Arch Systems Inc.",
+	0x03c1: "Ember Technologies, Inc.",
+	0x03c2: "Snapchat Inc",
+	0x03c3: "Casambi Technologies Oy",
+	0x03c4: "Pico Technology Inc.",
+	0x03c5: "St. Jude Medical, Inc.",
+	0x03c6: "Intricon",
+	0x03c7: "Structural Health Systems, Inc.",
+	0x03c8: "Avvel International",
+	0x03c9: "Gallagher Group",
+	0x03ca: "In2things Automation Pvt. Ltd.",
+	0x03cb: "SYSDEV Srl",
+	0x03cc: "Vonkil Technologies Ltd",
+	0x03cd: "Wynd Technologies, Inc.",
+	0x03ce: "CONTRINEX S.A.",
+	0x03cf: "MIRA, Inc.",
+	0x03d0: "Watteam Ltd",
+	0x03d1: "Density Inc.",
+	0x03d2: "IOT Pot India Private Limited",
+	0x03d3: "Sigma Connectivity AB",
+	0x03d4: "PEG PEREGO SPA",
+	0x03d5: "Wyzelink Systems Inc.",
+	0x03d6: "Yota Devices LTD",
+	0x03d7: "FINSECUR",
+	0x03d8: "Zen-Me Labs Ltd",
+	0x03d9: "3IWare Co., Ltd.",
+	0x03da: "EnOcean GmbH",
+	0x03db: "Instabeat, Inc",
+	0x03dc: "Nima Labs",
+	0x03dd: "Andreas Stihl AG & Co. KG",
+	0x03de: "Nathan Rhoades LLC",
+	0x03df: "Grob Technologies, LLC",
+	0x03e0: "Actions Technology Co.,Ltd",
+	0x03e1: "SPD Development Company Ltd",
+	0x03e2: "Sensoan Oy",
+	0x03e3: "Qualcomm Life Inc",
+	0x03e4: "Chip-ing AG",
+	0x03e5: "ffly4u",
+	0x03e6: "IoT Instruments Oy",
+	0x03e7: "TRUE Fitness Technology",
+	0x03e9: "SHENZHEN LEMONJOY TECHNOLOGY CO., LTD.",
+	0x03ea: "Hello Inc.",
+	0x03eb: "Ozo Edu, Inc.",
+	0x03ec: "Jigowatts Inc.",
+	0x03ed: "BASIC MICRO.COM,INC.",
+	0x03ee: "CUBE TECHNOLOGIES",
+	0x03f0: "CLINK",
+	0x03f1: "Hestan Smart Cooking Inc.",
+	0x03f2: "WindowMaster A/S",
+	0x03f4: "PAL Technologies Ltd",
+	0x03f5: "WHERE, Inc.",
+	0x03f6: "Iton Technology Corp.",
+	0x03f7: "Owl Labs Inc.",
+	0x03f8: "Rockford Corp.",
+	0x03f9: "Becon Technologies Co.,Ltd.",
+	0x03fa: "Vyassoft Technologies Inc",
+	0x03fb: "Nox Medical",
+	0x03fc: "Kimberly-Clark",
+	0x03fd: "Trimble Inc.",
+	0x03fe: "Littelfuse",
+	0x03ff: "Withings",
+	0x0400: "i-developer IT Beratung UG",
+	0x0401: "Relations Inc.",
+	0x0402: "Sears Holdings Corporation",
+	0x0403: "Gantner Electronic GmbH",
+	0x0404: "Authomate Inc",
+	0x0405: "Vertex International, Inc.",
+	0x0407: "Swiss Audio SA",
+	0x0408: "ToGetHome Inc.",
+	0x040a: "ZF OPENMATICS s.r.o.",
+	0x040b: "Jana Care Inc.",
+	0x040d: "NorthStar Battery Company, LLC",
+	0x040e: "SKF (U.K.) Limited",
+	0x040f: "CO-AX Technology, Inc.",
+	0x0410: "Fender Musical Instruments",
+	0x0411: "Luidia Inc",
+	0x0412: "SEFAM",
+	0x0413: "Wireless Cables Inc",
+	0x0416: "SODA GmbH",
+	0x0417: "Fatigue Science",
+	0x0419: "Novalogy LTD",
+	0x041a: "Friday Labs Limited",
+	0x041b: "OrthoAccel Technologies",
+	0x041c: "WaterGuru, Inc.",
+	0x041d: "Benning Elektrotechnik und Elektronik GmbH & Co. KG",
+	0x041e: "Dell Computer Corporation",
+	0x041f: "Kopin Corporation",
+	0x0420: "TecBakery GmbH",
+	0x0421: "Backbone Labs, Inc.",
+	0x0422: "DELSEY SA",
+	0x0423: "Chargifi Limited",
+	0x0424: "Trainesense Ltd.",
+	0x0425: "Unify Software and Solutions GmbH & Co. KG",
+	0x0426: "Husqvarna AB",
+	0x0427: "Focus fleet and fuel management inc",
+	0x0428: "SmallLoop, LLC",
+	0x0429: "Prolon Inc.",
+	0x042a: "BD Medical",
+	0x042b: "iMicroMed Incorporated",
+	0x042c: "Ticto N.V.",
+	0x042d: "Meshtech AS",
+	0x042e: "MemCachier Inc.",
+	0x042f: "Danfoss A/S",
+	0x0430: "SnapStyk Inc.",
+	0x0431: "Alticor Inc.",
+	0x0432: "Silk Labs, Inc.",
+	0x0433: "Pillsy Inc.",
+	0x0434: "Hatch Baby, Inc.",
+	0x0435: "Blocks Wearables Ltd.",
+	0x0436: "Drayson Technologies (Europe) Limited",
+	0x0437: "eBest IOT Inc.",
+	0x0438: "Helvar Ltd",
+	0x0439: "Radiance Technologies",
+	0x043a: "Nuheara Limited",
+	0x043b: "Appside co., ltd.",
+	0x043d: "Coiler Corporation",
+	0x043e: "Thermomedics, Inc.",
+	0x043f: "Tentacle Sync GmbH",
+	0x0440: "Valencell, Inc.",
+	0x0442: "SECOM CO., LTD.",
+	0x0443: "Tucker International LLC",
+	0x0444: "Metanate Limited",
+	0x0445: "Kobian Canada Inc.",
+	0x0446: "NETGEAR, Inc.",
+	0x0447: "Fabtronics Australia Pty Ltd",
+	0x0448: "Grand Centrix GmbH",
+	0x0449: "1UP USA.com llc",
+	0x044a: "SHIMANO INC.",
+	0x044b: "Nain Inc.",
+	0x044c: "LifeStyle Lock, LLC",
+	0x044d: "VEGA Grieshaber KG",
+	0x044e: "Xtrava Inc.",
+	0x044f: "TTS Tooltechnic Systems AG & Co. KG",
+	0x0450: "Teenage Engineering AB",
+	0x0451: "Tunstall Nordic AB",
+	0x0452: "Svep Design Center AB",
+	0x0453: "Qorvo Utrecht B.V.",
+	0x0454: "Sphinx Electronics GmbH & Co KG",
+	0x0456: "Nemik Consulting Inc",
+	0x0457: "RF INNOVATION",
+	0x0458: "Mini Solution Co., Ltd.",
+	0x045a: "2048450 Ontario Inc",
+	0x045c: "Delta T Corporation",
+	0x045d: "Boston Scientific Corporation",
+	0x045e: "Nuviz, Inc.",
+	0x045f: "Real Time Automation, Inc.",
+	0x0460: "Kolibree",
+	0x0461: "vhf elektronik GmbH",
+	0x0462: "Bonsai Systems GmbH",
+	0x0463: "Fathom Systems Inc.",
+	0x0464: "Bellman & Symfon Group AB",
+	0x0465: "International Forte Group LLC",
+	0x0467: "Codenex Oy",
+	0x0468: "Kynesim Ltd",
+	0x0469: "Palago AB",
+	0x046a: "INSIGMA INC.",
+	0x046b: "PMD Solutions",
+	0x046c: "Qingdao Realtime Technology Co., Ltd.",
+	0x046d: "BEGA Gantenbrink-Leuchten KG",
+	0x046e: "Pambor Ltd.",
+	0x046f: "Develco Products A/S",
+	0x0470: "iDesign s.r.l.",
+	0x0471: "TiVo Corp",
+	0x0472: "Control-J Pty Ltd",
+	0x0473: "Steelcase, Inc.",
+	0x0474: "iApartment co., ltd.",
+	0x0475: "Icom inc.",
+	0x0477: "Blue Spark Technologies",
+	0x0478: "FarSite Communications Limited",
+	0x0479: "mywerk system GmbH",
+	0x047a: "Sinosun Technology Co., Ltd.",
+	0x047b: "MIYOSHI ELECTRONICS CORPORATION",
+	0x047d: "Occly LLC",
+	0x047e: "OurHub Dev IvS",
+	0x047f: "Pro-Mark, Inc.",
+	0x0481: "Quintrax Limited",
+	0x0482: "POS Tuning Udo Vosshenrich GmbH & Co. KG",
+	0x0484: "Revol Technologies Inc",
+	0x0485: "SKIDATA AG",
+	0x0486: "DEV TECNOLOGIA INDUSTRIA, COMERCIO E MANUTENCAO DE EQUIPAMENTOS LTDA. - ME",
+	0x0487: "Centrica Connected Home",
+	0x0488: "Automotive Data Solutions Inc",
+	0x0489: "Igarashi Engineering",
+	0x048a: "Taelek Oy",
+	0x048c: "Vectronix AG",
+	0x048d: "S-Labs Sp. z o.o.",
+	0x048e: "Companion Medical, Inc.",
+	0x048f: "BlueKitchen GmbH",
+	0x0490: "Matting AB",
+	0x0491: "SOREX - Wireless Solutions GmbH",
+	0x0492: "ADC Technology, Inc.",
+	0x0493: "Lynxemi Pte Ltd",
+	0x0494: "SENNHEISER electronic GmbH & Co. KG",
+	0x0496: "Polymorphic Labs LLC",
+	0x0497: "Cochlear Limited",
+	0x0498: "METER Group, Inc. USA",
+	0x0499: "Ruuvi Innovations Ltd.",
+	0x049a: "Situne AS",
+	0x049b: "nVisti, LLC",
+	0x049c: "DyOcean",
+	0x049d: "Uhlmann & Zacher GmbH",
+	0x049e: "AND!XOR LLC",
+	0x049f: "Popper Pay AB",
+	0x04a2: "ovrEngineered, LLC",
+	0x04a3: "GT-tronics HK Ltd",
+	0x04a4: "Herbert Waldmann GmbH & Co. KG",
+	0x04a5: "Guangzhou FiiO Electronics Technology Co.,Ltd",
+	0x04a6: "Vinetech Co., Ltd",
+	0x04a7: "Dallas Logic Corporation",
+	0x04a8: "BioTex, Inc.",
+	0x04aa: "LINKIO SAS",
+	0x04ab: "Harbortronics, Inc.",
+	0x04ac: "Undagrid B.V.",
+	0x04ad: "Shure Inc",
+	0x04ae: "ERM Electronic Systems LTD",
+	0x04af: "BIOROWER Handelsagentur GmbH",
+	0x04b1: "Kartographers Technologies Pvt. Ltd.",
+	0x04b2: "The Shadow on the Moon",
+	0x04b3: "mobike (Hong Kong) Limited",
+	0x04b4: "Inuheat Group AB",
+	0x04b5: "Swiftronix AB",
+	0x04b6: "Diagnoptics Technologies",
+	0x04b7: "Analog Devices, Inc.",
+	0x04b8: "Soraa Inc.",
+	0x04b9: "CSR Building Products Limited",
+	0x04ba: "Crestron Electronics, Inc.",
+	0x04bb: "Neatebox Ltd",
+	0x04bc: "Draegerwerk AG & Co. KGaA",
+	0x04bd: "AlbynMedical",
+	0x04be: "Averos FZCO",
+	0x04bf: "VIT Initiative, LLC",
+	0x04c0: "Statsports International",
+	0x04c1: "Sospitas, s.r.o.",
+	0x04c2: "Dmet Products Corp.",
+	0x04c3: "Mantracourt Electronics Limited",
+	0x04c4: "TeAM Hutchins AB",
+	0x04c5: "Seibert Williams Glass, LLC",
+	0x04c6: "Insta GmbH",
+	0x04c7: "Svantek Sp. z o.o.",
+	0x04c8: "Shanghai Flyco Electrical Appliance Co., Ltd.",
+	0x04c9: "Thornwave Labs Inc",
+	0x04ca: "Steiner-Optik GmbH",
+	0x04cb: "Novo Nordisk A/S",
+	0x04cd: "Safetech Products LLC",
+	0x04ce: "GOOOLED S.R.L.",
+	0x04cf: "DOM Sicherheitstechnik GmbH & Co. KG",
+	0x04d0: "Olympus Corporation",
+	0x04d1: "KTS GmbH",
+	0x04d2: "Anloq Technologies Inc.",
+	0x04d3: "Queercon, Inc",
+	0x04d4: "TASKA PROSTHETICS LIMITED",
+	0x04d5: "Gooee Limited",
+	0x04d6: "LUGLOC LLC",
+	0x04d7: "Blincam, Inc.",
+	0x04d8: "FUJIFILM Corporation",
+	0x04d9: "RM Acquisition LLC",
+	0x04da: "Franceschi Marina snc",
+	0x04db: "Engineered Audio, LLC.",
+	0x04dc: "IOTTIVE (OPC) PRIVATE LIMITED",
+	0x04dd: "4MOD Technology",
+	0x04de: "Lutron Electronics Co., Inc.",
+	0x04df: "Emerson Electric Co.",
+	0x04e0: "Guardtec, Inc.",
+	0x04e1: "REACTEC LIMITED",
+	0x04e3: "Under Armour",
+	0x04e4: "Woodenshark",
+	0x04e5: "Avack Oy",
+	0x04e6: "Smart Solution Technology, Inc.",
+	0x04e8: "STABILO International",
+	0x04e9: "Busch Jaeger Elektro GmbH",
+	0x04ea: "Pacific Bioscience Laboratories, Inc",
+	0x04eb: "Bird Home Automation GmbH",
+	0x04ec: "Motorola Solutions",
+	0x04ee: "Auxivia",
+	0x04ef: "DaisyWorks, Inc",
+	0x04f0: "Kosi Limited",
+	0x04f1: "Theben AG",
+	0x04f2: "InDreamer Techsol Private Limited",
+	0x04f3: "Cerevast Medical",
+	0x04f4: "ZanCompute Inc.",
+	0x04f5: "Pirelli Tyre S.P.A.",
+	0x04f6: "McLear Limited",
+	0x04f7: "Shenzhen Goodix Technology Co., Ltd",
+	0x04f8: "Convergence Systems Limited",
+	0x04f9: "Interactio",
+	0x04fa: "Androtec GmbH",
+	0x04fb: "Benchmark Drives GmbH & Co. KG",
+	0x04fc: "SwingLync L. L. C.",
+	0x04fd: "Tapkey GmbH",
+	0x04fe: "Woosim Systems Inc.",
+	0x04ff: "Microsemi Corporation",
+	0x0500: "Wiliot LTD.",
+	0x0501: "Polaris IND",
+	0x0502: "Specifi-Kali LLC",
+	0x0503: "Locoroll, Inc",
+	0x0504: "PHYPLUS Inc",
+	0x0505: "InPlay, Inc.",
+	0x0506: "Hager",
+	0x0508: "Axes System sp. z o. o.",
+	0x0509: "Garage Smart, Inc.",
+	0x050a: "Shake-on B.V.",
+	0x050b: "Vibrissa Inc.",
+	0x050c: "OSRAM GmbH",
+	0x050d: "TRSystems GmbH",
+	0x050e: "Yichip Microelectronics (Hangzhou) Co.,Ltd.",
+	0x050f: "Foundation Engineering LLC",
+	0x0510: "UNI-ELECTRONICS, INC.",
+	0x0511: "Brookfield Equinox LLC",
+	0x0512: "Soprod SA",
+	0x0513: "9974091 Canada Inc.",
+	0x0514: "FIBRO GmbH",
+	0x0515: "RB Controls Co., Ltd.",
+	0x0516: "Footmarks",
+	0x0517: "Amtronic Sverige AB",
+	0x0518: "MAMORIO.inc",
+	0x0519: "Tyto Life LLC",
+	0x051a: "Leica Camera AG",
+	0x051c: "EDPS",
+	0x051d: "OFF Line Co., Ltd.",
+	0x051e: "Detect Blue Limited",
+	0x051f: "Setec Pty Ltd",
+	0x0520: "Target Corporation",
+	0x0521: "IAI Corporation",
+	0x0522: "NS Tech, Inc.",
+	0x0523: "MTG Co., Ltd.",
+	0x0524: "Hangzhou iMagic Technology Co., Ltd",
+	0x0525: "HONGKONG NANO IC TECHNOLOGIES  CO., LIMITED",
+	0x0526: "Honeywell International Inc.",
+	0x0527: "Albrecht JUNG",
+	0x0528: "Lunera Lighting Inc.",
+	0x0529: "Lumen UAB",
+	0x052a: "Keynes Controls Ltd",
+	0x052b: "Novartis AG",
+	0x052c: "Geosatis SA",
+	0x052d: "EXFO, Inc.",
+	0x052e: "LEDVANCE GmbH",
+	0x052f: "Center ID Corp.",
+	0x0530: "Adolene, Inc.",
+	0x0531: "D&M Holdings Inc.",
+	0x0532: "CRESCO Wireless, Inc.",
+	0x0533: "Nura Operations Pty Ltd",
+	0x0534: "Frontiergadget, Inc.",
+	0x0535: "Smart Component Technologies Limited",
+	0x0536: "ZTR Control Systems LLC",
+	0x0537: "MetaLogics Corporation",
+	0x0538: "Medela AG",
+	0x0539: "OPPLE Lighting Co., Ltd",
+	0x053a: "Savitech Corp.,",
+	0x053b: "prodigy",
+	0x053c: "Screenovate Technologies Ltd",
+	0x053d: "TESA SA",
+	0x053e: "CLIM8 LIMITED",
+	0x053f: "Silergy Corp",
+	0x0540: "SilverPlus, Inc",
+	0x0541: "Sharknet srl",
+	0x0542: "Mist Systems, Inc.",
+	0x0543: "MIWA LOCK CO.,Ltd",
+	0x0544: "OrthoSensor, Inc.",
+	0x0546: "Apexar Technologies S.A.",
+	0x0547: "LOGICDATA Electronic & Software Entwicklungs GmbH",
+	0x0548: "Knick Elektronische Messgeraete GmbH & Co. KG",
+	0x0549: "Smart Technologies and Investment Limited",
+	0x054a: "Linough Inc.",
+	0x054b: "Advanced Electronic Designs, Inc.",
+	0x054c: "Carefree Scott Fetzer Co Inc",
+	0x054d: "Sensome",
+	0x054e: "FORTRONIK storitve d.o.o.",
+	0x054f: "Sinnoz",
+	0x0551: "Sylero",
+	0x0552: "Avempace SARL",
+	0x0553: "Nintendo Co., Ltd.",
+	0x0554: "National Instruments",
+	0x0555: "KROHNE Messtechnik GmbH",
+	0x0556: "Otodynamics Ltd",
+	0x0557: "Arwin Technology Limited",
+	0x0558: "benegear, inc.",
+	0x0559: "Newcon Optik",
+	0x055a: "CANDY HOUSE, Inc.",
+	0x055b: "FRANKLIN TECHNOLOGY INC",
+	0x055c: "Lely",
+	0x055d: "Valve Corporation",
+	0x055e: "Hekatron Vertriebs GmbH",
+	0x055f: "PROTECH S.A.S. DI GIRARDI ANDREA & C.",
+	0x0560: "Sarita CareTech APS",
+	0x0561: "Finder S.p.A.",
+	0x0562: "Thalmic Labs Inc.",
+	0x0563: "Steinel GmbH",
+	0x0564: "Beghelli Spa",
+	0x0566: "CORE TRANSPORT TECHNOLOGIES NZ LIMITED",
+	0x0567: "Xiamen Everesports Goods Co., Ltd",
+	0x0568: "Bodyport Inc.",
+	0x056a: "Flipnavi Co.,Ltd.",
+	0x056b: "Rion Co., Ltd.",
+	0x056c: "Long Range Systems, LLC",
+	0x056d: "Redmond Industrial Group LLC",
+	0x056e: "VIZPIN INC.",
+	0x056f: "BikeFinder AS",
+	0x0570: "Consumer Sleep Solutions LLC",
+	0x0571: "PSIKICK, INC.",
+	0x0572: "AntTail.com",
+	0x0573: "Lighting Science Group Corp.",
+	0x0574: "AFFORDABLE ELECTRONICS INC",
+	0x0575: "Integral Memroy Plc",
+	0x0576: "Globalstar, Inc.",
+	0x0577: "True Wearables, Inc.",
+	0x0578: "Wellington Drive Technologies Ltd",
+	0x057a: "OMNI Remotes",
+	0x057b: "Duracell U.S. Operations Inc.",
+	0x057c: "Toor Technologies LLC",
+	0x057d: "Instinct Performance",
+	0x057e: "Beco, Inc",
+	0x057f: "Scuf Gaming International, LLC",
+	0x0581: "LYS TECHNOLOGIES LTD",
+	0x0582: "Breakwall Analytics, LLC",
+	0x0583: "Code Blue Communications",
+	0x0584: "Gira Giersiepen GmbH & Co. KG",
+	0x0585: "Hearing Lab Technology",
+	0x0586: "LEGRAND",
+	0x0587: "Derichs GmbH",
+	0x0588: "ALT-TEKNIK LLC",
+	0x0589: "Star Technologies",
+	0x058a: "START TODAY CO.,LTD.",
+	0x058b: "Maxim Integrated Products",
+	0x058c: "Fracarro Radioindustrie SRL",
+	0x058d: "Jungheinrich Aktiengesellschaft",
+	0x058e: "Meta Platforms Technologies, LLC",
+	0x058f: "HENDON SEMICONDUCTORS PTY LTD",
+	0x0590: "Pur3 Ltd",
+	0x0591: "Viasat Group S.p.A.",
+	0x0592: "IZITHERM",
+	0x0593: "Spaulding Clinical Research",
+	0x0594: "Kohler Company",
+	0x0595: "Inor Process AB",
+	0x0596: "My Smart Blinds",
+	0x0597: "RadioPulse Inc",
+	0x0598: "rapitag GmbH",
+	0x0599: "Lazlo326, LLC.",
+	0x059a: "Teledyne Lecroy, Inc.",
+	0x059b: "Dataflow Systems Limited",
+	0x059c: "Macrogiga Electronics",
+	0x059d: "Tandem Diabetes Care",
+	0x059e: "Polycom, Inc.",
+	0x059f: "Fisher & Paykel Healthcare",
+	0x05a0: "Dream Devices Technologies Oy",
+	0x05a1: "Shanghai Xiaoyi Technology Co.,Ltd.",
+	0x05a2: "ADHERIUM(NZ) LIMITED",
+	0x05a3: "Axiomware Systems Incorporated",
+	0x05a4: "O. E. M. Controls, Inc.",
+	0x05a5: "Kiiroo BV",
+	0x05a6: "Telecon Mobile Limited",
+	0x05a7: "Sonos Inc",
+	0x05a8: "Tom Allebrandi Consulting",
+	0x05a9: "Monidor",
+	0x05aa: "Tramex Limited",
+	0x05ab: "Nofence AS",
+	0x05ac: "GoerTek Dynaudio Co., Ltd.",
+	0x05ad: "INIA",
+	0x05ae: "CARMATE MFG.CO.,LTD",
+	0x05af: "OV LOOP, INC.",
+	0x05b0: "NewTec GmbH",
+	0x05b1: "Medallion Instrumentation Systems",
+	0x05b2: "CAREL INDUSTRIES S.P.A.",
+	0x05b3: "Parabit Systems, Inc.",
+	0x05b4: "White Horse Scientific ltd",
+	0x05b5: "verisilicon",
+	0x05b6: "Elecs Industry Co.,Ltd.",
+	0x05b7: "Beijing Pinecone Electronics Co.,Ltd.",
+	0x05b8: "Ambystoma Labs Inc.",
+	0x05b9: "Suzhou Pairlink Network Technology",
+	0x05ba: "igloohome",
+	0x05bb: "Oxford Metrics plc",
+	0x05bc: "Leviton Mfg. Co., Inc.",
+	0x05bd: "ULC Robotics Inc.",
+	0x05bf: "Real-World-Systems Corporation",
+	0x05c0: "Nalu Medical, Inc.",
+	0x05c1: "P.I.Engineering",
+	0x05c2: "Grote Industries",
+	0x05c3: "Runtime, Inc.",
+	0x05c4: "Codecoup sp. z o.o. sp. k.",
+	0x05c5: "SELVE GmbH & Co. KG",
+	0x05c7: "Lippert Components, INC",
+	0x05c8: "SOMFY SAS",
+	0x05c9: "TBS Electronics B.V.",
+	0x05ca: "MHL Custom Inc",
+	0x05cb: "LucentWear LLC",
+	0x05cc: "WATTS ELECTRONICS",
+	0x05cd: "RJ Brands LLC",
+	0x05ce: "V-ZUG Ltd",
+	0x05cf: "Biowatch SA",
+	0x05d0: "Anova Applied Electronics",
+	0x05d1: "Lindab AB",
+	0x05d2: "frogblue TECHNOLOGY GmbH",
+	0x05d3: "Acurable Limited",
+	0x05d4: "LAMPLIGHT Co., Ltd.",
+	0x05d5: "TEGAM, Inc.",
+	0x05d6: "Zhuhai Jieli technology Co.,Ltd",
+	0x05d7: "modum.io AG",
+	0x05d8: "Farm Jenny LLC",
+	0x05d9: "Toyo Electronics Corporation",
+	0x05da: "Applied Neural Research Corp",
+	0x05db: "Avid Identification Systems, Inc.",
+	0x05dc: "Petronics Inc.",
+	0x05dd: "essentim GmbH",
+	0x05de: "QT Medical INC.",
+	0x05df: "VIRTUALCLINIC.DIRECT LIMITED",
+	0x05e0: "Viper Design LLC",
+	0x05e1: "Human, Incorporated",
+	0x05e2: "stAPPtronics GmbH",
+	0x05e3: "Elemental Machines, Inc.",
+	0x05e4: "Taiyo Yuden Co., Ltd",
+	0x05e5: "INEO ENERGY& SYSTEMS",
+	0x05e6: "Motion Instruments Inc.",
+	0x05e7: "PressurePro",
+	0x05e8: "COWBOY",
+	0x05e9: "iconmobile GmbH",
+	0x05ea: "ACS-Control-System GmbH",
+	0x05eb: "Bayerische Motoren Werke AG",
+	0x05ec: "Gycom Svenska AB",
+	0x05ed: "Fuji Xerox Co., Ltd",
+	0x05ef: "SIKOM AS",
+	0x05f0: "beken",
+	0x05f1: "The Linux Foundation",
+	0x05f2: "Try and E CO.,LTD.",
+	0x05f3: "SeeScan",
+	0x05f4: "Clearity, LLC",
+	0x05f5: "GS TAG",
+	0x05f6: "DPTechnics",
+	0x05f7: "TRACMO, INC.",
+	0x05f8: "Anki Inc.",
+	0x05f9: "Hagleitner Hygiene International GmbH",
+	0x05fa: "Konami Sports Life Co., Ltd.",
+	0x05fb: "Arblet Inc.",
+	0x05fc: "Masbando GmbH",
+	0x05fd: "Innoseis",
+	0x05fe: "Niko nv",
+	0x05ff: "Wellnomics Ltd",
+	0x0600: "iRobot Corporation",
+	0x0601: "Schrader Electronics",
+	0x0602: "Geberit International AG",
+	0x0603: "Fourth Evolution Inc",
+	0x0605: "FMW electronic Futterer u. Maier-Wolf OHG",
+	0x0606: "John Deere",
+	0x0607: "Rookery Technology Ltd",
+	0x0608: "KeySafe-Cloud",
+	0x0609: "BUCHI Labortechnik AG",
+	0x060a: "IQAir AG",
+	0x060b: "Triax Technologies Inc",
+	0x060c: "Vuzix Corporation",
+	0x060d: "TDK Corporation",
+	0x060e: "Blueair AB",
+	0x060f: "Signify Netherlands B.V.",
+	0x0610: "ADH GUARDIAN USA LLC",
+	0x0611: "Beurer GmbH",
+	0x0612: "Playfinity AS",
+	0x0613: "Hans Dinslage GmbH",
+	0x0614: "OnAsset Intelligence, Inc.",
+	0x0615: "INTER ACTION Corporation",
+	0x0616: "OS42 UG (haftungsbeschraenkt)",
+	0x0618: "Audio-Technica Corporation",
+	0x0619: "Six Guys Labs, s.r.o.",
+	0x061a: "R.W. Beckett Corporation",
+	0x061b: "silex technology, inc.",
+	0x061c: "Univations Limited",
+	0x061d: "SENS Innovation ApS",
+	0x061e: "Diamond Kinetics, Inc.",
+	0x061f: "Phrame Inc.",
+	0x0620: "Forciot Oy",
+	0x0621: "Noordung d.o.o.",
+	0x0622: "Beam Labs, LLC",
+	0x0624: "Biovotion AG",
+	0x0625: "Square Panda, Inc.",
+	0x0626: "Amplifico",
+	0x0627: "WEG S.A.",
+	0x0628: "Ensto Oy",
+	0x0629: "PHONEPE PVT LTD",
+	0x062b: "MinebeaMitsumi Inc.",
+	0x062c: "ASPion GmbH",
+	0x062d: "Vossloh-Schwabe Deutschland GmbH",
+	0x062e: "Procept",
+	0x062f: "ONKYO Corporation",
+	0x0630: "Asthrea D.O.O.",
+	0x0631: "Fortiori Design LLC",
+	0x0632: "Hugo Muller GmbH & Co KG",
+	0x0633: "Wangi Lai PLT",
+	0x0634: "Fanstel Corp",
+	0x0635: "Crookwood",
+	0x0636: "ELECTRONICA INTEGRAL DE SONIDO S.A.",
+	0x0637: "GiP Innovation Tools GmbH",
+	0x0638: "LX SOLUTIONS PTY LIMITED",
+	0x0639: "Shenzhen Minew Technologies Co., Ltd.",
+	0x063a: "Prolojik Limited",
+	0x063b: "Kromek Group Plc",
+	0x063c: "Contec Medical Systems Co., Ltd.",
+	0x063d: "Xradio Technology Co.,Ltd.",
+	0x063e: "The Indoor Lab, LLC",
+	0x063f: "LDL TECHNOLOGY",
+	0x0640: "Dish Network LLC",
+	0x0641: "Revenue Collection Systems FRANCE SAS",
+	0x0642: "Bluetrum Technology Co.,Ltd",
+	0x0643: "makita corporation",
+	0x0644: "Apogee Instruments",
+	0x0645: "BM3",
+	0x0646: "SGV Group Holding GmbH & Co. KG",
+	0x0647: "MED-EL",
+	0x0648: "Ultune Technologies",
+	0x0649: "Ryeex Technology Co.,Ltd.",
+	0x064a: "Open Research Institute, Inc.",
+	0x064b: "Scale-Tec, Ltd",
+	0x064c: "Zumtobel Group AG",
+	0x064d: "iLOQ Oy",
+	0x064e: "KRUXWorks Technologies Private Limited",
+	0x064f: "Digital Matter Pty Ltd",
+	0x0650: "Coravin, Inc.",
+	0x0651: "Stasis Labs, Inc.",
+	0x0652: "ITZ Innovations- und Technologiezentrum GmbH",
+	0x0653: "Meggitt SA",
+	0x0654: "Ledlenser GmbH & Co. KG",
+	0x0655: "Renishaw PLC",
+	0x0656: "ZhuHai AdvanPro Technology Company Limited",
+	0x0657: "Meshtronix Limited",
+	0x0658: "Payex Norge AS",
+	0x0659: "UnSeen Technologies Oy",
+	0x065a: "Marshall Group AB",
+	0x065b: "Sesam Solutions BV",
+	0x065c: "PixArt Imaging Inc.",
+	0x065d: "Panduit Corp.",
+	0x065e: "Alo AB",
+	0x065f: "Ricoh Company Ltd",
+	0x0660: "RTC Industries, Inc.",
+	0x0661: "Mode Lighting Limited",
+	0x0662: "Particle Industries, Inc.",
+	0x0663: "Advanced Telemetry Systems, Inc.",
+	0x0664: "RHA TECHNOLOGIES LTD",
+	0x0665: "Pure International Limited",
+	0x0666: "WTO Werkzeug-Einrichtungen GmbH",
+	0x0668: "Bleb Technology srl",
+	0x0669: "Livanova USA, Inc.",
+	0x066a: "Brady Worldwide Inc.",
+	0x066b: "DewertOkin GmbH",
+	0x066c: "Ztove ApS",
+	0x066d: "Venso EcoSolutions AB",
+	0x066e: "Eurotronik Kranj d.o.o.",
+	0x066f: "Hug Technology Ltd",
+	0x0670: "Gema Switzerland GmbH",
+	0x0671: "Buzz Products Ltd.",
+	0x0672: "Kopi",
+	0x0673: "Innova Ideas Limited",
+	0x0674: "BeSpoon",
+	0x0676: "Expai Solutions Private Limited",
+	0x0677: "Innovation First, Inc.",
+	0x0678: "SABIK Offshore GmbH",
+	0x0679: "4iiii Innovations Inc.",
+	0x067a: "The Energy Conservatory, Inc.",
+	0x067b: "I.FARM, INC.",
+	0x067c: "Tile, Inc.",
+	0x067d: "Form Athletica Inc.",
+	0x067f: "NETGRID S.N.C. DI BISSOLI MATTEO, CAMPOREALE SIMONE, TOGNETTI FEDERICO",
+	0x0680: "Mannkind Corporation",
+	0x0681: "Trade FIDES a.s.",
+	0x0682: "Photron Limited",
+	0x0683: "Eltako GmbH",
+	0x0684: "Dermalapps, LLC",
+	0x0685: "Greenwald Industries",
+	0x0686: "inQs Co., Ltd.",
+	0x0687: "Cherry GmbH",
+	0x0688: "Amsted Digital Solutions Inc.",
+	0x0689: "Tacx b.v.",
+	0x068a: "Raytac Corporation",
+	0x068b: "Jiangsu Teranovo Tech Co., Ltd.",
+	0x068e: "Razer Inc.",
+	0x068f: "JRM Group Limited",
+	0x0690: "Eccrine Systems, Inc.",
+	0x0691: "Curie Point AB",
+	0x0692: "Georg Fischer AG",
+	0x0693: "Hach - Danaher",
+	0x0694: "T&A Laboratories LLC",
+	0x0695: "Koki Holdings Co., Ltd.",
+	0x0696: "Gunakar Private Limited",
+	0x0697: "Stemco Products Inc",
+	0x0698: "Wood IT Security, LLC",
+	0x0699: "RandomLab SAS",
+	0x069a: "Adero, Inc.",
+	0x069b: "Dragonchip Limited",
+	0x069c: "Noomi AB",
+	0x069e: "Delta Electronics, Inc.",
+	0x069f: "FlowMotion Technologies AS",
+	0x06a0: "OBIQ Location Technology Inc.",
+	0x06a1: "Cardo Systems, Ltd",
+	0x06a2: "Globalworx GmbH",
+	0x06a3: "Nymbus, LLC",
+	0x06a4: "LIMNO Co. Ltd.",
+	0x06a5: "TEKZITEL PTY LTD",
+	0x06a6: "Roambee Corporation",
+	0x06a7: "Chipsea Technologies (ShenZhen) Corp.",
+	0x06a8: "GD Midea Air-Conditioning Equipment Co., Ltd.",
+	0x06a9: "Soundmax Electronics Limited",
+	0x06aa: "Produal Oy",
+	0x06ab: "HMS Industrial Networks AB",
+	0x06ac: "Ingchips Technology Co., Ltd.",
+	0x06ad: "InnovaSea Systems Inc.",
+	0x06ae: "SenseQ Inc.",
+	0x06af: "Shoof Technologies",
+	0x06b0: "BRK Brands, Inc.",
+	0x06b1: "SimpliSafe, Inc.",
+	0x06b2: "Tussock Innovation 2013 Limited",
+	0x06b4: "Sencilion Oy",
+	0x06b5: "Wabilogic Ltd.",
+	0x06b6: "Sociometric Solutions, Inc.",
+	0x06b7: "iCOGNIZE GmbH",
+	0x06b8: "ShadeCraft, Inc",
+	0x06b9: "Beflex Inc.",
+	0x06ba: "Beaconzone Ltd",
+	0x06bb: "Leaftronix Analogic Solutions Private Limited",
+	0x06bc: "TWS Srl",
+	0x06bd: "ABB Oy",
+	0x06be: "HitSeed Oy",
+	0x06c0: "CAME S.p.A.",
+	0x06c1: "Alarm.com Holdings, Inc",
+	0x06c2: "Measurlogic Inc.",
+	0x06c3: "King I Electronics.Co.,Ltd",
+	0x06c4: "Dream Labs GmbH",
+	0x06c5: "Urban Compass, Inc",
+	0x06c6: "Simm Tronic Limited",
+	0x06c8: "Storz & Bickel GmbH & Co. KG",
+	0x06c9: "MYLAPS B.V.",
+	0x06ca: "Shenzhen Zhongguang Infotech Technology Development Co., Ltd",
+	0x06cb: "Dyeware, LLC",
+	0x06cc: "Dongguan SmartAction Technology Co.,Ltd.",
+	0x06cd: "DIG Corporation",
+	0x06ce: "FIOR & GENTZ",
+	0x06d0: "Etekcity Corporation",
+	0x06d1: "Meyer Sound Laboratories, Incorporated",
+	0x06d2: "CeoTronics AG",
+	0x06d5: "Sensirion AG",
+	0x06d6: "JCT Healthcare Pty Ltd",
+	0x06d7: "FUBA Automotive Electronics GmbH",
+	0x06d8: "AW Company",
+	0x06d9: "Shanghai Mountain View Silicon Co.,Ltd.",
+	0x06da: "Zliide Technologies ApS",
+	0x06db: "Automatic Labs, Inc.",
+	0x06dc: "Industrial Network Controls, LLC",
+	0x06dd: "Intellithings Ltd.",
+	0x06de: "Navcast, Inc.",
+	0x06df: "HLI Solutions Inc.",
+	0x06e0: "Avaya Inc.",
+	0x06e1: "Milestone AV Technologies LLC",
+	0x06e2: "Alango Technologies Ltd",
+	0x06e3: "Spinlock Ltd",
+	0x06e4: "Aluna",
+	0x06e5: "OPTEX CO.,LTD.",
+	0x06e6: "NIHON DENGYO KOUSAKU",
+	0x06e7: "VELUX A/S",
+	0x06e8: "Almendo Technologies GmbH",
+	0x06e9: "Zmartfun Electronics, Inc.",
+	0x06ea: "SafeLine Sweden AB",
+	0x06eb: "Houston Radar LLC",
+	0x06ed: "J Neades Ltd",
+	0x06ef: "ALCARE Co., Ltd.",
+	0x06f0: "Chargy Technologies, SL",
+	0x06f1: "Shibutani Co., Ltd.",
+	0x06f2: "Trapper Data AB",
+	0x06f3: "Alfred International Inc.",
+	0x06f4: "Touché Technology Ltd",
+	0x06f5: "Vigil Technologies Inc.",
+	0x06f6: "Vitulo Plus BV",
+	0x06f7: "WILKA Schliesstechnik GmbH",
+	0x06f8: "BodyPlus Technology Co.,Ltd",
+	0x06f9: "happybrush GmbH",
+	0x06fa: "Enequi AB",
+	0x06fb: "Sartorius AG",
+	0x06fc: "Tom Communication Industrial Co.,Ltd.",
+	0x06fd: "ESS Embedded System Solutions Inc.",
+	0x06fe: "Mahr GmbH",
+	0x06ff: "Redpine Signals Inc",
+	0x0700: "TraqFreq LLC",
+	0x0701: "PAFERS TECH",
+	0x0702: "Akciju sabiedriba \"SAF TEHNIKA\"",
+	0x0703: "Beijing Jingdong Century Trading Co., Ltd.",
+	0x0704: "JBX Designs Inc.",
+	0x0705: "AB Electrolux",
+	0x0706: "Wernher von Braun Center for ASdvanced Research",
+	0x0707: "Essity Hygiene and Health Aktiebolag",
+	0x0708: "Be Interactive Co., Ltd",
+	0x0709: "Carewear Corp.",
+	0x070b: "Element Products, Inc.",
+	0x070c: "Beijing Winner Microelectronics Co.,Ltd",
+	0x070d: "SmartSnugg Pty Ltd",
+	0x070e: "FiveCo Sarl",
+	0x070f: "California Things Inc.",
+	0x0710: "Audiodo AB",
+	0x0711: "ABAX AS",
+	0x0712: "Bull Group Company Limited",
+	0x0713: "Respiri Limited",
+	0x0714: "MindPeace Safety LLC",
+	0x0715: "MBARC LABS Inc",
+	0x0716: "Altonics",
+	0x0718: "IDIBAIX enginneering",
+	0x0719: "COREIOT PTY LTD",
+	0x071a: "REVSMART WEARABLE HK CO LTD",
+	0x071b: "Precor",
+	0x071c: "F5 Sports, Inc",
+	0x071d: "exoTIC Systems",
+	0x071e: "DONGGUAN HELE ELECTRONICS CO., LTD",
+	0x071f: "Dongguan Liesheng Electronic Co.Ltd",
+	0x0720: "Oculeve, Inc.",
+	0x0721: "Clover Network, Inc.",
+	0x0722: "Xiamen Eholder Electronics Co.Ltd",
+	0x0723: "Ford Motor Company",
+	0x0724: "Guangzhou SuperSound Information Technology Co.,Ltd",
+	0x0725: "Tedee Sp. z o.o.",
+	0x0726: "PHC Corporation",
+	0x0728: "Eli Lilly and Company",
+	0x0729: "SwaraLink Technologies",
+	0x072a: "JMR embedded systems GmbH",
+	0x072b: "Bitkey Inc.",
+	0x072c: "GWA Hygiene GmbH",
+	0x072d: "Safera Oy",
+	0x072e: "Open Platform Systems LLC",
+	0x072f: "OnePlus Electronics (Shenzhen) Co., Ltd.",
+	0x0730: "Wildlife Acoustics, Inc.",
+	0x0731: "ABLIC Inc.",
+	0x0732: "Dairy Tech, LLC",
+	0x0733: "Iguanavation, Inc.",
+	0x0734: "DiUS Computing Pty Ltd",
+	0x0735: "UpRight Technologies LTD",
+	0x0736: "Luna XIO, Inc.",
+	0x0737: "LLC Navitek",
+	0x0738: "Glass Security Pte Ltd",
+	0x0739: "Jiangsu Qinheng Co., Ltd.",
+	0x073a: "Chandler Systems Inc.",
+	0x073b: "Fantini Cosmi s.p.a.",
+	0x073d: "Beijing Hao Heng Tian Tech Co., Ltd.",
+	0x073e: "Bluepack S.R.L.",
+	0x073f: "Beijing Unisoc Technologies Co., Ltd.",
+	0x0741: "MAC SRL",
+	0x0742: "DML LLC",
+	0x0743: "Sanofi",
+	0x0744: "SOCOMEC",
+	0x0745: "WIZNOVA, Inc.",
+	0x0746: "Seitec Elektronik GmbH",
+	0x0747: "OR Technologies Pty Ltd",
+	0x0748: "GuangZhou KuGou Computer Technology Co.Ltd",
+	0x0749: "DIAODIAO (Beijing) Technology Co., Ltd.",
+	0x074a: "Illusory Studios LLC",
+	0x074b: "Sarvavid Software Solutions LLP",
+	0x074d: "Amtech Systems, LLC",
+	0x074e: "EAGLE DETECTION SA",
+	0x074f: "MEDIATECH S.R.L.",
+	0x0750: "Hamilton Professional Services of Canada Incorporated",
+	0x0751: "Changsha JEMO IC Design Co.,Ltd",
+	0x0752: "Elatec GmbH",
+	0x0753: "JLG Industries, Inc.",
+	0x0754: "Michael Parkin",
+	0x0755: "Brother Industries, Ltd",
+	0x0756: "Lumens For Less, Inc",
+	0x0757: "ELA Innovation",
+	0x0758: "umanSense AB",
+	0x0759: "Shanghai InGeek Cyber Security Co., Ltd.",
+	0x075a: "HARMAN CO.,LTD.",
+	0x075b: "Smart Sensor Devices AB",
+	0x075c: "Antitronics Inc.",
+	0x075d: "RHOMBUS SYSTEMS, INC.",
+	0x075e: "Katerra Inc.",
+	0x075f: "Remote Solution Co., LTD.",
+	0x0760: "Vimar SpA",
+	0x0761: "Mantis Tech LLC",
+	0x0762: "TerOpta Ltd",
+	0x0763: "PIKOLIN S.L.",
+	0x0764: "WWZN Information Technology Company Limited",
+	0x0765: "Voxx International",
+	0x0766: "ART AND PROGRAM, INC.",
+	0x0767: "NITTO DENKO ASIA TECHNICAL CENTRE PTE. LTD.",
+	0x0768: "Peloton Interactive Inc.",
+	0x0769: "Force Impact Technologies",
+	0x076a: "Dmac Mobile Developments, LLC",
+	0x076b: "Engineered Medical Technologies",
+	0x076c: "Noodle Technology inc",
+	0x076d: "Graesslin GmbH",
+	0x076e: "WuQi technologies, Inc.",
+	0x076f: "Successful Endeavours Pty Ltd",
+	0x0770: "InnoCon Medical ApS",
+	0x0771: "Corvex Connected Safety",
+	0x0772: "Thirdwayv Inc.",
+	0x0774: "C-MAX Asia Limited",
+	0x0775: "4eBusiness GmbH",
+	0x0776: "Cyber Transport Control GmbH",
+	0x0777: "Cue",
+	0x0778: "KOAMTAC INC.",
+	0x0779: "Loopshore Oy",
+	0x077a: "Niruha Systems Private Limited",
+	0x077c: "radius co., ltd.",
+	0x077d: "Sensority, s.r.o.",
+	0x077e: "Sparkage Inc.",
+	0x077f: "Glenview Software Corporation",
+	0x0780: "Finch Technologies Ltd.",
+	0x0781: "Qingping Technology (Beijing) Co., Ltd.",
+	0x0782: "DeviceDrive AS",
+	0x0783: "ESEMBER LIMITED LIABILITY COMPANY",
+	0x0784: "audifon GmbH & Co. KG",
+	0x0785: "O2 Micro, Inc.",
+	0x0788: "BubblyNet, LLC",
+	0x0789: "PCB Piezotronics, Inc.",
+	0x078a: "The Wildflower Foundation",
+	0x078b: "Optikam Tech Inc.",
+	0x078c: "MINIBREW HOLDING B.V",
+	0x078d: "Cybex GmbH",
+	0x078e: "FUJIMIC NIIGATA, INC.",
+	0x078f: "Hanna Instruments, Inc.",
+	0x0790: "KOMPAN A/S",
+	0x0791: "Scosche Industries, Inc.",
+	0x0792: "Cricut, Inc.",
+	0x0793: "AEV spol. s r.o.",
+	0x0794: "The Coca-Cola Company",
+	0x0795: "GASTEC CORPORATION",
+	0x0796: "StarLeaf Ltd",
+	0x0797: "Water-i.d. GmbH",
+	0x0798: "HoloKit, Inc.",
+	0x0799: "PlantChoir Inc.",
+	0x079a: "GuangDong Oppo Mobile Telecommunications Corp., Ltd.",
+	0x079b: "CST ELECTRONICS (PROPRIETARY) LIMITED",
+	0x079c: "Sky UK Limited",
+	0x079d: "Digibale Pty Ltd",
+	0x079e: "Smartloxx GmbH",
+	0x079f: "Pune Scientific LLP",
+	0x07a0: "Regent Beleuchtungskorper AG",
+	0x07a2: "Roku, Inc.",
+	0x07a4: "Xiamen Mage Information Technology Co., Ltd.",
+	0x07a5: "RAB Lighting, Inc.",
+	0x07a6: "Musen Connect, Inc.",
+	0x07a7: "Zume, Inc.",
+	0x07a8: "conbee GmbH",
+	0x07a9: "Bruel & Kjaer Sound & Vibration",
+	0x07aa: "The Kroger Co.",
+	0x07ab: "Granite River Solutions, Inc.",
+	0x07ac: "LoupeDeck Oy",
+	0x07ad: "New H3C Technologies Co.,Ltd",
+	0x07ae: "Aurea Solucoes Tecnologicas Ltda.",
+	0x07af: "Hong Kong Bouffalo Lab Limited",
+	0x07b0: "GV Concepts Inc.",
+	0x07b1: "Thomas Dynamics, LLC",
+	0x07b2: "Moeco IOT Inc.",
+	0x07b3: "2N TELEKOMUNIKACE a.s.",
+	0x07b4: "Hormann KG Antriebstechnik",
+	0x07b5: "CRONO CHIP, S.L.",
+	0x07b6: "Soundbrenner Limited",
+	0x07b7: "ETABLISSEMENTS GEORGES RENAULT",
+	0x07b8: "iSwip",
+	0x07ba: "Battery-Biz Inc.",
+	0x07bb: "EPIC S.R.L.",
+	0x07bd: "Genedrive Diagnostics Ltd",
+	0x07be: "Axentia Technologies AB",
+	0x07bf: "REGULA Ltd.",
+	0x07c0: "Biral AG",
+	0x07c2: "Radinn AB",
+	0x07c3: "CIMTechniques, Inc.",
+	0x07c4: "Johnson Health Tech NA",
+	0x07c5: "June Life, Inc.",
+	0x07c6: "Bluenetics GmbH",
+	0x07c7: "iaconicDesign Inc.",
+	0x07c8: "WRLDS Creations AB",
+	0x07c9: "Skullcandy, Inc.",
+	0x07cb: "West Pharmaceutical Services, Inc.",
+	0x07cc: "Barnacle Systems Inc.",
+	0x07cd: "Smart Wave Technologies Canada Inc",
+	0x07ce: "Shanghai Top-Chip Microelectronics Tech. Co., LTD",
+	0x07cf: "NeoSensory, Inc.",
+	0x07d0: "Hangzhou Tuya Information  Technology Co., Ltd",
+	0x07d1: "Shanghai Panchip Microelectronics Co., Ltd",
+	0x07d2: "React Accessibility Limited",
+	0x07d3: "LIVNEX Co.,Ltd.",
+	0x07d4: "Kano Computing Limited",
+	0x07d5: "hoots classic GmbH",
+	0x07d6: "ecobee Inc.",
+	0x07d7: "Nanjing Qinheng Microelectronics Co., Ltd",
+	0x07d8: "SOLUTIONS AMBRA INC.",
+	0x07d9: "Micro-Design, Inc.",
+	0x07da: "STARLITE Co., Ltd.",
+	0x07db: "Remedee Labs",
+	0x07dc: "ThingOS GmbH & Co KG",
+	0x07dd: "Linear Circuits",
+	0x07de: "Unlimited Engineering SL",
+	0x07df: "Snap-on Incorporated",
+	0x07e0: "Edifier International Limited",
+	0x07e2: "Alfred Kaercher SE & Co. KG",
+	0x07e3: "Airoha Technology Corp.",
+	0x07e4: "Geeksme S.L.",
+	0x07e5: "Minut, Inc.",
+	0x07e6: "Waybeyond Limited",
+	0x07e7: "Komfort IQ, Inc.",
+	0x07e8: "Packetcraft, Inc.",
+	0x07e9: "Häfele GmbH & Co KG",
+	0x07ea: "ShapeLog, Inc.",
+	0x07eb: "NOVABASE S.R.L.",
+	0x07ec: "Frecce LLC",
+	0x07ed: "Joule IQ, INC.",
+	0x07ee: "KidzTek LLC",
+	0x07ef: "Aktiebolaget Sandvik Coromant",
+	0x07f0: "e-moola.com Pty Ltd",
+	0x07f1: "Zimi Innovations Pty Ltd",
+	0x07f2: "SERENE GROUP, INC",
+	0x07f3: "DIGISINE ENERGYTECH CO. LTD.",
+	0x07f4: "MEDIRLAB Orvosbiologiai Fejleszto Korlatolt Felelossegu Tarsasag",
+	0x07f5: "Byton North America Corporation",
+	0x07f6: "Shenzhen TonliScience and Technology Development Co.,Ltd",
+	0x07f7: "Cesar Systems Ltd.",
+	0x07f8: "quip NYC Inc.",
+	0x07fa: "Klipsch Group, Inc.",
+	0x07fb: "Access Co., Ltd",
+	0x07fc: "Renault SA",
+	0x07fd: "JSK CO., LTD.",
+	0x07fe: "BIROTA",
+	0x07ff: "maxon motor ltd.",
+	0x0800: "Optek",
+	0x0801: "CRONUS ELECTRONICS LTD",
+	0x0802: "NantSound, Inc.",
+	0x0803: "Domintell s.a.",
+	0x0804: "Andon Health Co.,Ltd",
+	0x0805: "Urbanminded Ltd",
+	0x0806: "TYRI Sweden AB",
+	0x0807: "ECD Electronic Components GmbH Dresden",
+	0x0808: "SISTEMAS KERN, SOCIEDAD ANÓMINA",
+	0x0809: "Trulli Audio",
+	0x080a: "Altaneos",
+	0x080b: "Nanoleaf Canada Limited",
+	0x080c: "Ingy B.V.",
+	0x080d: "Azbil Co.",
+	0x080e: "TATTCOM LLC",
+	0x080f: "Paradox Engineering SA",
+	0x0810: "LECO Corporation",
+	0x0811: "Becker Antriebe GmbH",
+	0x0812: "Mstream Technologies., Inc.",
+	0x0813: "Flextronics International USA Inc.",
+	0x0814: "Ossur hf.",
+	0x0815: "SKC Inc",
+	0x0816: "SPICA SYSTEMS LLC",
+	0x0817: "Wangs Alliance Corporation",
+	0x0818: "tatwah SA",
+	0x0819: "Hunter Douglas Inc",
+	0x081a: "Shenzhen Conex",
+	0x081b: "DIM3",
+	0x081c: "Bobrick Washroom Equipment, Inc.",
+	0x081d: "Potrykus Holdings and Development LLC",
+	0x081e: "iNFORM Technology GmbH",
+	0x081f: "eSenseLab LTD",
+	0x0820: "Brilliant Home Technology, Inc.",
+	0x0821: "INOVA Geophysical, Inc.",
+	0x0822: "adafruit industries",
+	0x0824: "8Power Limited",
+	0x0825: "CME PTE. LTD.",
+	0x0826: "Hyundai Motor Company",
+	0x0827: "Kickmaker",
+	0x0828: "Shanghai Suisheng Information Technology Co., Ltd.",
+	0x0829: "HEXAGON METROLOGY DIVISION ROMER",
+	0x082a: "Mitutoyo Corporation",
+	0x082b: "shenzhen fitcare electronics Co.,Ltd",
+	0x082c: "INGICS TECHNOLOGY CO., LTD.",
+	0x082d: "INCUS PERFORMANCE LTD.",
+	0x082e: "ABB S.p.A.",
+	0x082f: "Blippit AB",
+	0x0831: "Foxble, LLC",
+	0x0832: "Intermotive,Inc.",
+	0x0833: "Conneqtech B.V.",
+	0x0834: "RIKEN KEIKI CO., LTD.,",
+	0x0835: "Canopy Growth Corporation",
+	0x0836: "Bitwards Oy",
+	0x0837: "vivo Mobile Communication Co., Ltd.",
+	0x0838: "Etymotic Research, Inc.",
+	0x0839: "A puissance 3",
+	0x083a: "BPW Bergische Achsen Kommanditgesellschaft",
+	0x083b: "Piaggio Fast Forward",
+	0x083c: "BeerTech LTD",
+	0x083d: "Tokenize, Inc.",
+	0x083e: "Zorachka LTD",
+	0x083f: "D-Link Corp.",
+	0x0840: "Down Range Systems LLC",
+	0x0841: "General Luminaire (Shanghai) Co., Ltd.",
+	0x0842: "Tangshan HongJia electronic technology co., LTD.",
+	0x0843: "FRAGRANCE DELIVERY TECHNOLOGIES LTD",
+	0x0844: "Pepperl + Fuchs GmbH",
+	0x0845: "Dometic Corporation",
+	0x0846: "USound GmbH",
+	0x0847: "DNANUDGE LIMITED",
+	0x0848: "JUJU JOINTS CANADA CORP.",
+	0x0849: "Dopple Technologies B.V.",
+	0x084a: "ARCOM",
+	0x084b: "Biotechware SRL",
+	0x084c: "ORSO Inc.",
+	0x084d: "SafePort",
+	0x084e: "Carol Cole Company",
+	0x084f: "Embedded Fitness B.V.",
+	0x0850: "Yealink (Xiamen) Network Technology Co.,LTD",
+	0x0851: "Subeca, Inc.",
+	0x0852: "Cognosos, Inc.",
+	0x0853: "Pektron Group Limited",
+	0x0854: "Tap Sound System",
+	0x0855: "Helios Sports, Inc.",
+	0x0856: "Canopy Growth Corporation",
+	0x0857: "Parsyl Inc",
+	0x0858: "SOUNDBOKS",
+	0x0859: "BlueUp",
+	0x085a: "DAKATECH",
+	0x085b: "Nisshinbo Micro Devices Inc.",
+	0x085c: "ACOS CO.,LTD.",
+	0x085d: "Guilin Zhishen Information Technology Co.,Ltd.",
+	0x085e: "Krog Systems LLC",
+	0x0860: "Alflex Products B.V.",
+	0x0861: "SmartSensor Labs Ltd",
+	0x0862: "SmartDrive",
+	0x0863: "Yo-tronics Technology Co., Ltd.",
+	0x0864: "Rafaelmicro",
+	0x0865: "Emergency Lighting Products Limited",
+	0x0866: "LAONZ Co.,Ltd",
+	0x0867: "Western Digital Techologies, Inc.",
+	0x0868: "WIOsense GmbH & Co. KG",
+	0x0869: "EVVA Sicherheitstechnologie GmbH",
+	0x086a: "Odic Incorporated",
+	0x086b: "Pacific Track, LLC",
+	0x086c: "Revvo Technologies, Inc.",
+	0x086d: "Biometrika d.o.o.",
+	0x086e: "Vorwerk Elektrowerke GmbH & Co. KG",
+	0x086f: "Trackunit A/S",
+	0x0870: "Wyze Labs, Inc",
+	0x0871: "Dension Elektronikai Kft.",
+	0x0872: "11 Health & Technologies Limited",
+	0x0873: "Innophase Incorporated",
+	0x0874: "Treegreen Limited",
+	0x0875: "Berner International LLC",
+	0x0876: "SmartResQ ApS",
+	0x0877: "Valtech",
+	0x0878: "The Chamberlain Group, Inc.",
+	0x0879: "MIZUNO Corporation",
+	0x087a: "ZRF, LLC",
+	0x087b: "BYSTAMP",
+	0x087c: "Crosscan GmbH",
+	0x087d: "Konftel AB",
+	0x087e: "1bar.net Limited",
+	0x087f: "Phillips Connect Technologies LLC",
+	0x0880: "imagiLabs AB",
+	0x0881: "Optalert",
+	0x0882: "PSYONIC, Inc.",
+	0x0883: "Wintersteiger AG",
+	0x0884: "Controlid Industria, Comercio de Hardware e Servicos de Tecnologia Ltda",
+	0x0886: "Movella Technologies B.V.",
+	0x0887: "Hydro-Gear Limited Partnership",
+	0x0888: "EnPointe Fencing Pty Ltd",
+	0x0889: "XANTHIO",
+	0x088a: "sclak s.r.l.",
+	0x088b: "Tricorder Arraay Technologies LLC",
+	0x088c: "GB Solution co.,Ltd",
+	0x088d: "Soliton Systems K.K.",
+	0x088f: "Tait International Limited",
+	0x0890: "NICHIEI INTEC CO., LTD.",
+	0x0891: "SmartWireless GmbH & Co. KG",
+	0x0892: "Ingenieurbuero Birnfeld UG (haftungsbeschraenkt)",
+	0x0893: "Maytronics Ltd",
+	0x0894: "EPIFIT",
+	0x0895: "Gimer medical",
+	0x0896: "Nokian Renkaat Oyj",
+	0x0897: "Current Lighting Solutions LLC",
+	0x0899: "SFS unimarket AG",
+	0x089a: "Private limited company \"Teltonika\"",
+	0x089b: "Saucon Technologies",
+	0x089c: "Embedded Devices Co. Company",
+	0x089d: "J-J.A.D.E. Enterprise LLC",
+	0x089e: "i-SENS, inc.",
+	0x089f: "Witschi Electronic Ltd",
+	0x08a0: "Aclara Technologies LLC",
+	0x08a1: "EXEO TECH CORPORATION",
+	0x08a2: "Epic Systems Co., Ltd.",
+	0x08a3: "Hoffmann SE",
+	0x08a4: "Realme Chongqing Mobile Telecommunications Corp., Ltd.",
+	0x08a6: "Intelligenceworks Inc.",
+	0x08a7: "TGR 1.618 Limited",
+	0x08a8: "Shanghai Kfcube Inc",
+	0x08a9: "Fraunhofer IIS",
+	0x08aa: "SZ DJI TECHNOLOGY CO.,LTD",
+	0x08ab: "Coburn Technology, LLC",
+	0x08ac: "Topre Corporation",
+	0x08ad: "Kayamatics Limited",
+	0x08ae: "Moticon ReGo AG",
+	0x08af: "Polidea Sp. z o.o.",
+	0x08b0: "Trivedi Advanced Technologies LLC",
+	0x08b1: "CORE|vision BV",
+	0x08b2: "PF SCHWEISSTECHNOLOGIE GMBH",
+	0x08b3: "IONIQ Skincare GmbH & Co. KG",
+	0x08b4: "Sengled Co., Ltd.",
+	0x08b6: "Boehringer Ingelheim Vetmedica GmbH",
+	0x08b7: "ABB Inc",
+	0x08b8: "Check Technology Solutions LLC",
+	0x08b9: "U-Shin Ltd.",
+	0x08ba: "HYPER ICE, INC.",
+	0x08bb: "Tokai-rika co.,ltd.",
+	0x08bc: "Prevayl Limited",
+	0x08bd: "bf1systems limited",
+	0x08be: "ubisys technologies GmbH",
+	0x08bf: "SIRC Co., Ltd.",
+	0x08c0: "Accent Advanced Systems SLU",
+	0x08c1: "Rayden.Earth LTD",
+	0x08c2: "Lindinvent AB",
+	0x08c3: "CHIPOLO d.o.o.",
+	0x08c5: "J. Wagner GmbH",
+	0x08c7: "Monadnock Systems Ltd.",
+	0x08c8: "Liteboxer Technologies Inc.",
+	0x08c9: "Noventa AG",
+	0x08ca: "Nubia Technology Co.,Ltd.",
+	0x08cb: "JT INNOVATIONS LIMITED",
+	0x08cc: "TGM TECHNOLOGY CO., LTD.",
+	0x08cd: "ifly",
+	0x08ce: "ZIMI CORPORATION",
+	0x08cf: "betternotstealmybike UG (with limited liability)",
+	0x08d0: "ESTOM Infotech Kft.",
+	0x08d1: "Sensovium Inc.",
+	0x08d2: "Virscient Limited",
+	0x08d3: "Novel Bits, LLC",
+	0x08d4: "ADATA Technology Co., LTD.",
+	0x08d5: "KEYes",
+	0x08d7: "Inovonics Corp",
+	0x08d8: "WARES",
+	0x08d9: "Pointr Labs Limited",
+	0x08da: "Miridia Technology Incorporated",
+	0x08db: "Tertium Technology",
+	0x08dc: "SHENZHEN AUKEY E BUSINESS CO., LTD",
+	0x08dd: "code-Q",
+	0x08de: "TE Connectivity Corporation",
+	0x08df: "IRIS OHYAMA CO.,LTD.",
+	0x08e0: "Philia Technology",
+	0x08e1: "KOZO KEIKAKU ENGINEERING Inc.",
+	0x08e2: "Shenzhen Simo Technology co. LTD",
+	0x08e3: "Republic Wireless, Inc.",
+	0x08e4: "Rashidov ltd",
+	0x08e5: "Crowd Connected Ltd",
+	0x08e6: "Eneso Tecnologia de Adaptacion S.L.",
+	0x08e7: "Barrot Technology Co.,Ltd.",
+	0x08e8: "Naonext",
+	0x08e9: "Taiwan Intelligent Home Corp.",
+	0x08ea: "COWBELL ENGINEERING CO.,LTD.",
+	0x08eb: "Beijing Big Moment Technology Co., Ltd.",
+	0x08ec: "Denso Corporation",
+	0x08ed: "IMI Hydronic Engineering International SA",
+	0x08ee: "Askey Computer Corp.",
+	0x08ef: "Cumulus Digital Systems, Inc",
+	0x08f0: "Joovv, Inc.",
+	0x08f1: "The L.S. Starrett Company",
+	0x08f2: "Microoled",
+	0x08f3: "PSP - Pauli Services & Products GmbH",
+	0x08f4: "Kodimo Technologies Company Limited",
+	0x08f5: "Tymtix Technologies Private Limited",
+	0x08f6: "Dermal Photonics Corporation",
+	0x08f7: "MTD Products Inc & Affiliates",
+	0x08f8: "instagrid GmbH",
+	0x08f9: "Spacelabs Medical Inc.",
+	0x08fb: "Darkglass Electronics Oy",
+	0x08fc: "Hill-Rom",
+	0x08fd: "BioIntelliSense, Inc.",
+	0x08fe: "Ketronixs Sdn Bhd",
+	0x08ff: "Plastimold Products, Inc",
+	0x0900: "Beijing Zizai Technology Co., LTD.",
+	0x0901: "Lucimed",
+	0x0902: "TSC Auto-ID Technology Co., Ltd.",
+	0x0903: "DATAMARS, Inc.",
+	0x0904: "SUNCORPORATION",
+	0x0905: "Yandex Services AG",
+	0x0906: "Scope Logistical Solutions",
+	0x0907: "User Hello, LLC",
+	0x0908: "Pinpoint Innovations Limited",
+	0x0909: "70mai Co.,Ltd.",
+	0x090a: "Zhuhai Hoksi Technology CO.,LTD",
+	0x090b: "EMBR labs, INC",
+	0x090c: "Radiawave Technologies Co.,Ltd.",
+	0x090e: "OPTIMUSIOT TECH LLP",
+	0x090f: "VC Inc.",
+	0x0910: "ASR Microelectronics (Shanghai) Co., Ltd.",
+	0x0911: "Douglas Lighting Controls Inc.",
+	0x0912: "Nerbio Medical Software Platforms Inc",
+	0x0913: "Braveheart Wireless, Inc.",
+	0x0914: "INEO-SENSE",
+	0x0915: "Honda Motor Co., Ltd.",
+	0x0916: "Ambient Sensors LLC",
+	0x0917: "ASR Microelectronics(ShenZhen)Co., Ltd.",
+	0x0919: "NO SMD LIMITED",
+	0x091a: "Albertronic BV",
+	0x091b: "Luminostics, Inc.",
+	0x091c: "Oblamatik AG",
+	0x091d: "Innokind, Inc.",
+	0x091e: "Melbot Studios, Sociedad Limitada",
+	0x091f: "Myzee Technology",
+	0x0921: "KAHA PTE. LTD.",
+	0x0922: "Shanghai MXCHIP Information Technology Co., Ltd.",
+	0x0923: "JSB TECH PTE LTD",
+	0x0925: "Yukai Engineering Inc.",
+	0x0926: "Gooligum Technologies Pty Ltd",
+	0x0927: "ROOQ GmbH",
+	0x0928: "AiRISTA",
+	0x0929: "Qingdao Haier Technology Co., Ltd.",
+	0x092a: "Sappl Verwaltungs- und Betriebs GmbH",
+	0x092b: "TekHome",
+	0x092c: "PCI Private Limited",
+	0x092d: "Leggett & Platt, Incorporated",
+	0x092e: "PS GmbH",
+	0x092f: "C.O.B.O. SpA",
+	0x0930: "James Walker RotaBolt Limited",
+	0x0931: "BREATHINGS Co., Ltd.",
+	0x0933: "SRAM",
+	0x0934: "KiteSpring Inc.",
+	0x0935: "Reconnect, Inc.",
+	0x0936: "Elekon AG",
+	0x0937: "RealThingks GmbH",
+	0x0938: "Henway Technologies, LTD.",
+	0x0939: "ASTEM Co.,Ltd.",
+	0x093a: "LinkedSemi Microelectronics (Xiamen) Co., Ltd",
+	0x093b: "ENSESO LLC",
+	0x093c: "Xenoma Inc.",
+	0x093d: "Adolf Wuerth GmbH & Co KG",
+	0x093e: "Catalyft Labs, Inc.",
+	0x093f: "JEPICO Corporation",
+	0x0940: "Hero Workout GmbH",
+	0x0941: "Rivian Automotive, LLC",
+	0x0942: "TRANSSION HOLDINGS LIMITED",
+	0x0944: "Agitron d.o.o.",
+	0x0945: "Globe (Jiangsu) Co., Ltd",
+	0x0946: "AMC International Alfa Metalcraft Corporation AG",
+	0x0947: "First Light Technologies Ltd.",
+	0x0948: "Wearable Link Limited",
+	0x0949: "Metronom Health Europe",
+	0x094a: "Zwift, Inc.",
+	0x094b: "Kindeva Drug Delivery L.P.",
+	0x094c: "GimmiSys GmbH",
+	0x094d: "tkLABS INC.",
+	0x094e: "PassiveBolt, Inc.",
+	0x094f: "Limited Liability Company \"Mikrotikls\"",
+	0x0950: "Capetech",
+	0x0951: "PPRS",
+	0x0952: "Apptricity Corporation",
+	0x0953: "LogiLube, LLC",
+	0x0954: "Julbo",
+	0x0955: "Breville Group",
+	0x0956: "Kerlink",
+	0x0957: "Ohsung Electronics",
+	0x0958: "ZTE Corporation",
+	0x0959: "HerdDogg, Inc",
+	0x095b: "Lismore Instruments Limited",
+	0x095c: "LogiLube, LLC",
+	0x095d: "Electronic Theatre Controls",
+	0x095e: "BioEchoNet inc.",
+	0x095f: "NUANCE HEARING LTD",
+	0x0960: "Sena Technologies Inc.",
+	0x0961: "Linkura AB",
+	0x0962: "GL Solutions K.K.",
+	0x0963: "Moonbird BV",
+	0x0964: "Countrymate Technology Limited",
+	0x0965: "Asahi Kasei Corporation",
+	0x0966: "PointGuard, LLC",
+	0x0967: "Neo Materials and Consulting Inc.",
+	0x0968: "Actev Motors, Inc.",
+	0x0969: "Woan Technology (Shenzhen) Co., Ltd.",
+	0x096a: "dricos, Inc.",
+	0x096b: "Guide ID B.V.",
+	0x096d: "Gunwerks, LLC",
+	0x096e: "Band Industries, inc.",
+	0x0970: "IBA Dosimetry GmbH",
+	0x0971: "GA",
+	0x0973: "Popit Oy",
+	0x0974: "ABEYE",
+	0x0975: "BlueIOT(Beijing) Technology Co.,Ltd",
+	0x0976: "Fauna Audio GmbH",
+	0x0977: "TOYOTA motor corporation",
+	0x0978: "ZifferEins GmbH & Co. KG",
+	0x0979: "BIOTRONIK SE & Co. KG",
+	0x097a: "CORE CORPORATION",
+	0x097b: "CTEK Sweden AB",
+	0x097c: "Thorley Industries, LLC",
+	0x097d: "CLB B.V.",
+	0x097e: "SonicSensory Inc",
+	0x097f: "ISEMAR S.R.L.",
+	0x0980: "DEKRA TESTING AND CERTIFICATION, S.A.U.",
+	0x0981: "Bernard Krone Holding SE & Co.KG",
+	0x0982: "ELPRO-BUCHS AG",
+	0x0983: "Feedback Sports LLC",
+	0x0984: "TeraTron GmbH",
+	0x0986: "Cello Hill, LLC",
+	0x0987: "TSE BRAKES, INC.",
+	0x0988: "BHM-Tech Produktionsgesellschaft m.b.H",
+	0x0989: "WIKA Alexander Wiegand SE & Co.KG",
+	0x098a: "Biovigil",
+	0x098b: "Mequonic Engineering, S.L.",
+	0x098c: "bGrid B.V.",
+	0x098e: "ADVEEZ",
+	0x098f: "Aktiebolaget Regin",
+	0x0990: "Anton Paar GmbH",
+	0x0991: "Telenor ASA",
+	0x0992: "Big Kaiser Precision Tooling Ltd",
+	0x0993: "Absolute Audio Labs B.V.",
+	0x0994: "VT42 Pty Ltd",
+	0x0995: "Bronkhorst High-Tech B.V.",
+	0x0996: "C. & E. Fein GmbH",
+	0x0997: "NextMind",
+	0x0998: "Pixie Dust Technologies, Inc.",
+	0x0999: "eTactica ehf",
+	0x099a: "New Audio LLC",
+	0x099b: "Sendum Wireless Corporation",
+	0x099c: "deister electronic GmbH",
+	0x099d: "YKK AP Inc.",
+	0x099e: "Step One Limited",
+	0x099f: "Koya Medical, Inc.",
+	0x09a0: "Proof Diagnostics, Inc.",
+	0x09a1: "VOS Systems, LLC",
+	0x09a2: "ENGAGENOW DATA SCIENCES PRIVATE LIMITED",
+	0x09a3: "ARDUINO SA",
+	0x09a4: "KUMHO ELECTRICS, INC",
+	0x09a5: "Security Enhancement Systems, LLC",
+	0x09a6: "BEIJING ELECTRIC VEHICLE CO.,LTD",
+	0x09a7: "Paybuddy ApS",
+	0x09a8: "KHN Solutions LLC",
+	0x09a9: "Nippon Ceramic Co.,Ltd.",
+	0x09aa: "PHOTODYNAMIC INCORPORATED",
+	0x09ab: "DashLogic, Inc.",
+	0x09ac: "Ambiq",
+	0x09ad: "Narhwall Inc.",
+	0x09ae: "Pozyx NV",
+	0x09af: "ifLink Open Community",
+	0x09b0: "Deublin Company, LLC",
+	0x09b1: "BLINQY",
+	0x09b2: "DYPHI",
+	0x09b3: "BlueX Microelectronics Corp Ltd.",
+	0x09b4: "PentaLock Aps.",
+	0x09b5: "AUTEC Gesellschaft fuer Automationstechnik mbH",
+	0x09b6: "Pegasus Technologies, Inc.",
+	0x09b7: "Bout Labs, LLC",
+	0x09b8: "PlayerData Limited",
+	0x09b9: "SAVOY ELECTRONIC LIGHTING",
+	0x09ba: "Elimo Engineering Ltd",
+	0x09bb: "SkyStream Corporation",
+	0x09bc: "Aerosens LLC",
+	0x09bd: "Centre Suisse d'Electronique et de Microtechnique SA",
+	0x09be: "Vessel Ltd.",
+	0x09bf: "Span.IO, Inc.",
+	0x09c0: "AnotherBrain inc.",
+	0x09c1: "Rosewill",
+	0x09c2: "Universal Audio, Inc.",
+	0x09c3: "JAPAN TOBACCO INC.",
+	0x09c4: "UVISIO",
+	0x09c5: "HungYi Microelectronics Co.,Ltd.",
+	0x09c6: "Honor Device Co., Ltd.",
+	0x09c7: "Combustion, LLC",
+	0x09c8: "XUNTONG",
+	0x09c9: "CrowdGlow Ltd",
+	0x09ca: "Mobitrace",
+	0x09cb: "Hx Engineering, LLC",
+	0x09cc: "Senso4s d.o.o.",
+	0x09ce: "Julius Blum GmbH",
+	0x09cf: "BlueStreak IoT, LLC",
+	0x09d0: "Chess Wise B.V.",
+	0x09d1: "ABLEPAY TECHNOLOGIES AS",
+	0x09d2: "Temperature Sensitive Solutions Systems Sweden AB",
+	0x09d4: "ORBIS Inc.",
+	0x09d5: "GEAR RADIO ELECTRONICS CORP.",
+	0x09d6: "EAR TEKNIK ISITME VE ODIOMETRI CIHAZLARI SANAYI VE TICARET ANONIM SIRKETI",
+	0x09d7: "Coyotta",
+	0x09d8: "Synergy Tecnologia em Sistemas Ltda",
+	0x09d9: "VivoSensMedical GmbH",
+	0x09da: "Nagravision SA",
+	0x09db: "Bionic Avionics Inc.",
+	0x09dd: "Innoware Development AB",
+	0x09de: "JLD Technology Solutions, LLC",
+	0x09df: "Magnus Technology Sdn Bhd",
+	0x09e1: "Tag-N-Trac Inc",
+	0x09e3: "Friday Home Aps",
+	0x09e4: "CPS AS",
+	0x09e5: "Mobilogix",
+	0x09e6: "Masonite Corporation",
+	0x09e7: "Kabushikigaisha HANERON",
+	0x09e8: "Melange Systems Pvt. Ltd.",
+	0x09e9: "LumenRadio AB",
+	0x09ea: "Athlos Oy",
+	0x09eb: "KEAN ELECTRONICS PTY LTD",
+	0x09ec: "Yukon advanced optics worldwide, UAB",
+	0x09ed: "Sibel Inc.",
+	0x09ee: "OJMAR SA",
+	0x09ef: "Steinel Solutions AG",
+	0x09f0: "WatchGas B.V.",
+	0x09f1: "OM Digital Solutions Corporation",
+	0x09f2: "Audeara Pty Ltd",
+	0x09f3: "Beijing Zero Zero Infinity Technology Co.,Ltd.",
+	0x09f4: "Spectrum Technologies, Inc.",
+	0x09f5: "OKI Electric Industry Co., Ltd",
+	0x09f6: "Mobile Action Technology Inc.",
+	0x09f7: "SENSATEC Co., Ltd.",
+	0x09f8: "R.O. S.R.L.",
+	0x09f9: "Hangzhou Yaguan Technology Co. LTD",
+	0x09fa: "Listen Technologies Corporation",
+	0x09fb: "TOITU CO., LTD.",
+	0x09fc: "Confidex",
+	0x09fe: "Lichtvision Engineering GmbH",
+	0x09ff: "AIRSTAR",
+	0x0a00: "Ampler Bikes OU",
+	0x0a01: "Cleveron AS",
+	0x0a02: "Ayxon-Dynamics GmbH",
+	0x0a03: "donutrobotics Co., Ltd.",
+	0x0a04: "Flosonics Medical",
+	0x0a05: "Southwire Company, LLC",
+	0x0a06: "Shanghai wuqi microelectronics Co.,Ltd",
+	0x0a07: "Reflow Pty Ltd",
+	0x0a08: "Oras Oy",
+	0x0a0a: "Volan Technology Inc.",
+	0x0a0c: "Shanghai Yidian Intelligent Technology Co., Ltd.",
+	0x0a0d: "Blue Peacock GmbH",
+	0x0a0e: "Roland Corporation",
+	0x0a0f: "LIXIL Corporation",
+	0x0a10: "SUBARU Corporation",
+	0x0a11: "Sensolus",
+	0x0a12: "Dyson Technology Limited",
+	0x0a13: "Tec4med LifeScience GmbH",
+	0x0a14: "CROXEL, INC.",
+	0x0a15: "Syng Inc",
+	0x0a17: "Plume Design Inc",
+	0x0a18: "Cambridge Animal Technologies Ltd",
+	0x0a19: "Maxell, Ltd.",
+	0x0a1a: "Link Labs, Inc.",
+	0x0a1b: "Embrava Pty Ltd",
+	0x0a1c: "INPEAK sp. z o.o.",
+	0x0a1d: "API-K",
+	0x0a1e: "CombiQ AB",
+	0x0a1f: "DeVilbiss Healthcare LLC",
+	0x0a20: "Jiangxi Innotech Technology Co., Ltd",
+	0x0a21: "Apollogic Sp. z o.o.",
+	0x0a22: "DAIICHIKOSHO CO., LTD.",
+	0x0a23: "BIXOLON CO.,LTD",
+	0x0a24: "Atmosic Technologies, Inc.",
+	0x0a25: "Eran Financial Services LLC",
+	0x0a26: "Louis Vuitton",
+	0x0a28: "NanoFlex Power Corporation",
+	0x0a29: "Worthcloud Technology Co.,Ltd",
+	0x0a2a: "Yamaha Corporation",
+	0x0a2b: "PaceBait IVS",
+	0x0a2c: "Shenzhen H&T Intelligent Control Co., Ltd",
+	0x0a2d: "Shenzhen Feasycom Technology Co., Ltd.",
+	0x0a2f: "Instamic, Inc.",
+	0x0a30: "Air-Weigh",
+	0x0a31: "Nevro Corp.",
+	0x0a32: "Pinnacle Technology, Inc.",
+	0x0a33: "WMF AG",
+	0x0a34: "Luxer Corporation",
+	0x0a35: "safectory GmbH",
+	0x0a36: "NGK SPARK PLUG CO., LTD.",
+	0x0a37: "2587702 Ontario Inc.",
+	0x0a38: "Bouffalo Lab (Nanjing)., Ltd.",
+	0x0a39: "BLUETICKETING SRL",
+	0x0a3b: "Galileo Technology Limited",
+	0x0a3c: "Siteco GmbH",
+	0x0a3d: "DELABIE",
+	0x0a3f: "Shenzhen Yopeak Optoelectronics Technology Co., Ltd.",
+	0x0a41: "OPEX Corporation",
+	0x0a42: "Motionalysis, Inc.",
+	0x0a43: "Busch Systems International Inc.",
+	0x0a44: "Novidan, Inc.",
+	0x0a45: "3SI Security Systems, Inc",
+	0x0a46: "Beijing HC-Infinite Technology Limited",
+	0x0a47: "The Wand Company Ltd",
+	0x0a48: "JRC Mobility Inc.",
+	0x0a4a: "Map Large, Inc.",
+	0x0a4b: "MistyWest Energy and Transport Ltd.",
+	0x0a4c: "SiFli Technologies (shanghai) Inc.",
+	0x0a4d: "Lockn Technologies Private Limited",
+	0x0a4e: "Toytec Corporation",
+	0x0a4f: "VANMOOF Global Holding B.V.",
+	0x0a50: "Nextscape Inc.",
+	0x0a51: "CSIRO",
+	0x0a52: "Follow Sense Europe B.V.",
+	0x0a53: "KKM COMPANY LIMITED",
+	0x0a54: "SQL Technologies Corp.",
+	0x0a55: "Inugo Systems Limited",
+	0x0a56: "ambie",
+	0x0a57: "Meizhou Guo Wei Electronics Co., Ltd",
+	0x0a58: "Indigo Diabetes",
+	0x0a59: "TourBuilt, LLC",
+	0x0a5a: "Sontheim Industrie Elektronik GmbH",
+	0x0a5b: "LEGIC Identsystems AG",
+	0x0a5c: "Innovative Design Labs Inc.",
+	0x0a5d: "MG Energy Systems B.V.",
+	0x0a5f: "stryker",
+	0x0a60: "DATANG SEMICONDUCTOR TECHNOLOGY CO.,LTD",
+	0x0a61: "Smart Parks B.V.",
+	0x0a62: "MOKO TECHNOLOGY Ltd",
+	0x0a64: "Geopal system A/S",
+	0x0a65: "Lytx, INC.",
+	0x0a67: "Beijing SuperHexa Century Technology CO. Ltd",
+	0x0a68: "Focus Ingenieria SRL",
+	0x0a69: "HAPPIEST BABY, INC.",
+	0x0a6a: "Scribble Design Inc.",
+	0x0a6b: "Olympic Ophthalmics, Inc.",
+	0x0a6c: "Pokkels",
+	0x0a6e: "Pac Sane Limited",
+	0x0a6f: "Warner Bros.",
+	0x0a70: "Ooma",
+	0x0a71: "Senquip Pty Ltd",
+	0x0a72: "Jumo GmbH & Co. KG",
+	0x0a73: "Innohome Oy",
+	0x0a74: "MICROSON S.A.",
+	0x0a75: "Delta Cycle Corporation",
+	0x0a76: "Synaptics Incorporated",
+	0x0a77: "AXTRO PTE. LTD.",
+	0x0a78: "Shenzhen Sunricher Technology Limited",
+	0x0a79: "Webasto SE",
+	0x0a7a: "Emlid Limited",
+	0x0a7b: "UniqAir Oy",
+	0x0a7c: "WAFERLOCK",
+	0x0a7d: "Freedman Electronics Pty Ltd",
+	0x0a7e: "KEBA Handover Automation GmbH",
+	0x0a7f: "Intuity Medical",
+	0x0a80: "Cleer Limited",
+	0x0a81: "Universal Biosensors Pty Ltd",
+	0x0a82: "Corsair",
+	0x0a83: "Rivata, Inc.",
+	0x0a84: "Greennote Inc,",
+	0x0a85: "Snowball Technology Co., Ltd.",
+	0x0a86: "ALIZENT International",
+	0x0a87: "Shanghai Smart System Technology Co., Ltd",
+	0x0a88: "PSA Peugeot Citroen",
+	0x0a89: "VusionGroup",
+	0x0a8a: "HAINBUCH GMBH SPANNENDE TECHNIK",
+	0x0a8b: "SANlight GmbH",
+	0x0a8c: "DelpSys, s.r.o.",
+	0x0a8d: "JCM TECHNOLOGIES S.A.",
+	0x0a8e: "Perfect Company",
+	0x0a8f: "TOTO LTD.",
+	0x0a90: "Shenzhen Grandsun Electronic Co.,Ltd.",
+	0x0a91: "Monarch International Inc.",
+	0x0a92: "Carestream Dental LLC",
+	0x0a93: "GiPStech S.r.l.",
+	0x0a94: "OOBIK Inc.",
+	0x0a95: "Pamex Inc.",
+	0x0a98: "Foil, Inc.",
+	0x0a99: "Shanghai high-flying electronics technology Co.,Ltd",
+	0x0a9a: "TEMKIN ASSOCIATES, LLC",
+	0x0a9b: "Eello LLC",
+	0x0a9c: "Xi'an Fengyu Information Technology Co., Ltd.",
+	0x0a9d: "Canon Finetech Nisca Inc.",
+	0x0a9f: "ista International GmbH",
+	0x0aa0: "Loy Tec electronics GmbH",
+	0x0aa1: "LINCOGN TECHNOLOGY CO. LIMITED",
+	0x0aa2: "Care Bloom, LLC",
+	0x0aa3: "DIC Corporation",
+	0x0aa4: "FAZEPRO LLC",
+	0x0aa5: "Shenzhen Uascent Technology Co., Ltd",
+	0x0aa6: "Realityworks, inc.",
+	0x0aa7: "Urbanista AB",
+	0x0aa8: "Zencontrol Pty Ltd",
+	0x0aa9: "Spintly, Inc.",
+	0x0aaa: "Computime International Ltd",
+	0x0aab: "Anhui Listenai Co",
+	0x0aac: "OSM HK Limited",
+	0x0aad: "Adevo Consulting AB",
+	0x0aae: "PS Engineering, Inc.",
+	0x0aaf: "AIAIAI ApS",
+	0x0ab0: "Visiontronic s.r.o.",
+	0x0ab1: "InVue Security Products Inc",
+	0x0ab2: "TouchTronics, Inc.",
+	0x0ab3: "INNER RANGE PTY. LTD.",
+	0x0ab4: "Ellenby Technologies, Inc.",
+	0x0ab5: "Elstat Electronics Ltd.",
+	0x0ab6: "Xenter, Inc.",
+	0x0ab7: "LogTag North America Inc.",
+	0x0ab8: "Sens.ai Incorporated",
+	0x0ab9: "STL",
+	0x0aba: "Open Bionics Ltd.",
+	0x0abb: "R-DAS, s.r.o.",
+	0x0abc: "KCCS Mobile Engineering Co., Ltd.",
+	0x0abd: "Inventas AS",
+	0x0abe: "Robkoo Information & Technologies Co., Ltd.",
+	0x0abf: "PAUL HARTMANN AG",
+	0x0ac0: "Omni-ID USA, INC.",
+	0x0ac1: "Shenzhen Jingxun Technology Co., Ltd.",
+	0x0ac2: "RealMega Microelectronics technology (Shanghai) Co. Ltd.",
+	0x0ac3: "Kenzen, Inc.",
+	0x0ac4: "CODIUM",
+	0x0ac5: "Flexoptix GmbH",
+	0x0ac6: "Barnes Group Inc.",
+	0x0ac7: "Chengdu Aich Technology Co.,Ltd",
+	0x0ac8: "Keepin Co., Ltd.",
+	0x0ac9: "Swedlock AB",
+	0x0aca: "Shenzhen CoolKit Technology Co., Ltd",
+	0x0acb: "ise Individuelle Software und Elektronik GmbH",
+	0x0acc: "Nuvoton",
+	0x0acd: "Visuallex Sport International Limited",
+	0x0ace: "KOBATA GAUGE MFG. CO., LTD.",
+	0x0acf: "CACI Technologies",
+	0x0ad0: "Nordic Strong ApS",
+	0x0ad2: "Lautsprecher Teufel GmbH",
+	0x0ad3: "SSV Software Systems GmbH",
+	0x0ad4: "Zhuhai Pantum Electronisc Co., Ltd",
+	0x0ad5: "Streamit B.V.",
+	0x0ad6: "nymea GmbH",
+	0x0ad7: "AL-KO Geraete GmbH",
+	0x0ad8: "Franz Kaldewei GmbH&Co KG",
+	0x0ada: "Codefabrik GmbH",
+	0x0adb: "Reelables, Inc.",
+	0x0adc: "Duravit AG",
+	0x0add: "Boss Audio",
+	0x0ade: "Vocera Communications, Inc.",
+	0x0adf: "Douglas Dynamics L.L.C.",
+	0x0ae3: "GlobalMed",
+	0x0ae4: "DALI Alliance",
+	0x0ae5: "unu GmbH",
+	0x0ae6: "Hexology",
+	0x0ae7: "Sunplus Technology Co., Ltd.",
+	0x0ae8: "LEVEL, s.r.o.",
+	0x0ae9: "FLIR Systems AB",
+	0x0aea: "Borda Technology",
+	0x0aeb: "Square, Inc.",
+	0x0aec: "FUTEK ADVANCED SENSOR TECHNOLOGY, INC",
+	0x0aed: "Saxonar GmbH",
+	0x0aee: "Velentium, LLC",
+	0x0aef: "GLP German Light Products GmbH",
+	0x0af0: "Leupold & Stevens, Inc.",
+	0x0af1: "CRADERS,CO.,LTD",
+	0x0af3: "701x Inc.",
+	0x0af4: "Radioworks Microelectronics PTY LTD",
+	0x0af5: "Unitech Electronic Inc.",
+	0x0af6: "AMETEK, Inc.",
+	0x0af7: "Irdeto",
+	0x0af8: "First Design System Inc.",
+	0x0af9: "Unisto AG",
+	0x0afa: "Chengdu Ambit Technology Co., Ltd.",
+	0x0afb: "SMT ELEKTRONIK GmbH",
+	0x0afc: "Cerebrum Sensor Technologies Inc.",
+	0x0afd: "Weber Sensors, LLC",
+	0x0afe: "Earda Technologies Co.,Ltd",
+	0x0aff: "FUSEAWARE LIMITED",
+	0x0b00: "Flaircomm Microelectronics Inc.",
+	0x0b01: "RESIDEO TECHNOLOGIES, INC.",
+	0x0b02: "IORA Technology Development Ltd. Sti.",
+	0x0b03: "Precision Triathlon Systems Limited",
+	0x0b05: "Marquardt GmbH",
+	0x0b06: "FAZUA GmbH",
+	0x0b07: "Workaround Gmbh",
+	0x0b08: "Shenzhen Qianfenyi Intelligent Technology Co., LTD",
+	0x0b0a: "Belun Technology Company Limited",
+	0x0b0b: "Sanistaal A/S",
+	0x0b0c: "BluPeak",
+	0x0b0d: "SANYO DENKO Co.,Ltd.",
+	0x0b0e: "Minebea Access Solutions Inc.",
+	0x0b0f: "B.E.A. S.A.",
+	0x0b10: "Alfa Laval Corporate AB",
+	0x0b11: "ThermoWorks, Inc.",
+	0x0b12: "ToughBuilt Industries LLC",
+	0x0b13: "IOTOOLS",
+	0x0b14: "Olumee",
+	0x0b15: "NAOS JAPAN K.K.",
+	0x0b16: "Guard RFID Solutions Inc.",
+	0x0b17: "SIG SAUER, INC.",
+	0x0b18: "DECATHLON SE",
+	0x0b19: "WBS PROJECT H PTY LTD",
+	0x0b1a: "Roca Sanitario, S.A.",
+	0x0b1c: "Nanoleq AG",
+	0x0b1d: "Accelerated Systems",
+	0x0b1e: "PB INC.",
+	0x0b1f: "Beijing ESWIN Computing Technology Co., Ltd.",
+	0x0b20: "TKH Security B.V.",
+	0x0b22: "Hygiene IQ, LLC.",
+	0x0b23: "iRhythm Technologies, Inc.",
+	0x0b24: "BeiJing ZiJie TiaoDong KeJi Co.,Ltd.",
+	0x0b25: "NIBROTECH LTD",
+	0x0b26: "Baracoda Daily Healthtech.",
+	0x0b27: "Lumi United Technology Co., Ltd",
+	0x0b29: "Tech-Venom Entertainment Private Limited",
+	0x0b2b: "MAINBOT",
+	0x0b2c: "ILLUMAGEAR, Inc.",
+	0x0b2d: "REDARC ELECTRONICS PTY LTD",
+	0x0b2e: "MOCA System Inc.",
+	0x0b2f: "Duke Manufacturing Co",
+	0x0b30: "ART SPA",
+	0x0b31: "Silver Wolf Vehicles Inc.",
+	0x0b32: "Hala Systems, Inc.",
+	0x0b33: "ARMATURA LLC",
+	0x0b34: "CONZUMEX INDUSTRIES PRIVATE LIMITED",
+	0x0b35: "BH SENS",
+	0x0b36: "SINTEF",
+	0x0b37: "Omnivoltaic Energy Solutions Limited Company",
+	0x0b38: "WISYCOM S.R.L.",
+	0x0b39: "Red 100 Lighting Co., ltd.",
+	0x0b3a: "Impact Biosystems, Inc.",
+	0x0b3b: "AIC semiconductor (Shanghai) Co., Ltd.",
+	0x0b3c: "Dodge Industrial, Inc.",
+	0x0b3d: "REALTIMEID AS",
+	0x0b3e: "ISEO Serrature S.p.a.",
+	0x0b3f: "MindRhythm, Inc.",
+	0x0b40: "Havells India Limited",
+	0x0b41: "Sentrax GmbH",
+	0x0b42: "TSI",
+	0x0b43: "INCITAT ENVIRONNEMENT",
+	0x0b44: "nFore Technology Co., Ltd.",
+	0x0b45: "Electronic Sensors, Inc.",
+	0x0b47: "Gentex Corporation",
+	0x0b48: "NIO USA, Inc.",
+	0x0b49: "SkyHawke Technologies",
+	0x0b4a: "Nomono AS",
+	0x0b4b: "EMS Integrators, LLC",
+	0x0b4c: "BiosBob.Biz",
+	0x0b4d: "Adam Hall GmbH",
+	0x0b4e: "ICP Systems B.V.",
+	0x0b4f: "Breezi.io, Inc.",
+	0x0b50: "Mesh Systems LLC",
+	0x0b51: "FUN FACTORY GmbH",
+	0x0b52: "ZIIP Inc",
+	0x0b53: "SHENZHEN KAADAS INTELLIGENT TECHNOLOGY CO.,Ltd",
+	0x0b54: "Emotion Fitness GmbH & Co. KG",
+	0x0b55: "H G M Automotive Electronics, Inc.",
+	0x0b56: "BORA - Vertriebs GmbH & Co KG",
+	0x0b57: "CONVERTRONIX TECHNOLOGIES AND SERVICES LLP",
+	0x0b58: "TOKAI-DENSHI INC",
+	0x0b5b: "Shenzhen ImagineVision Technology Limited",
+	0x0b5d: "Fujian Newland Auto-ID Tech. Co., Ltd.",
+	0x0b5e: "CELLCONTROL, INC.",
+	0x0b5f: "Rivieh, Inc.",
+	0x0b60: "RATOC Systems, Inc.",
+	0x0b61: "Sentek Pty Ltd",
+	0x0b62: "NOVEA ENERGIES",
+	0x0b63: "Innolux Corporation",
+	0x0b64: "NingBo klite Electric Manufacture Co.,LTD",
+	0x0b65: "The Apache Software Foundation",
+	0x0b66: "MITSUBISHI ELECTRIC AUTOMATION (THAILAND) COMPANY LIMITED",
+	0x0b68: "Quha oy",
+	0x0b69: "Addaday",
+	0x0b6a: "Dymo",
+	0x0b6b: "Samsara Networks, Inc",
+	0x0b6c: "Sensitech, Inc.",
+	0x0b6d: "SOLUM CO., LTD",
+	0x0b6e: "React Mobile",
+	0x0b70: "JDRF Electromag Engineering Inc",
+	0x0b71: "lilbit ODM AS",
+	0x0b72: "Geeknet, Inc.",
+	0x0b73: "HARADA INDUSTRY CO., LTD.",
+	0x0b74: "BQN",
+	0x0b75: "Triple W Japan Inc.",
+	0x0b76: "MAX-co., ltd",
+	0x0b77: "Aixlink(Chengdu) Co., Ltd.",
+	0x0b78: "FIELD DESIGN INC.",
+	0x0b79: "Sankyo Air Tech Co.,Ltd.",
+	0x0b7a: "Shenzhen KTC Technology Co.,Ltd.",
+	0x0b7b: "Hardcoder Oy",
+	0x0b7c: "Scangrip A/S",
+	0x0b7d: "FoundersLane GmbH",
+	0x0b7e: "Offcode Oy",
+	0x0b7f: "ICU tech GmbH",
+	0x0b80: "AXELIFE",
+	0x0b81: "SCM Group",
+	0x0b82: "Mammut Sports Group AG",
+	0x0b83: "Taiga Motors Inc.",
+	0x0b84: "Presidio Medical, Inc.",
+	0x0b85: "VIMANA TECH PTY LTD",
+	0x0b86: "Trek Bicycle",
+	0x0b87: "Ampetronic Ltd",
+	0x0b88: "Muguang (Guangdong) Intelligent Lighting Technology Co., Ltd",
+	0x0b89: "Rotronic AG",
+	0x0b8a: "Seiko Instruments Inc.",
+	0x0b8b: "American Technology Components, Incorporated",
+	0x0b8c: "MOTREX",
+	0x0b8d: "Pertech Industries Inc",
+	0x0b8e: "Gentle Energy Corp.",
+	0x0b8f: "Senscomm Semiconductor Co., Ltd.",
+	0x0b91: "Alfen ICU B.V.",
+	0x0b93: "Hangzhou BroadLink Technology Co., Ltd.",
+	0x0b94: "Dreem SAS",
+	0x0b96: "Telecom Design",
+	0x0b97: "SILVER TREE LABS, INC.",
+	0x0b98: "Gymstory B.V.",
+	0x0b99: "The Goodyear Tire & Rubber Company",
+	0x0b9a: "Beijing Wisepool Infinite Intelligence Technology Co.,Ltd",
+	0x0b9c: "Komatsu Ltd.",
+	0x0b9d: "Sensoria Holdings LTD",
+	0x0b9e: "Audio Partnership Plc",
+	0x0b9f: "Group Lotus Limited",
+	0x0ba0: "Data Sciences International",
+	0x0ba1: "Bunn-O-Matic Corporation",
+	0x0ba2: "TireCheck GmbH",
+	0x0ba3: "Sonova Consumer Hearing GmbH",
+	0x0ba4: "Vervent Audio Group",
+	0x0ba5: "SONICOS ENTERPRISES, LLC",
+	0x0ba6: "Nissan Motor Co., Ltd.",
+	0x0ba7: "hearX Group (Pty) Ltd",
+	0x0ba8: "GLOWFORGE INC.",
+	0x0ba9: "Allterco Robotics ltd",
+	0x0baa: "Infinitegra, Inc.",
+	0x0bab: "Grandex International Corporation",
+	0x0bac: "Machfu Inc.",
+	0x0bad: "Roambotics, Inc.",
+	0x0bae: "Soma Labs LLC",
+	0x0baf: "NITTO KOGYO CORPORATION",
+	0x0bb0: "Ecolab Inc.",
+	0x0bb1: "Beijing ranxin intelligence technology Co.,LTD",
+	0x0bb2: "Fjorden Electra AS",
+	0x0bb3: "Flender GmbH",
+	0x0bb4: "New Cosmos USA, Inc.",
+	0x0bb5: "Xirgo Technologies, LLC",
+	0x0bb6: "Build With Robots Inc.",
+	0x0bb7: "IONA Tech LLC",
+	0x0bb8: "INNOVAG PTY. LTD.",
+	0x0bb9: "SaluStim Group Oy",
+	0x0bba: "Huso, INC",
+	0x0bbb: "SWISSINNO SOLUTIONS AG",
+	0x0bbc: "T2REALITY SOLUTIONS PRIVATE LIMITED",
+	0x0bbe: "SAAB Aktiebolag",
+	0x0bbf: "HIMSA II K/S",
+	0x0bc0: "READY FOR SKY LLP",
+	0x0bc1: "Miele & Cie. KG",
+	0x0bc2: "EntWick Co.",
+	0x0bc3: "MCOT INC.",
+	0x0bc4: "TECHTICS ENGINEERING B.V.",
+	0x0bc5: "Aperia Technologies, Inc.",
+	0x0bc6: "TCL COMMUNICATION EQUIPMENT CO.,LTD.",
+	0x0bc7: "Signtle Inc.",
+	0x0bc8: "OTF Distribution, LLC",
+	0x0bc9: "Neuvatek Inc.",
+	0x0bca: "Perimeter Technologies, Inc.",
+	0x0bcb: "Divesoft s.r.o.",
+	0x0bcc: "Sylvac sa",
+	0x0bcd: "Amiko srl",
+	0x0bce: "Neurosity, Inc.",
+	0x0bcf: "LL Tec Group LLC",
+	0x0bd0: "Durag GmbH",
+	0x0bd1: "Hubei Yuan Times Technology Co., Ltd.",
+	0x0bd2: "IDEC",
+	0x0bd3: "Procon Analytics, LLC",
+	0x0bd4: "ndd Medizintechnik AG",
+	0x0bd5: "Super B Lithium Power B.V.",
+	0x0bd6: "Shenzhen Injoinic Technology Co., Ltd.",
+	0x0bd8: "PURA SCENTS, INC.",
+	0x0bda: "Aardex Ltd.",
+	0x0bdb: "CHAR-BROIL, LLC",
+	0x0bdc: "TWINKLY SRL",
+	0x0bdd: "Coroflo Limited",
+	0x0bde: "Yale",
+	0x0bdf: "WINKEY ENTERPRISE (HONG KONG) LIMITED",
+	0x0be0: "Koizumi Lighting Technology corp.",
+	0x0be2: "OTC engineering",
+	0x0be3: "Comtel Systems Ltd.",
+	0x0be4: "Deepfield Connect GmbH",
+	0x0be5: "ZWILLING J.A. Henckels Aktiengesellschaft",
+	0x0be6: "Puratap Pty Ltd",
+	0x0be7: "Fresnel Technologies, Inc.",
+	0x0be8: "Sensormate AG",
+	0x0be9: "Shindengen Electric Manufacturing Co., Ltd.",
+	0x0bea: "Twenty Five Seven, prodaja in storitve, d.o.o.",
+	0x0beb: "Luna Health, Inc.",
+	0x0bed: "CORAL-TAIYI Co. Ltd.",
+	0x0bee: "LINKSYS USA, INC.",
+	0x0bef: "Safetytest GmbH",
+	0x0bf0: "KIDO SPORTS CO., LTD.",
+	0x0bf1: "Site IQ LLC",
+	0x0bf2: "Angel Medical Systems, Inc.",
+	0x0bf3: "PONE BIOMETRICS AS",
+	0x0bf5: "T5 tek, Inc.",
+	0x0bf6: "greenTEG AG",
+	0x0bf7: "Wacker Neuson SE",
+	0x0bf8: "Innovacionnye Resheniya",
+	0x0bfa: "CleanBands Systems Ltd.",
+	0x0bfb: "Dodam Enersys Co., Ltd",
+	0x0bfc: "T+A elektroakustik GmbH & Co.KG",
+	0x0bfd: "Esmé Solutions",
+	0x0bfe: "Media-Cartec GmbH",
+	0x0bff: "Ratio Electric BV",
+	0x0c00: "MQA Limited",
+	0x0c01: "NEOWRK SISTEMAS INTELIGENTES S.A.",
+	0x0c02: "Loomanet, Inc.",
+	0x0c03: "Puff Corp",
+	0x0c04: "Happy Health, Inc.",
+	0x0c05: "Montage Connect, Inc.",
+	0x0c06: "LED Smart Inc.",
+	0x0c07: "CONSTRUKTS, INC.",
+	0x0c08: "limited liability company \"Red\"",
+	0x0c09: "Senic Inc.",
+	0x0c0a: "Automated Pet Care Products, LLC",
+	0x0c0b: "aconno GmbH",
+	0x0c0c: "Mendeltron, Inc.",
+	0x0c0d: "Mereltron bv",
+	0x0c0e: "ALEX DENKO CO.,LTD.",
+	0x0c0f: "AETERLINK",
+	0x0c10: "Cosmed s.r.l.",
+	0x0c11: "Gordon Murray Design Limited",
+	0x0c12: "IoSA",
+	0x0c13: "Scandinavian Health Limited",
+	0x0c14: "Fasetto, Inc.",
+	0x0c15: "Geva Sol B.V.",
+	0x0c16: "TYKEE PTY. LTD.",
+	0x0c17: "SomnoMed Limited",
+	0x0c18: "CORROHM",
+	0x0c19: "Arlo Technologies, Inc.",
+	0x0c1a: "Catapult Group International Ltd",
+	0x0c1b: "Rockchip Electronics Co., Ltd.",
+	0x0c1c: "GEMU",
+	0x0c1d: "OFF Line Japan Co., Ltd.",
+	0x0c1e: "EC sense co., Ltd",
+	0x0c1f: "LVI Co.",
+	0x0c20: "COMELIT GROUP S.P.A.",
+	0x0c21: "Foshan Viomi Electrical Technology Co., Ltd",
+	0x0c22: "Glamo Inc.",
+	0x0c23: "KEYTEC,Inc.",
+	0x0c24: "SMARTD TECHNOLOGIES INC.",
+	0x0c25: "JURA Elektroapparate AG",
+	0x0c26: "Performance Electronics, Ltd.",
+	0x0c27: "Pal Electronics",
+	0x0c28: "Embecta Corp.",
+	0x0c29: "DENSO AIRCOOL CORPORATION",
+	0x0c2a: "Caresix Inc.",
+	0x0c2b: "GigaDevice Semiconductor Inc.",
+	0x0c2c: "Zeku Technology (Shanghai) Corp., Ltd.",
+	0x0c2d: "OTF Product Sourcing, LLC",
+	0x0c2e: "Easee AS",
+	0x0c2f: "BEEHERO, INC.",
+	0x0c30: "McIntosh Group Inc",
+	0x0c31: "KINDOO LLP",
+	0x0c32: "Xian Yisuobao Electronic Technology Co., Ltd.",
+	0x0c33: "Exeger Operations AB",
+	0x0c34: "BYD Company Limited",
+	0x0c35: "Thermokon-Sensortechnik GmbH",
+	0x0c37: "SignalQuest, LLC",
+	0x0c38: "Noritz Corporation.",
+	0x0c39: "TIGER CORPORATION",
+	0x0c3b: "ORB Innovations Ltd",
+	0x0c3c: "Classified Cycling",
+	0x0c3d: "Wrmth Corp.",
+	0x0c3e: "BELLDESIGN Inc.",
+	0x0c3f: "Stinger Equipment, Inc.",
+	0x0c40: "HORIBA, Ltd.",
+	0x0c41: "Control Solutions LLC",
+	0x0c42: "Heath Consultants Inc.",
+	0x0c43: "Berlinger & Co. AG",
+	0x0c44: "ONCELABS LLC",
+	0x0c45: "Brose Verwaltung SE, Bamberg",
+	0x0c47: "Epsilon Electronics,lnc",
+	0x0c48: "VALEO MANAGEMENT SERVICES",
+	0x0c49: "twopounds gmbh",
+	0x0c4a: "atSpiro ApS",
+	0x0c4b: "ADTRAN, Inc.",
+	0x0c4c: "Orpyx Medical Technologies Inc.",
+	0x0c4d: "Seekwave Technology Co.,ltd.",
+	0x0c4e: "Tactile Engineering, Inc.",
+	0x0c4f: "SharkNinja Operating LLC",
+	0x0c50: "Imostar Technologies Inc.",
+	0x0c51: "INNOVA S.R.L.",
+	0x0c52: "ESCEA LIMITED",
+	0x0c53: "Taco, Inc.",
+	0x0c54: "HiViz Lighting, Inc.",
+	0x0c55: "Zintouch B.V.",
+	0x0c56: "Rheem Sales Company, Inc.",
+	0x0c57: "UNEEG medical A/S",
+	0x0c58: "Hykso Inc.",
+	0x0c59: "CYBERDYNE Inc.",
+	0x0c5a: "Lockswitch Sdn Bhd",
+	0x0c5b: "Alban Giacomo S.P.A.",
+	0x0c5c: "MGM WIRELESSS HOLDINGS PTY LTD",
+	0x0c5d: "StepUp Solutions ApS",
+	0x0c5e: "BlueID GmbH",
+	0x0c5f: "Wuxi Linkpower Microelectronics Co.,Ltd",
+	0x0c60: "KEBA Energy Automation GmbH",
+	0x0c61: "NNOXX, Inc",
+	0x0c62: "Phiaton Corporation",
+	0x0c63: "phg Peter Hengstler GmbH + Co. KG",
+	0x0c64: "dormakaba Holding AG",
+	0x0c65: "WAKO CO,.LTD",
+	0x0c67: "TRACKTING S.R.L.",
+	0x0c68: "Emerja Corporation",
+	0x0c6a: "CONSORCIO TRUST CONTROL - NETTEL",
+	0x0c6b: "GILSON SAS",
+	0x0c6c: "SNIFF LOGIC LTD",
+	0x0c6d: "Fidure Corp.",
+	0x0c6e: "Sensa LLC",
+	0x0c6f: "Parakey AB",
+	0x0c70: "SCARAB SOLUTIONS LTD",
+	0x0c71: "BitGreen Technolabz (OPC) Private Limited",
+	0x0c72: "StreetCar ORV, LLC",
+	0x0c73: "Truma Gerätetechnik GmbH & Co. KG",
+	0x0c74: "yupiteru",
+	0x0c75: "Embedded Engineering Solutions LLC",
+	0x0c77: "TEAC Corporation",
+	0x0c78: "CHARGTRON IOT PRIVATE LIMITED",
+	0x0c79: "Zhuhai Smartlink Technology Co., Ltd",
+	0x0c7a: "Triductor Technology (Suzhou), Inc.",
+	0x0c7b: "PT SADAMAYA GRAHA TEKNOLOGI",
+	0x0c7c: "Mopeka Products LLC",
+	0x0c7d: "3ALogics, Inc.",
+	0x0c7f: "Rochester Sensors, LLC",
+	0x0c80: "CARDIOID - TECHNOLOGIES, LDA",
+	0x0c81: "Carrier Corporation",
+	0x0c82: "NACON",
+	0x0c83: "Watchdog Systems LLC",
+	0x0c84: "MAXON INDUSTRIES, INC.",
+	0x0c85: "Amlogic, Inc.",
+	0x0c86: "Qingdao Eastsoft Communication Technology Co.,Ltd",
+	0x0c87: "Weltek Technologies Company Limited",
+	0x0c88: "Nextivity Inc.",
+	0x0c89: "AGZZX OPTOELECTRONICS TECHNOLOGY CO., LTD",
+	0x0c8a: "A.GLOBAL co.,Ltd.",
+	0x0c8b: "Heavys Inc",
+	0x0c8c: "T-Mobile USA",
+	0x0c8d: "tonies GmbH",
+	0x0c8e: "Technocon Engineering Ltd.",
+	0x0c8f: "Radar Automobile Sales(Shandong)Co.,Ltd.",
+	0x0c90: "WESCO AG",
+	0x0c91: "Yashu Systems",
+	0x0c92: "Kesseböhmer Ergonomietechnik GmbH",
+	0x0c93: "Movesense Oy",
+	0x0c94: "Baxter Healthcare Corporation",
+	0x0c95: "Gemstone Lights Canada Ltd.",
+	0x0c96: "H+B Hightech GmbH",
+	0x0c97: "Deako",
+	0x0c99: "Vire Health Oy",
+	0x0c9a: "ALF Inc.",
+	0x0c9b: "NTT sonority, Inc.",
+	0x0c9c: "Sunstone-RTLS Ipari Szolgaltato Korlatolt Felelossegu Tarsasag",
+	0x0c9d: "Ribbiot, INC.",
+	0x0c9f: "Dragonfly Energy Corp.",
+	0x0ca0: "BIGBEN",
+	0x0ca1: "YAMAHA MOTOR CO.,LTD.",
+	0x0ca2: "XSENSE LTD",
+	0x0ca3: "MAQUET GmbH",
+	0x0ca4: "MITSUBISHI ELECTRIC LIGHTING CO, LTD",
+	0x0ca5: "Princess Cruise Lines, Ltd.",
+	0x0ca6: "Megger Ltd",
+	0x0ca7: "Verve InfoTec Pty Ltd",
+	0x0ca8: "Sonas, Inc.",
+	0x0ca9: "Mievo Technologies Private Limited",
+	0x0caa: "Shenzhen Poseidon Network Technology Co., Ltd",
+	0x0cab: "HERUTU ELECTRONICS CORPORATION",
+	0x0cac: "Shenzhen Shokz Co.,Ltd.",
+	0x0cad: "Shenzhen Openhearing Tech CO., LTD .",
+	0x0cae: "Evident Corporation",
+	0x0caf: "NEURINNOV",
+	0x0cb0: "SwipeSense, Inc.",
+	0x0cb1: "RF Creations",
+	0x0cb2: "SHINKAWA Sensor Technology, Inc.",
+	0x0cb3: "janova GmbH",
+	0x0cb4: "Eberspaecher Climate Control Systems GmbH",
+	0x0cb5: "Racketry, d. o. o.",
+	0x0cb6: "THE EELECTRIC MACARON LLC",
+	0x0cb7: "Cucumber Lighting Controls Limited",
+	0x0cb9: "seca GmbH & Co. KG",
+	0x0cba: "Ameso Tech (OPC) Private Limited",
+	0x0cbb: "Emlid Tech Kft.",
+	0x0cbd: "Pricer AB",
+	0x0cbf: "Forward Thinking Systems LLC.",
+	0x0cc0: "Garnet Instruments Ltd.",
+	0x0cc1: "CLEIO Inc.",
+	0x0cc2: "Anker Innovations Limited",
+	0x0cc3: "HMD Global Oy",
+	0x0cc4: "ABUS August Bremicker Soehne Kommanditgesellschaft",
+	0x0cc5: "Open Road Solutions, Inc.",
+	0x0cc6: "Serial Technology Corporation",
+	0x0cc7: "SB C&S Corp.",
+	0x0cc8: "TrikThom",
+	0x0cc9: "Innocent Technology Co., Ltd.",
+	0x0cca: "Cyclops Marine Ltd",
+	0x0ccb: "NOTHING TECHNOLOGY LIMITED",
+	0x0ccc: "Kord Defence Pty Ltd",
+	0x0ccd: "YanFeng Visteon(Chongqing) Automotive Electronic Co.,Ltd",
+	0x0cce: "SENOSPACE LLC",
+	0x0ccf: "Shenzhen CESI Information Technology Co., Ltd.",
+	0x0cd0: "MooreSilicon Semiconductor Technology (Shanghai) Co., LTD.",
+	0x0cd1: "Imagine Marketing Limited",
+	0x0cd2: "EQOM SSC B.V.",
+	0x0cd3: "TechSwipe",
+	0x0cd4: "Reoqoo IoT Technology Co., Ltd.",
+	0x0cd5: "Numa Products, LLC",
+	0x0cd6: "HHO (Hangzhou) Digital Technology Co., Ltd.",
+	0x0cd7: "Maztech Industries, LLC",
+	0x0cd8: "SIA Mesh Group",
+	0x0cd9: "Minami acoustics Limited",
+	0x0cda: "Wolf Steel ltd",
+	0x0cdb: "Circus World Displays Limited",
+	0x0cdc: "Ypsomed AG",
+	0x0cdd: "Alif Semiconductor, Inc.",
+	0x0cdf: "SHENZHEN CHENYUN ELECTRONICS  CO., LTD",
+	0x0ce0: "VODALOGIC PTY LTD",
+	0x0ce1: "Regal Beloit America, Inc.",
+	0x0ce2: "CORVENT MEDICAL, INC.",
+	0x0ce3: "Taiwan Fuhsing",
+	0x0ce4: "Off-Highway Powertrain Services Germany GmbH",
+	0x0ce5: "Amina Distribution AS",
+	0x0ce6: "mwConnect",
+	0x0ce7: "TAG HEUER SA",
+	0x0ce8: "Dongguan Yougo Electronics Co.,Ltd.",
+	0x0ce9: "PEAG, LLC dba JLab Audio",
+	0x0cea: "HAYWARD INDUSTRIES, INC.",
+	0x0ceb: "Shenzhen Tingting Technology Co. LTD",
+	0x0cec: "Pacific Coast Fishery Services (2003) Inc.",
+	0x0ced: "CV. NURI TEKNIK",
+	0x0cee: "MadgeTech, Inc",
+	0x0cef: "POGS B.V.",
+	0x0cf0: "THOTAKA TEKHNOLOGIES INDIA PRIVATE LIMITED",
+	0x0cf1: "Midmark",
+	0x0cf3: "Radio Sound",
+	0x0cf4: "SOLUX PTY LTD",
+	0x0cf5: "BOS Balance of Storage Systems AG",
+	0x0cf6: "OJ Electronics A/S",
+	0x0cf7: "TVS Motor Company Ltd.",
+	0x0cf8: "core sensing GmbH",
+	0x0cf9: "Tamblue Oy",
+	0x0cfa: "Protect Animals With Satellites LLC",
+	0x0cfb: "Tyromotion GmbH",
+	0x0cfc: "ElectronX design",
+	0x0cfe: "Thule Group AB",
+	0x0d01: "KEEPEN",
+	0x0d02: "Rocky Mountain ATV/MC Jake Wilson",
+	0x0d03: "MakuSafe Corp",
+	0x0d04: "Bartec Auto Id Ltd",
+	0x0d05: "Energy Technology and Control Limited",
+	0x0d06: "doubleO Co., Ltd.",
+	0x0d07: "Datalogic S.r.l.",
+	0x0d08: "Datalogic USA, Inc.",
+	0x0d09: "Leica Geosystems AG",
+	0x0d0a: "CATEYE Co., Ltd.",
+	0x0d0b: "Research Products Corporation",
+	0x0d0c: "Planmeca Oy",
+	0x0d0d: "C.Ed. Schulte GmbH Zylinderschlossfabrik",
+	0x0d0e: "PetVoice Co., Ltd.",
+	0x0d0f: "Timebirds Australia Pty Ltd",
+	0x0d10: "JVC KENWOOD Corporation",
+	0x0d12: "Spartek Systems Inc.",
+	0x0d13: "MERRY ELECTRONICS CO., LTD.",
+	0x0d14: "Merry Electronics (S) Pte Ltd",
+	0x0d15: "Spark",
+	0x0d16: "Nations Technologies Inc.",
+	0x0d17: "Akix S.r.l.",
+	0x0d18: "Bioliberty Ltd",
+	0x0d19: "C.G. Air Systemes Inc.",
+	0x0d1a: "Maturix ApS",
+	0x0d1b: "RACHIO, INC.",
+	0x0d1c: "LIMBOID LLC",
+	0x0d1d: "Electronics4All Inc.",
+	0x0d1e: "FESTINA LOTUS SA",
+	0x0d1f: "Synkopi, Inc.",
+	0x0d20: "SCIENTERRA LIMITED",
+	0x0d21: "Cennox Group Limited",
+	0x0d22: "Cedarware, Corp.",
+	0x0d23: "GREE Electric Appliances, Inc. of Zhuhai",
+	0x0d24: "Japan Display Inc.",
+	0x0d25: "System Elite Holdings Group Limited",
+	0x0d26: "Burkert Werke GmbH & Co. KG",
+	0x0d27: "velocitux",
+	0x0d28: "FUJITSU COMPONENT LIMITED",
+	0x0d29: "MIYAKAWA ELECTRIC WORKS LTD.",
+	0x0d2a: "PhysioLogic Devices, Inc.",
+	0x0d2b: "Sensoryx AG",
+	0x0d2c: "SIL System Integration Laboratory GmbH",
+	0x0d2d: "Cooler Pro, LLC",
+	0x0d2e: "Advanced Electronic Applications, Inc",
+	0x0d2f: "Delta Development Team, Inc",
+	0x0d30: "Laxmi Therapeutic Devices, Inc.",
+	0x0d31: "SYNCHRON, INC.",
+	0x0d32: "Badger Meter",
+	0x0d33: "Micropower Group AB",
+	0x0d34: "ZILLIOT TECHNOLOGIES PRIVATE LIMITED",
+	0x0d35: "Universidad Politecnica de Madrid",
+	0x0d36: "XIHAO INTELLIGENGT TECHNOLOGY CO., LTD",
+	0x0d37: "Zerene Inc.",
+	0x0d38: "CycLock",
+	0x0d3a: "Frost Solutions, LLC",
+	0x0d3b: "Lone Star Marine Pty Ltd",
+	0x0d3c: "SIRONA Dental Systems GmbH",
+	0x0d3d: "bHaptics Inc.",
+	0x0d3e: "LUMINOAH, INC.",
+	0x0d3f: "Vogels Products B.V.",
+	0x0d40: "SignalFire Telemetry, Inc.",
+	0x0d41: "CPAC Systems AB",
+	0x0d42: "TEKTRO TECHNOLOGY CORPORATION",
+	0x0d43: "Gosuncn Technology Group Co., Ltd.",
+	0x0d44: "Ex Makhina Inc.",
+	0x0d45: "Odeon, Inc.",
+	0x0d46: "Thales Simulation & Training AG",
+	0x0d47: "Shenzhen DOKE Electronic Co., Ltd",
+	0x0d48: "Vemcon GmbH",
+	0x0d49: "Refrigerated Transport Electronics, Inc.",
+	0x0d4a: "Rockpile Solutions, LLC",
+	0x0d4b: "Soundwave Hearing, LLC",
+	0x0d4d: "Optec, LLC",
+	0x0d4e: "NIKAT SOLUTIONS PRIVATE LIMITED",
+	0x0d4f: "Movano Inc.",
+	0x0d50: "NINGBO FOTILE KITCHENWARE CO., LTD.",
+	0x0d51: "Genetus inc.",
+	0x0d52: "DIVAN TRADING CO., LTD.",
+	0x0d53: "Luxottica Group S.p.A",
+	0x0d54: "ISEKI FRANCE S.A.S",
+	0x0d55: "NO CLIMB PRODUCTS LTD",
+	0x0d56: "Wellang.Co,.Ltd",
+	0x0d57: "Nanjing Xinxiangyuan Microelectronics Co., Ltd.",
+	0x0d58: "ifm electronic gmbh",
+	0x0d59: "HYUPSUNG MACHINERY ELECTRIC CO., LTD.",
+	0x0d5a: "Gunnebo Aktiebolag",
+	0x0d5b: "Axis Communications AB",
+	0x0d5d: "Stogger B.V.",
+	0x0d5e: "Pella Corp",
+	0x0d5f: "SiChuan Homme Intelligent Technology co.,Ltd.",
+	0x0d60: "Smart Products Connection, S.A.",
+	0x0d61: "F.I.P. FORMATURA INIEZIONE POLIMERI - S.P.A.",
+	0x0d62: "MEBSTER s.r.o.",
+	0x0d63: "SKF France",
+	0x0d64: "Southco",
+	0x0d65: "Molnlycke Health Care AB",
+	0x0d66: "Hendrickson USA , L.L.C",
+	0x0d67: "BLACK BOX NETWORK SERVICES INDIA PRIVATE LIMITED",
+	0x0d68: "Status Audio LLC",
+	0x0d69: "AIR AROMA INTERNATIONAL PTY LTD",
+	0x0d6a: "Helge Kaiser GmbH",
+	0x0d6b: "Crane Payment Innovations, Inc.",
+	0x0d6d: "DYNAMOX S/A",
+	0x0d6e: "Look Cycle International",
+	0x0d6f: "Closed Joint Stock Company NVP BOLID",
+	0x0d70: "Kindhome",
+	0x0d71: "Kiteras Inc.",
+	0x0d72: "Earfun Technology (HK) Limited",
+	0x0d73: "iota Biosciences, Inc.",
+	0x0d74: "ANUME s.r.o.",
+	0x0d75: "Indistinguishable From Magic, Inc.",
+	0x0d76: "i-focus Co.,Ltd",
+	0x0d77: "DualNetworks SA",
+	0x0d78: "MITACHI CO.,LTD.",
+	0x0d79: "VIVIWARE JAPAN, Inc.",
+	0x0d7a: "Xiamen Intretech Inc.",
+	0x0d7b: "MindMaze SA",
+	0x0d7c: "BeiJing SmartChip Microelectronics Technology Co.,Ltd",
+	0x0d7d: "Taiko Audio B.V.",
+	0x0d7e: "Daihatsu Motor Co., Ltd.",
+	0x0d7f: "Konova",
+	0x0d80: "Gravaa B.V.",
+	0x0d81: "Beyerdynamic GmbH & Co. KG",
+	0x0d82: "VELCO",
+	0x0d83: "ATLANTIC SOCIETE FRANCAISE DE DEVELOPPEMENT THERMIQUE",
+	0x0d84: "Testo SE & Co. KGaA",
+	0x0d85: "SEW-EURODRIVE GmbH & Co KG",
+	0x0d86: "ROCKWELL AUTOMATION, INC.",
+	0x0d87: "Quectel Wireless Solutions Co., Ltd.",
+	0x0d89: "Nanohex Corp",
+	0x0d8a: "Simply Embedded Inc.",
+	0x0d8b: "Software Development, LLC",
+	0x0d8c: "Ultimea Technology (Shenzhen) Limited",
+	0x0d8d: "RF Electronics Limited",
+	0x0d8e: "Optivolt Labs, Inc.",
+	0x0d8f: "Canon Electronics Inc.",
+	0x0d90: "LAAS ApS",
+	0x0d91: "Beamex Oy Ab",
+	0x0d92: "TACHIKAWA CORPORATION",
+	0x0d93: "HagerEnergy GmbH",
+	0x0d95: "Hunter Industries Incorporated",
+	0x0d96: "NEOKOHM SISTEMAS ELETRONICOS LTDA",
+	0x0d97: "Zhejiang Huanfu Technology Co., LTD",
+	0x0d98: "E.F. Johnson Company",
+	0x0d99: "Caire Inc.",
+	0x0d9a: "Yeasound (Xiamen) Hearing Technology Co., Ltd",
+	0x0d9b: "Boxyz, Inc.",
+	0x0d9c: "Skytech Creations Limited",
+	0x0d9d: "Cear, Inc.",
+	0x0d9e: "Impulse Wellness LLC",
+	0x0d9f: "MML US, Inc",
+	0x0da0: "SICK AG",
+	0x0da1: "Fen Systems Ltd.",
+	0x0da2: "KIWI.KI GmbH",
+	0x0da3: "Airgraft Inc.",
+	0x0da4: "HP Tuners",
+	0x0da5: "PIXELA CORPORATION",
+	0x0da6: "Generac Corporation",
+	0x0da7: "Novoferm tormatic GmbH",
+	0x0da8: "Airwallet ApS",
+	0x0da9: "Inventronics GmbH",
+	0x0daa: "Shenzhen EBELONG Technology Co., Ltd.",
+	0x0dab: "Efento",
+	0x0dac: "ITALTRACTOR ITM S.P.A.",
+	0x0dae: "TITUM AUDIO, INC.",
+	0x0daf: "Hexagon Aura Reality AG",
+	0x0db0: "Invisalert Solutions, Inc.",
+	0x0db1: "TELE System Communications Pte. Ltd.",
+	0x0db2: "Whirlpool",
+	0x0db3: "SHENZHEN REFLYING ELECTRONIC CO., LTD",
+	0x0db4: "Franklin Control Systems",
+	0x0db5: "Djup AB",
+	0x0db6: "SAFEGUARD EQUIPMENT, INC.",
+	0x0db7: "Morningstar Corporation",
+	0x0db8: "Shenzhen Chuangyuan Digital Technology Co., Ltd",
+	0x0db9: "CompanyDeep Ltd",
+	0x0dba: "Veo Technologies ApS",
+	0x0dbb: "Nexis Link Technology Co., Ltd.",
+	0x0dbc: "Felion Technologies Company Limited",
+	0x0dbd: "MAATEL",
+	0x0dbe: "HELLA GmbH & Co. KGaA",
+	0x0dbf: "HWM-Water Limited",
+	0x0dc0: "Shenzhen Jahport Electronic Technology Co., Ltd.",
+	0x0dc1: "NACHI-FUJIKOSHI CORP.",
+	0x0dc2: "Cirrus Research plc",
+	0x0dc3: "GEARBAC TECHNOLOGIES INC.",
+	0x0dc4: "Hangzhou NationalChip Science & Technology Co.,Ltd",
+	0x0dc5: "DHL",
+	0x0dc6: "Levita",
+	0x0dc7: "MORNINGSTAR FX PTE. LTD.",
+	0x0dc8: "ETO GRUPPE TECHNOLOGIES GmbH",
+	0x0dc9: "farmunited GmbH",
+	0x0dca: "Aptener Mechatronics Private Limited",
+	0x0dcb: "GEOPH, LLC",
+	0x0dcc: "Trotec GmbH",
+	0x0dcd: "Astra LED AG",
+	0x0dce: "NOVAFON - Electromedical devices limited liability company",
+	0x0dcf: "KUBU SMART LIMITED",
+	0x0dd0: "ESNAH",
+	0x0dd1: "OrangeMicro Limited",
+	0x0dd2: "Fresh n Rebel B.V.",
+	0x0dd3: "Global Satellite Engineering",
+	0x0dd4: "KOQOON GmbH & Co.KG",
+	0x0dd5: "BEEPINGS",
+	0x0dd6: "MODULAR MEDICAL, INC.",
+	0x0dd7: "Xiant Technologies, Inc.",
+	0x0dd9: "SCHELL GmbH & Co. KG",
+	0x0dda: "Minebea Intec GmbH",
+	0x0ddb: "KAGA FEI Co., Ltd.",
+	0x0ddc: "AUTHOR-ALARM, razvoj in prodaja avtomobilskih sistemov proti kraji, d.o.o.",
+	0x0ddd: "Tozoa LLC",
+	0x0dde: "SHENZHEN DNS INDUSTRIES CO., LTD.",
+	0x0ddf: "Shenzhen Lunci Technology Co., Ltd",
+	0x0de0: "KNOG PTY. LTD.",
+	0x0de1: "Outshiny India Private Limited",
+	0x0de2: "TAMADIC Co., Ltd.",
+	0x0de3: "Shenzhen MODSEMI Co., Ltd",
+	0x0de4: "EMBEINT INC",
+	0x0de5: "Ehong Technology Co.,Ltd",
+	0x0de6: "DEXATEK Technology LTD",
+	0x0de7: "Dendro Technologies, Inc.",
+	0x0de8: "Vivint, Inc.",
+	0x0de9: "General Laser GmbH",
+	0x0dea: "Kathrein Solutions GmbH",
+	0x0deb: "Fitz Inc.",
+	0x0dec: "ATEGENOS PHARMACEUTICALS INC",
+	0x0ded: "Flextronic GmbH",
+	0x0dee: "Safety Swim LLC",
+	0x0def: "SING SUN TECHNOLOGY (INTERNATIONAL) LIMITED",
+	0x0df0: "Woncan (Hong Kong) Limited",
+	0x0df1: "iFLYTEK (Suzhou) Technology Co., Ltd.",
+	0x0df2: "Weber-Stephen Products LLC",
+	0x0df3: "hDrop Technologies Inc.",
+	0x0df4: "REEKON TOOLS INC.",
+	0x0df5: "Delta Faucet Company",
+	0x0df6: "Mutrack Co., Ltd",
+	0x0df7: "Hangzhou Zhaotong Microelectronics Co., Ltd.",
+	0x0df8: "Chengdu CSCT Microelectronics Co., Ltd.",
+	0x0df9: "Belusun Technology Ltd.",
+	0x0dfa: "Shenzhen Matches IoT Technology Co., Ltd.",
+	0x0dfb: "Beidou Intelligent Connected Vehicle Technology Co., Ltd.",
+	0x0dfc: "SOJI ELECTRONICS JOINT STOCK COMPANY",
+	0x0dfd: "BH Technologies",
+	0x0dfe: "Haptech, Inc.",
+	0x0dff: "WaveRF, Corp.",
+	0x0e00: "SHENZHEN SOUNDSOUL INFORMATION TECHNOLOGY CO.,LTD",
+	0x0e01: "Wuhu Mengbo Technology Co., Ltd.",
+	0x0e02: "PROSYS DEV LIMITED",
+	0x0e03: "Shenzhen eMeet technology Co.,Ltd",
+	0x0e04: "Doro AB",
+	0x0e05: "SUREPULSE MEDICAL LIMITED",
+	0x0e06: "iodyne, LLC",
+	0x0e07: "Pinpoint GmbH",
+	0x0e08: "Heinrich Kopp GmbH",
+	0x0e09: "Evolutive Systems SL",
+	0x0e0b: "Sounding Audio Industrial Ltd.",
+	0x0e0c: "Yuanfeng Technology Co., Ltd.",
+	0x0e0d: "FrontAct Co., Ltd.",
+	0x0e0f: "SenseWorks Tecnologia Ltda.",
+	0x0e10: "Eko Health, Inc.",
+	0x0e11: "Wanzl GmbH & Co. KGaA",
+	0x0e12: "CLEVER LOGGER TECHNOLOGIES PTY LIMITED",
+	0x0e13: "ASYSTOM",
+	0x0e14: "Heilongjiang Tianyouwei Electronics Co.,Ltd.",
+	0x0e15: "Eastern Partner Limited",
+	0x0e16: "Xiamen RUI YI Da Electronic Technology Co.,Ltd",
+	0x0e17: "Ad Hoc Electronics, llc.",
+	0x0e18: "Hangzhou Microimage Software Co.,Ltd.",
+	0x0e19: "Hive-Zox International SA",
+	0x0e1a: "Sensovo GmbH",
+	0x0e1b: "Time Location Systems AS",
+	0x0e1c: "SHENZHEN DIGITECH CO., LTD",
+	0x0e1d: "Capte B.V.",
+	0x0e1e: "9512-5837 QUEBEC INC.",
+	0x0e1f: "Blecon Ltd",
+	0x0e20: "CFLAB TEKNOLOJI TICARET LIMITED SIRKETI",
+	0x0e21: "FOGO",
+	0x0e22: "HITO INC",
+	0x0e23: "MS kajak7 UG (limited liability)",
+	0x0e24: "Avedis Zildjian Co.",
+	0x0e25: "Hangzhou Hikvision Digital Technology Co., Ltd.",
+	0x0e26: "LIHJOEN SPEED METER CO., LTD.",
+	0x0e27: "NextSense, Inc.",
+	0x0e28: "PatchRx, Inc.",
+	0x0e29: "Flipper Devices Inc.",
+	0x0e2a: "Huizhou Foryou General Electronics Co., Ltd.",
+	0x0e2b: "JE electronic a/s",
+	0x0e2c: "9313-7263 Quebec inc.",
+	0x0e2d: "ECARX (Hubei) Tech Co.,Ltd.",
+	0x0e2e: "NIHON KOHDEN CORPORATION",
+	0x0e2f: "ONWI",
+	0x0e30: "Primax Electronics Ltd.",
+	0x0e31: "AlphaTheta Corporation",
+	0x0e32: "PACIFIC INDUSTRIAL CO., LTD.",
+	0x0e33: "Crescent NV",
+	0x0e34: "Vermis, software solutions llc",
+	0x0e35: "SNAPPWISH LLC",
+	0x0e36: "Cousins and Sears LLC",
+	0x0e37: "CESYS Gesellschaft für angewandte Mikroelektronik mbH",
+	0x0e38: "SLOC GmbH",
+	0x0e39: "IRES Infrarot Energie Systeme GmbH",
+	0x0e3a: "OFIVE LIMITED",
+	0x0e3b: "Swift IOT Tech (Shenzhen) Co., LTD.",
+	0x0e3c: "Viselabs",
+	0x0e3d: "Walmart Inc.",
+	0x0e3e: "VANBOX",
+	0x0e3f: "Wiser Devices, LLC",
+	0x0e40: "WKD Labs Ltd",
+	0x0e41: "Asustek Computer Inc.",
+	0x0e42: "Z-ONE Technology Co., Ltd.",
+	0x0e43: "InnoVision Medical Technologies, LLC",
+	0x0e44: "QUANTATEC",
+	0x0e45: "Filo Srl",
+	0x0e46: "SOUNDUCT",
+	0x0e47: "Hosiden Besson Limited",
+	0x0e48: "KARLUNA MUHENDISLIK SANAYI VE TICARET ANONIM SIRKETI",
+	0x0e49: "Deone (Shanghai) Communication & Technology Co., Ltd",
+	0x0e4a: "Nitto Denko Corporation",
+	0x0e4b: "PUDSEY DIAMOND ENGINEERING LIMITED",
+	0x0e4c: "Luxshare Precision Industry Co., Ltd.",
+	0x0e4d: "Brooksee, Inc.",
+	0x0e4e: "QSC, LLC",
+	0x0e4f: "eBet Gaming Sytems Pty Limited",
+	0x0e50: "Zhejiang Desman Intelligent Technology Co., Ltd.",
+	0x0e51: "Dyaco International Inc.",
+	0x0e52: "Aquana, LLC",
+	0x0e53: "MINIRIG",
+	0x0e54: "After Technologies AS",
+	0x0e55: "New Cosmos Electric Co., Ltd.",
+	0x0e56: "INEPRO Metering B.V.",
+	0x0e57: "Altina Inc.",
+	0x0e58: "Urban Armor Gear, LLC",
+	0x0e59: "Loewe Technology GmbH",
+	0x0e5a: "OmniWave Microelectronics Shanghai Co., Ltd",
+	0x0e5b: "Wuhu Hongjing Electronic Co.,Ltd",
+	0x0e5c: "Rocoto Ltd",
+	0x0e5d: "L.T.H. Electronics Limited",
+	0x0e5e: "SHAPER TOOLS, INC.",
+	0x0e5f: "Ruptela",
+	0x0e60: "Ant Group Co., Ltd.",
+	0x0e61: "Queclink Wireless Solutions Co., Ltd.",
+	0x0e62: "GOKI PTY LTD",
+	0x0e63: "LAST LOCK INC.",
+	0x0e64: "HuiTong intelligence Company Limited",
+	0x0e65: "Daikin Industries, LTD",
+	0x0e66: "Shenzhen Baseus Technology Co., Ltd.",
+	0x0e67: "NEXT DEVICES LTDA",
+	0x0e69: "Megatronix (Beijing) Technology Co., Ltd",
+	0x0e6a: "Hyena Inc.",
+	0x0e6b: "Shenzhen Goodocom Information Technology Co., Ltd.",
+	0x0e6c: "RIGH, INC.",
+	0x0e6f: "OpConnect, Inc.",
+	0x0e70: "Powerstick.com",
+	0x0e71: "ENABLEWEAR LLC",
+	0x0e73: "Adventures of the Persistently Impaired (and other tales) Limited",
+	0x0e74: "Rocky Radios LLC",
+	0x0e75: "Le Touch (Shenzhen) Electronics Co., Ltd.",
+	0x0e76: "Guangdong Nanguang Photo&Video Systems Co., Ltd.",
+	0x0e77: "MOBILE TECH, INC.",
+	0x0e78: "HONG KONG COMMUNICATIONS COMPANY LIMITED",
+	0x0e79: "Neptune First OU",
+	0x0e7a: "Vivago Oy",
+	0x0e7b: "Circular",
+	0x0e7c: "final Inc.",
+	0x0e7d: "Jiangsu XinTongda Electric Technology Co.,Ltd.",
+	0x0e7e: "Archon Controls LLC",
+	0x0e7f: "SZR-Dev UG",
+	0x0e80: "IQNEXXT Solutions GmbH",
+	0x0e81: "Guangdong Hengqin Xingtong Technology Co.,ltd.",
+	0x0e82: "CHEVALIER TECH LIMITED",
+	0x0e83: "SPRiNTUS GmbH",
+	0x0e84: "Tymphany HK Ltd",
+	0x0e85: "TigerLight, Inc.",
+	0x0e86: "Mercury Marine, a division of Brunswick Corporation",
+	0x0e87: "OpenTech Alliance, Inc.",
+	0x0e88: "Skewered Fencing, LLC",
+	0x0e89: "Brudden",
+	0x0e8a: "Tele-Radio i Lysekil AB",
+	0x0e8b: "Allgon AB",
+	0x0e8c: "Gopod Group Holding Limited",
+	0x0e8d: "Celebrities Management Private Limited",
+	0x0e8e: "Nobest Inc",
+	0x0e8f: "Avetos Design LLC",
+	0x0e90: "RainMaker Solutions, Inc.",
+	0x0e91: "Identita Inc.",
+	0x0e92: "Glutz AG",
+	0x0e93: "MIV ELECTRONICS, LTD",
+	0x0e94: "Tactrix",
+	0x0e95: "Viaanix, Inc.",
+	0x0e96: "Skeed,co,Ltd.",
+	0x0e97: "kokoromil Inc.",
+	0x0e98: "Chromatic Inc.",
+	0x0e99: "Medibound, Inc.",
+	0x0e9a: "Scanbro OU",
+	0x0e9b: "Panasonic Automotive Systems Co., Ltd.",
+	0x0e9c: "Andrews & Arnold Ltd",
+	0x0e9d: "Audinor ApS",
+	0x0e9e: "Travelxp India Private Limited",
+	0x0e9f: "Owlet Baby Care Inc.",
+	0x0ea0: "ENLESS WIRELESS",
+	0x0ea1: "Culligan International Company",
+	0x0ea2: "QIKCONNEX LLC",
+	0x0ea3: "OLIS ELECTRONICS, LLC",
+	0x0ea4: "BiTECH Automotive (Wuhu) Co.,Ltd",
+	0x0ea6: "AMG Lab LLC",
+	0x0ea7: "BrickXter GmbH",
+	0x0ea8: "Dongguan Trangjan Industrial Co., Ltd",
+	0x0ea9: "Makichie Co., Ltd.",
+	0x0eab: "STEYR Sport GmbH",
+	0x0eac: "Dynetrex Solutions Inc.",
+	0x0ead: "OPTRON Co., Ltd.",
+	0x0eae: "BHClears Microelectronics (Shanghai) Co., Ltd.",
+	0x0eaf: "Unfolded Circle ApS",
+	0x0eb0: "FactorySense",
+	0x0eb1: "WearNex Limited",
+	0x0eb2: "PRADCO Outdoor Brands",
+	0x0eb3: "Zucchetti Axess",
+	0x0eb4: "BLUEFIN DATA, LLC",
+	0x0eb5: "Preseed Japan Corporation",
+	0x0eb6: "Server Products, Inc.",
+	0x0eb7: "Embedded Solutions LLC",
+	0x0eba: "THERMY LTD",
+	0x0ebb: "Asahi Denso Co.,Ltd.",
+	0x0ebc: "GP Acoustics International Limited",
+	0x0ebd: "Tongfang Health Technology (Beijing) Co., Ltd.",
+	0x0ebe: "Deity Acoustic Technology Co.",
+	0x0ebf: "Schulte-Schlagbaum AG",
+	0x0ec0: "WEST inx Ltd.",
+	0x0ec1: "Crossdoor",
+	0x0ec2: "High Entropy, LLC",
+	0x0ec3: "Herschel Infrared Ltd",
+	0x0ec4: "PACIFIC MARINE BATTERIES PTY. LIMITED",
+	0x0ec5: "Alibaba (China) Co., Ltd.",
+	0x0ec6: "AuthGate B.V.",
+	0x0ec7: "Canyon Bicycles GmbH",
+	0x0ec8: "Codie LLC",
+	0x0ec9: "NeuroPace Inc",
+	0x0eca: "NexRev LLC",
+	0x0ecb: "Zhong Shan City Richsound Electronic Industrial Ltd.",
+	0x0ecc: "Shenzhen NEOECO Technology Co., Ltd.",
+	0x0ecd: "Nature Inc.",
+	0x0ece: "Guangzhou Honor Microelectronic Co.,Ltd.",
+	0x0ecf: "GGEC America, Inc.",
+	0x0ed0: "Gibson, Inc.",
+	0x0ed1: "VINYL MATT MEDIA LIMITED",
+	0x0ed2: "SHENZHEN BESTWAY ELECTRONICS CO.,LTD",
+	0x0ed3: "Lichens Innovation inc.",
+	0x0ed4: "Goerdyna Group Co., Ltd",
+	0x0ed5: "Relish Technologies Limited",
+	0x0ed6: "Quintessential Design, Inc.",
+	0x0ed7: "CS INSTRUMENTS GmbH & Co.KG",
+	0x0ed8: "DORAN MFG. LLC",
+	0x0ed9: "Overhead Door Corporation",
+	0x0eda: "Kodira GmbH",
+	0x0edb: "ShenZhen BoYiChuangXin",
+	0x0edc: "Shenzhen Zoqin Technology Co., Ltd.",
+	0x0edd: "Ceridwen Limited",
+	0x0ede: "Sony Honda Mobility Inc.",
+	0x0edf: "Dynaudio A/S",
+	0x0ee0: "MAERSK CONTAINER INDUSTRY A/S",
+	0x0ee1: "MA MICRO LIMITED",
+	0x0ee2: "shenzhen hongever technology Co,. Ltd",
+	0x0ee3: "TAMRON Co., Ltd.",
+	0x0ee4: "CAPTEMP, LDA",
+	0x0ee5: "Ambient Life Inc.",
+	0x0ee6: "NOCTRIX HEALTH, INC",
+	0x0ee7: "RICKARD AIR DIFFUSION (PTY) LTD",
+	0x0ee8: "SG Armaturen AS",
+	0x0ee9: "PIXEL TI IND. E COM PROD ELETRONICOS",
+	0x0eea: "Core Devices LLC",
+	0x0eeb: "Fujita Electric Works, Ltd",
+	0x0eec: "Willow Laboratories, Inc.",
+	0x0eed: "BBC Bircher AG",
+	0x0eee: "ELLEA INGEGNERIA SRL UNIPERSONALE",
+	0x0eef: "Q42 Internet B.V.",
+	0x0ef0: "Seaward Electronic",
+	0x0ef1: "Wuxi Does IOT Co., Ltd",
+	0x0ef3: "Monil AS",
+	0x0ef5: "LS ELECTRIC Co., Ltd.",
+	0x0ef6: "Shenzhen Cyber Innovation Technology Co., Ltd.",
+	0x0ef7: "Trackonomy Systems, Inc.",
+	0x0ef8: "IoT Solutions Malta Limited",
+	0x0ef9: "Aseptico, Inc.",
+	0x0efa: "Sensear Pty Ltd",
+	0x0efb: "BLUEPROVIDERZ LLC",
+	0x0efc: "Jano Life Inc.",
+	0x0efd: "PRIMES GmbH",
+	0x0efe: "Teledyne Instruments, Inc.",
+	0x0eff: "Healthcare Technology Limited",
+	0x0f00: "TRACERCO LIMITED",
+	0x0f01: "HAPPLABS SOFTWARE PRIVATE LIMITED",
+	0x0f02: "GE HEALTHCARE TECHNOLOGIES INC.",
+	0x0f03: "Olibra LLC",
+	0x0f04: "Lumen Labs (HK) Ltd",
+	0x0f05: "SHENZHEN GWSTAI TECHNOLOGY CO.,LTD",
+	0x0f06: "SHENZHEN POWEROAK NEWENER CO., LTD",
+	0x0f07: "Tech OVN Private Limited",
+	0x0f08: "Lezyne USA Inc.",
+	0x0f09: "ANC CHINA LIMITED",
+	0x0f0a: "LION GROUP, INC.",
+	0x0f0b: "Brightway Innovation Intelligent Technology (Suzhou) Co., Ltd.",
+	0x0f0c: "Hitachi Industrial Equipment Systems Co.,Ltd.",
+	0x0f0d: "Maennl Elektronik GmbH",
+	0x0f0e: "IDEATRONIK Limited Liability Company",
+	0x0f0f: "caive Inc.",
+	0x0f10: "ShenZhen Doctors of Intelligence & Technology Co.,Ltd",
+	0x0f11: "Deep and Steep LLC",
+	0x0f12: "Endur ID, Inc.",
+	0x0f13: "Freshape SA",
+	0x0f14: "PreEvnt, LLC",
+	0x0f15: "Oval Corporation",
+	0x0f16: "ADHD Friendly co.Ltd",
+	0x0f17: "RORENTECH Co., Ltd.",
+	0x0f18: "iKeyless, LLC",
+	0x0f19: "Shenzhen SuperSound Technology Co.,Ltd",
+	0x0f1a: "FLO SCIENCES, LLC",
+	0x0f1b: "PalatiumCare LLC",
+	0x0f1c: "Edge Semiconductors Inc.",
+	0x0f1d: "ZIMMERMANN PV-Steel Group GmbH & Co. KG",
+	0x0f1e: "Yolni Inc.",
+	0x0f1f: "Ninebot (Changzhou) Tech Co., Ltd.",
+	0x0f20: "Beijing Spring Creation Technology Co., Ltd.",
+	0x0f21: "Rokk Limited",
+	0x0f22: "ONESPACE TECHNOLOGIES (PTY) LTD",
+	0x0f23: "Yuquan Semiconductor (Xiamen) Co., Ltd.",
+	0x0f24: "Easy Measure Co., Ltd.",
+	0x0f25: "Sichuan Changhong Neonet Technologies Co.,Ltd.",
+	0x0f26: "Centromere Holding B.V.",
+	0x0f27: "Global Link Distribution Corp.",
+	0x0f28: "Amp Fit Israel LTD",
+	0x0f29: "Trezor Company s.r.o.",
+	0x0f2a: "KELLER Druckmesstechnik AG",
+	0x0f2b: "ElitEngineering LLC",
+	0x0f2c: "Vision Group Inc.",
+	0x0f2d: "ClipsClips LLC",
+	0x0f2e: "BRITZ INTERNATIONAL CO.,LTD",
+	0x0f2f: "ThingCo Limited",
+	0x0f30: "Opal Camera, Inc.",
+	0x0f31: "TECHNOLOGIES FOR FREERIDE s.r.o",
+	0x0f32: "FLINTEC UK LIMITED",
+	0x0f33: "TETNET",
+	0x0f34: "NINGBO SHARKWARD ELECTRONICS CO.,LTD",
+	0x0f35: "Audeze LLC",
+	0x0f36: "NODER Joint Stock Company",
+	0x0f37: "Schueco International KG",
+	0x0f38: "GILL INSTRUMENTS LIMITED",
+	0x0f39: "Seitron Spa",
+	0x0f3a: "Southern Audio Services, Inc",
+	0x0f3b: "SANWA NEWTEC CO.,LTD.",
+	0x0f3c: "Mobile Technology Solutions LLC",
+	0x0f3d: "Vitio Medical S.L.",
+	0x0f3e: "Salyx Medical Inc.",
+	0x0f3f: "Landig + Lava GmbH & Co. KG",
+	0x0f40: "Health Data Insight C.I.C.",
+	0x0f41: "BONX INC.",
+	0x0f42: "LINKEDCHIP TECHNOLOGY INC",
+	0x0f43: "12mm Health Technology (Hainan) Co., Ltd.",
+	0x0f44: "MAVERICK ENERGY SOLUTIONS INTERNATIONAL,INC",
+	0x0f45: "Tactica Defense LLC",
+	0x0f46: "Huizhou Meicanxin Electronics Technology Co.,Ltd",
+	0x0f47: "Lodestar Technology Inc.",
+	0x0f49: "IYO INC.",
+	0x0f4a: "Mitsubishi Motors Corporation",
+	0x0f4b: "desamisCo.,Ltd.",
+	0x0f4c: "JL WORLD CORPORATION LIMITED",
+	0x0f4d: "Qulinda AB",
+	0x0f4e: "Ningbo Dooya Mechanic & Electronic Technology Co., Ltd",
+	0x0f4f: "G-Vision GmbH",
+	0x0f50: "Lantern Innovations Incorporated",
+	0x0f51: "ebm-papst Mulfingen GmbH & Co. KGaA & Co. KG",
+	0x0f52: "Shenzhen Yunke Intelligent Co.Ltd",
+	0x0f53: "Fledt & Meiton Marin AB",
+	0x0f54: "ContiTech Deutschland GmbH",
+	0x0f55: "Sensoteq Ltd",
+	0x0f56: "Astute Access Group Limited",
+	0x0f57: "Silverlake Technologies",
+	0x0f58: "Shenzhen Guo-link Technology Co.,Ltd.",
+	0x0f59: "Silicon Vandals PTY LTD",
+	0x0f5a: "Vibe Energy B.V.",
+	0x0f5b: "shanghai fudan electronics group company Co. Ltd",
+	0x0f5c: "Micro Technology Services, Inc.",
+	0x0f5d: "Leapcraft ApS",
+	0x0f5e: "WUXI WEIDA INTELLIGENT ELECTRONICS CO.,LTD.",
+	0x0f5f: "ROBSON SRL",
+	0x0f61: "HyolimXE Co., Ltd.",
+	0x0f62: "Kehwin Technologies Co. Ltd.",
+	0x0f63: "WATTER, Inc.",
+	0x0f64: "SafeNow GmbH",
+	0x0f65: "CZV, Inc",
+	0x0f66: "BIGREDBEE, LLC",
+	0x0f67: "White Eagle Sonic Technologies, Inc.",
+	0x0f68: "Navico",
+	0x0f69: "Ancoe Industry Corporation",
+	0x0f6a: "Roam Devices LLC",
+	0x0f6b: "Skywalk Inc.",
+	0x0f6c: "Biogents AG",
+	0x0f6d: "MODRETRO, INC.",
+	0x0f6e: "iSi Wearable Safety GmbH",
+	0x0f6f: "onanoff limited",
+	0x0f70: "TECHFLITTER SOLUTIONS PRIVATE LIMITED",
+	0x0f71: "PIRITIZ LLC",
+	0x0f72: "FLORLINK, INC.",
+	0x0f73: "Keycafe Inc.",
+	0x0f74: "Sperry Labs LLC",
+	0x0f75: "Senseonics, Incorporated",
+	0x0f76: "simatec ag",
+	0x0f77: "CROSS BRAIN CO.,Ltd.",
+	0x0f78: "Also, Inc.",
+	0x0f79: "Walter Mueller Inc. for Industrial Electronics",
+	0x0f7a: "SHENZHEN GUANG QI GUO CHUANG TECHNOLOGY CO.,LTD",
+	0x0f7b: "SKYROAM, INC.",
+	0x0f7c: "Polk Audio",
+	0x0f7d: "eQ-3 AG",
+	0x0f7e: "shenzhen Holyiot Technology Co.,Ltd",
+	0x0f7f: "Badass eBikes GmbH",
+	0x0f80: "Hydro Electronic Devices, Inc.",
+	0x0f81: "IN Phase International lTD",
+	0x0f82: "Amon Co.Ltd.",
+	0x0f83: "Calpeda S.p.A.",
+	0x0f84: "KT Micro, Inc.",
+	0x0f85: "Hangzhou Sciener Smart Technology Co., Ltd.",
+	0x0f86: "Lakecrest Pty Ltd",
+	0x0f87: "Anacove Inc.",
+	0x0f88: "Kohler Ventures, Inc.",
+	0x0f89: "STOREIO TECHNOLOGIES LTD",
+	0x0f8a: "Innogando S.L.",
+	0x0f8b: "A.Y. McDonald Mfg. Co.",
+	0x0f8c: "ROYAL PARTS CO.,LTD",
+	0x0f8d: "JUREN CO., LTD.",
+	0x0f8e: "Biceek Inc",
+	0x0f8f: "SIGNUM INTELLIGENCE LTD",
+	0x0f90: "MOBI7 TECNOLOGIA EM MOBILIDADE S.A.",
+	0x0f91: "Valostra Digital Ventures LLP",
+	0x0f92: "Ethos Group, Inc.",
+	0x0f93: "Aktiebolaget Ebeco",
+	0x0f94: "IDX Company, Ltd.",
+	0x0f95: "Dongguang Huasoo Automation Technology Company Limited",
+	0x0f96: "SEIKOIST, INC",
+	0x0f97: "SPX Aids to Navigation Oy",
+	0x0f98: "Fitnexa Inc",
+	0x0f99: "DEZINE GROUP LLC",
+	0x0f9a: "Eiritsu Electronics Industry Co., Ltd.",
+	0x0f9b: "Hanshow Technology Co.,Ltd.",
+	0x0f9c: "WePower Technologies LLC",
+	0x0f9d: "IRTrans GmbH",
+	0x0f9e: "Hibino Corporation",
+	0x0f9f: "Seiko Future Creation Inc.",
+	0x0fa0: "DP IOT",
+	0x0fa1: "PALRED RETAIL PRIVATE LIMITED",
+	0x0fa2: "BIA NEUROSCIENCE INC.",
+	0x0fa3: "B.E.G. Brueck Electronic GmbH",
+	0x0fa4: "Nice Spa",
+	0x0fa5: "Avanos Medical, Inc.",
+	0x0fa6: "Ugreen Group Limited",
+	0x0fa7: "spheretek japan",
+	0x0fa8: "Senti Technologies Private Limited",
+	0x0fa9: "Ranch Systems, Inc.",
+	0x0faa: "Suzhou Fanxi Technology Co., Ltd.",
+	0x0fab: "Geo Radar AI Private Limited",
+	0x0fac: "Flitsmeister B.V.",
+	0x0fad: "The AI Toy Company",
+	0x0fae: "Capacite",
+	0x0faf: "Terasite Technologies",
+	0x0fb0: "General Resistance, LLC",
+	0x0fb1: "Avivomed Inc.",
+	0x0fb2: "PLAUD Inc.",
+	0x0fb3: "Hooked Society Oy Ltd",
+	0x0fb4: "StoneDevices",
+	0x0fb5: "Hangzhou Nano IC Technologies Co,. Ltd",
+	0x0fb6: "TQ-Systems GmbH",
+	0x0fb7: "Hapn Holdings, LLC",
+	0x0fb8: "SITERWELL ELECTRONICS CO.,LIMITED",
+	0x0fb9: "Shenzhen Mutual Technology Co., Ltd",
+	0x0fba: "Cosonic Intelligent Technologies Co., Ltd.",
+	0x0fbb: "Rollease Acmeda, Inc.",
+	0x0fbc: "ATANS TECHNOLOGY INC.",
+	0x0fbd: "Locus Robotics Corp.",
+	0x0fbe: "TaigaIoT",
+	0x0fbf: "Tridentify AB",
+	0x0fc0: "Littlebird Connected Care, Inc.",
+	0x0fc1: "Air Automotive tracking Inc.",
+	0x0fc2: "ISSPRO, INC",
+	0x0fc3: "Shenzhen Jimi loT Co.,Ltd",
+	0x0fc4: "FulScience Automotive Electronics Co., Ltd.",
+	0x0fc5: "Hypershell Co., Ltd",
+	0x0fc6: "ASD Lighting PLC",
+	0x0fc7: "Pharox B.V.",
+	0x0fc8: "Eforthink Technology Co., Ltd.",
+	0x0fc9: "Zirbel Bike GmbH",
+	0x0fca: "Legato Audio, Inc.",
+	0x0fcb: "Biocorp Production",
+	0x0fcc: "Neurotherapeutics Ltd",
+	0x0fcd: "Wimate Technology Solutions Pvt Ltd",
+	0x0fce: "NOJA Power",
+	0x0fcf: "Nestlab AS",
+	0x0fd0: "Stark Future SL",
+	0x0fd1: "Auranova LLC",
+	0x0fd2: "Domteknika S.A",
+	0x0fd3: "YDIIT Co., Ltd",
+	0x0fd4: "QRITAGYA LLP",
+	0x0fd5: "Telemacy Ltd",
+	0x0fd6: "LIOTYS",
+	0x0fd7: "Hondata, Inc.",
+	0x0fd8: "SIVA Inotec Limited",
+	0x0fd9: "REMDEVICE S.R.L.",
+	0x0fda: "NIVELCO PROCESS CONTROL CO.",
+	0x0fdc: "Beijing Hongsi Electronic Technology Co.,Ltd.",
+	0x103a: "FOSS Analytical A/S",
+	0x103d: "Blahaj Ltd",
+	0x103e: "Nextorage Corporation",
+	0x103f: "infyni",
+	0x1040: "Raspberry Pi",
+	0x1041: "Shenzhen Huasheng Jiaye Technology Co., Ltd",
+	0x1042: "Namara Water Technologies, Inc",
+	0x1043: "Shanghai Holychip Electronic Co., Ltd.",
+	0x1044: "Rotarex S.A.",
+	0x1045: "VOTRONIC Elektronik-Systeme GmbH",
+	0x1046: "EXELIO S.R.L.",
+	0x1047: "Yamaha Motor eBike Systems GmbH",
+	0x1048: "Ferrari s.p.a.",
+	0x1049: "Anhui Ubico Advanced Manufacture Co., Ltd.",
+	0x104a: "Mueller-BBM Rail Technologies GmbH",
+	0x104b: "Yoto Limited",
+	0x104c: "LAUMAS Elettronica S.r.l.",
+	0x104d: "BUND MEDIA PTY. LTD.",
+	0x104e: "LSC Control Systems Pty Ltd",
+	0x104f: "Safety Lighting Research Pty Ltd",
+	0x1050: "Hangzhou Sneuro Medical Co., Ltd.",
+	0x1051: "UnlimitedIRL LLC",
+	0x1052: "CHOZEN International CO., LTD.",
+	0x1053: "Sensorworx, Inc.",
+	0x1054: "Romcor Pty Ltd",
+	0x1055: "NewNet, Inc.",
+	0x1056: "CLM-Solutions",
+	0x1057: "RSA Security LLC",
+	0x1058: "Gravity (Shenzhen)Space Technology Co., Ltd",
+	0x1059: "Shenzhen Shuye Technology Co.,Ltd.",
+	0x105a: "ArjoHuntleigh AB",
+	0x105b: "Fairbanks Scales Inc.",
+	0x105c: "YANMAR HOLDINGS CO., LTD.",
+	0x105d: "Williams Sound, LLC",
+	0x105e: "Zuuka Limited",
+	0x105f: "Chip-pump Microelectronics",
+	0x1060: "TOMOE VALVE CO.,LTD",
+	0x1061: "VCS Vision Control Solutions GmbH",
+	0x1062: "Framason Audio S.A",
+	0x1063: "Field Line Automation Ltd",
+	0x1064: "Shearwater Research Inc.",
+	0x1065: "HIGH HIT ENTERPRISE CO., LTD.",
+	0x1066: "Algiz AS",
+	0x1067: "Shenzhen FHX Precision Hardware & Plastic Co., Ltd.",
+	0x1068: "Hotron Co., Ltd.",
+	0x1069: "Remoticom B.V.",
+	0x106a: "OSKEY",
+	0x106b: "GORDON MURRAY GROUP LIMITED",
+	0x106c: "Global Electronics",
+	0x106d: "Rokstar Holdings Limited",
+	0x106e: "STRYDE LIMITED",
+	0x106f: "Prosaris Solutions Ltd.",
+	0x1070: "TLV Co.,LTD.",
+	0x1071: "IZYBAT",
+	0x1072: "ALTESSA SOLUTIONS INC.",
+	0x1073: "Hong Kong Future lntelligent Technology Co., Limited",
+	0x1074: "CenTrak, Inc.",
+	0x1075: "TM-TECHNOLOGIES, JSC",
+	0x1076: "ALPHADIF",
+	0x1077: "Shenzhen Muyu Technology Co., Ltd.",
+	0x1078: "augment AI Inc.",
+	0x1079: "Digimax Innovative Products LTD.",
+	0x107a: "CodaHD LLC",
+	0x107b: "E.J. Brooks Company",
+	0x107c: "Martin.Care GmbH",
+	0x107d: "IMPACT-BZ LTD",
+	0x107e: "Belford investment holdings pty ltd",
+	0x107f: "Anton Paar ConsumerTec GmbH",
+	0x1080: "Knit Sound Company",
+	0x1081: "Australis Scientific Pty Ltd.",
+	0x1082: "AIR PRODUCTS AND CHEMICALS, INC.",
+	0x1083: "SYSTEM LOCO LTD",
+	0x1084: "24x8, LLC",
+	0x1085: "FUTURE INTELLIGENCE TECHNOLOGY SINGAPORE PTE. LTD.",
+	0x1086: "M3SH TECHNOLOGY INC.",
+	0x1087: "Matisse Interactif inc.",
+	0x1088: "Sesame AI, Inc.",
+	0x1089: "DEER MANAGEMENT SYSTEMS, LLC",
+	0x108a: "Grayhill Inc.",
+	0x108b: "PERUN TECH SP. Z O.O.",
+	0x108c: "Tiny Displays Inc.",
+	0x108d: "DEWINE Labs GmbH",
+	0x108e: "Industrial Computing Limited",
+	0x108f: "SkyCell AG",
+	0x1090: "NAGANO KEIKI CO., LTD.",
+	0x1091: "COSEL ELEKTRONIK OTOMASYON SISTEMLERI SANAYI VE TICARET LIMITED SIRKETI",
+	0x1092: "BXB DIGITAL PTY LIMITED",
+	0x1093: "Mindspire Limited",
+	0x1094: "Personal Products Co., Ltd.",
+	0x1095: "CardiaMetrics",
+	0x1096: "Aktv8 LLC",
+	0x1097: "Knox Associates, Inc.",
+	0x1098: "smart-TEC GmbH & Co. KG",
+	0x1099: "Selco GmbH",
+	0x109a: "MONNIT CORPORATION",
+	0x109b: "Tensoli GmbH",
+	0x109c: "KIBLE",
+	0x109d: "terumo",
+	0x109e: "SHIBAURA ELECTRONICS CO., LTD.",
+	0x109f: "Shenzhen Hali-Power Industrial Co., Ltd.",
+	0x10a0: "inContAlert GmbH",
+	0x10a1: "flexlog GmbH",
+	0x10a2: "Audio Research Corporation",
+	0x10a3: "BQT Solutions (NZ) Limited",
+	0x10a4: "RAPIDISE TECHNOLOGY PRIVATE LIMITED",
+	0x10a5: "Cebreo Medical A/S",
+	0x10a6: "Lightnovo ApS",
+	0x10a7: "Pedigree Technologies, LLC",
+	0x10a8: "Zhejiang Wanyou Intelligent Technology Co., Ltd.",
+	0x10a9: "Mallow",
+	0x10aa: "Sigenergy Technology Co., Ltd.",
+	0x10ab: "ObjectSpectrum, LLC",
+	0x10ac: "ATsens Co.,Ltd.",
+	0x10ad: "CoreHW Semiconductor Oy",
+	0x10ae: "Raycon Inc.",
+	0x10af: "Hangzhou SDIC Microelectronics Inc.",
+	0x10b0: "VSC Synapse LLC",
+	0x10b1: "Senops Tracker, LLC",
+	0x10b2: "Shenzhen Xunzong Zhilian Technology Co., Ltd.",
+	0x10b3: "HOSEOTELNET Co., Ltd.",
+	0x10b4: "Solabs Technology (HK) Co., Limited",
+	0x10b5: "SpartanLync Technologies Corp.",
+	0x10b6: "SHENZHEN CHINA MICRO SEMICON CO.,LIMITED",
+	0x10b7: "Fosilicon Co., Ltd",
+	0x10b8: "Palarum LLC",
+	0x10b9: "CYNC Labs, Inc.",
+	0x10ba: "Noble HiFi, LLC",
+	0x10bb: "VERGESENSE INC",
+	0x10bc: "Harley-Davidson Motor Company",
+	0x10bd: "Variowell Development GmbH",
+	0x10be: "UNIVERSAL REMOTE CONTROL, INC.",
+	0x10bf: "All Inspire Health, Inc.",
+	0x10c0: "Minda Corporation Ltd",
+	0x10c1: "Shenzhen ECO-NEWLEAF CO., LTD",
+	0x10c2: "SainStore Technology Co., Ltd.",
+	0x10c3: "TECH FASS s.r.o.",
+	0x10c4: "OPICA GmbH",
+	0x10c5: "Cardinal Scale Mfg",
+	0x10c6: "Piscisea Tech Inc",
+	0x10c7: "CHIYODA TECHNOL CORPORATION",
+	0x10c8: "mylife Diabetes Care AG",
+	0x10c9: "Tractian Technologies Inc.",
+	0x10ca: "FairPhone B.V",
+	0x10cb: "The90, Inc.",
+	0x10cc: "Linde GmbH",
+	0x10cd: "Kumho Tire",
+	0x10ce: "Inito Health Inc.",
+	0x10cf: "Tempur World, LLC",
+	0x10d0: "Band24, LLC",
+	0x10d1: "Acer Inc.",
+	0x10d2: "Hehku Company Oy",
+	0x10d3: "AGCO Corporation",
+	0x10d4: "Victor Hasselblad AB",
+	0x10d5: "IntelliDesign Pty Ltd",
+	0x10d6: "UltronSMART Inc.",
+	0x10d7: "Arashi Vision Inc.",
+	0x10d8: "Westfalen Aktiengesellschaft",
+	0x10d9: "Liebherr-Hausgeraete GmbH",
+	0x10da: "SKAIChips Co.,Ltd",
+	0x10db: "SZ Avinox Innovation Co., Ltd.",
+	0x10dc: "Spectra Precision (Kaiserslautern) GmbH",
+	0x10dd: "Triumph Designs Ltd",
+	0x10de: "Gastron Co.,Ltd",
+	0x10df: "Hawkeye Surgical Lighting LLC",
+	0x10e0: "Arnold & Richter Cine Technik GmbH & Co. Betriebs KG",
+	0x10e1: "Neurable, Inc",
+	0x10e2: "CAREHAWK INC.",
+	0x10e3: "Vybe Audio LLC",
+	0x10e4: "Smart Technologies ULC",
+	0x10e5: "Durin, inc.",
+	0x10e6: "KWANG YANG MOTOR CO., LTD.",
+	0x10e7: "Placenet Internet of Places S.L.",
+	0x10e8: "Liontron GmbH & Co. KG",
+	0x10e9: "Hankook Tire & Technology Co., Ltd.",
+	0x10ea: "Guangdong Youhong Medical Technology Co.,Ltd",
+	0x10eb: "Fontaine Fifth Wheel Company",
+	0x10ec: "ZELP LTD",
+	0x10ed: "BRITA SE",
+	0x10ee: "Shanghai XYLink Limited Corporation",
+	0x10ef: "Keto-Check Inc.",
+	0x10f0: "RFID N PRINT PTY LTD",
+	0x10f1: "Ralston Instruments, LLC",
+	0x10f2: "CHIGEE TECHNOLOGY CO., LTD.",
+	0x10f3: "EURODIMA GmbH & CoKG",
+	0x10f4: "SUPERARK LTD",
+	0x10f5: "Shenzhen DeepX Technology Co., Ltd.",
+	0x10f6: "Pressure Systems International, Inc.",
+	0x10f7: "HEX INNOVATE (UK) LIMITED",
+	0x10f8: "IONLINE INTERNET SERVICE PROVIDER",
+	0x10f9: "Even Realities Ltd.",
+	0x10fa: "QPlay Ltd",
+	0x10fb: "Hydratune Australia Pty Ltd",
+	0x10fc: "CITECH CO.,LTD.",
+	0x10fd: "Neurabody Inc.",
+	0x10fe: "Daisen Electronic Industrial Co., Ltd.",
+	0x10ff: "Sonus Faber spa",
+	0x1100: "miThings",
+	0x1101: "Nearfaces UG (haftungsbeschränkt)",
+	0x1102: "Temple Private Limited",
+	0x1103: "BRISANT SECURE LTD",
+	0x1104: "Daiichi Elektronik Sanayi ve Ticaret. A.S.",
+	0x1105: "Cirrus Design Corporation",
+	0x1106: "Flicfit inc.",
+	0x1107: "Optrel AG",
+	0x1108: "BEMER Int. AG",
+	0x1109: "Hamodaz Corporation",
+	0x110a: "OnRace Motorsports",
+	0x110b: "SIGMA CORPORATION",
+	0x110c: "rescuetrack GmbH",
+	0x110d: "ARETA AI INC.",
+	0x110e: "Gigawit Electronics LTD",
+}
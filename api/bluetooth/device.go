@@ -1,11 +1,19 @@
 package bluetooth
 
 import (
+	"context"
+	"time"
+
 	"github.com/google/uuid"
 )
 
 // Device describes a function call interface to invoke device related functions.
 type Device interface {
+	// WithContext returns a copy of the device function call interface, whose
+	// method calls are bound to the provided context. Cancelling the context
+	// aborts any pending method call, and cancels an ongoing pairing attempt.
+	WithContext(ctx context.Context) Device
+
 	// Pair will attempt to pair a bluetooth device that is in pairing mode.
 	Pair() error
 
@@ -13,7 +21,10 @@ type Device interface {
 	CancelPairing() error
 
 	// Connect will attempt to connect an already paired bluetooth device
-	// to an adapter.
+	// to an adapter. If the device is not known to the session, for example
+	// if it has not been discovered yet, the device is connected to via
+	// (Adapter).ConnectDevice of the default adapter instead, over BR/EDR
+	// unless the device is known to be an LE device.
 	Connect() error
 
 	// Disconnect will disconnect the bluetooth device from the adapter.
@@ -30,6 +41,43 @@ type Device interface {
 	// Remove removes a device from its associated adapter.
 	Remove() error
 
+	// SetTrusted sets the trusted state of the device. Trusted devices
+	// can connect to the adapter without any authorization requests.
+	SetTrusted(enable bool) error
+
+	// SetBlocked sets the blocked state of the device. Blocked devices are
+	// disconnected, and all incoming connections from them are rejected.
+	SetBlocked(enable bool) error
+
+	// SetAlias sets the alias of the device. If the alias is empty,
+	// the device name is used as the alias.
+	SetAlias(alias string) error
+
+	// SetWakeAllowed sets whether the device is allowed to wake up the host from
+	// system suspend. This is usually only supported by input devices.
+	SetWakeAllowed(enable bool) error
+
+	// ConnectWithRetry will attempt to connect to the device like Connect, and retries
+	// the connection attempt according to the provided policy, if it fails with an error
+	// that is worth retrying (for example, a page timeout, or if another connection
+	// attempt is in progress). A connect attempt event is published for each attempt.
+	// Cancelling the context (see WithContext) aborts the pending attempt and any further
+	// retries, in which case errorkinds.ErrMethodCanceled is returned.
+	ConnectWithRetry(policy ConnectRetryPolicy) error
+
+	// SetAutoReconnect sets whether the device is reconnected automatically, overriding
+	// the session configuration (see config.Configuration.AutoReconnect) for the device.
+	SetAutoReconnect(enable bool) error
+
+	// Setup runs the setup workflow of a new device, which pairs the device (if it is
+	// not paired), marks it as trusted, connects to it using the retry policy of the options,
+	// waits for its services to be resolved, and finally connects the profiles of the options.
+	// Pairing requests are authorized via the session's authorizer, like with Pair.
+	// A setup event is published when each stage starts and ends, and the returned result
+	// holds the outcome of each stage, including the stage which failed, if any.
+	// Cancelling the context aborts the workflow.
+	Setup(ctx context.Context, opts SetupOptions) (SetupResult, error)
+
 	// Properties returns all the properties of the device.
 	Properties() (DeviceData, error)
 }
@@ -44,6 +92,183 @@ type AuthorizeDevicePairing interface {
 	AuthorizeService(timeout AuthTimeout, address MacAddress, uuid uuid.UUID) error
 }
 
+// AddressType describes the type of a Bluetooth device address.
+type AddressType string
+
+// The different address types.
+const (
+	AddressTypeBREDR  AddressType = "bredr"
+	AddressTypePublic AddressType = "public"
+	AddressTypeRandom AddressType = "random"
+)
+
+// The default connection retry policy values.
+const (
+	DefaultConnectAttempts     = 3
+	DefaultConnectInitialDelay = time.Second
+	DefaultConnectMaxDelay     = 30 * time.Second
+	DefaultConnectMultiplier   = 2.0
+)
+
+// ConnectRetryPolicy holds the retry and backoff policy, which is used to
+// retry failed connection attempts to a device. Values which are not set
+// are replaced with their defaults.
+type ConnectRetryPolicy struct {
+	// MaxAttempts holds the maximum number of connection attempts, including the first attempt.
+	MaxAttempts int `json:"max_attempts,omitempty" doc:"The maximum number of connection attempts, including the first attempt."`
+
+	// InitialDelay holds the duration to wait before the first retry.
+	InitialDelay time.Duration `json:"initial_delay,omitempty" doc:"The duration to wait before the first retry."`
+
+	// MaxDelay holds the maximum duration to wait between retries.
+	MaxDelay time.Duration `json:"max_delay,omitempty" doc:"The maximum duration to wait between retries."`
+
+	// Multiplier holds the factor by which the delay is increased after each retry.
+	Multiplier float64 `json:"multiplier,omitempty" doc:"The factor by which the delay is increased after each retry."`
+}
+
+// DefaultSetupServicesTimeout holds the default duration to wait for the services of a device
+// to be resolved, during the setup of the device.
+const DefaultSetupServicesTimeout = 15 * time.Second
+
+// SetupStage describes a stage of the setup workflow of a device.
+type SetupStage string
+
+// The different setup stages, in the order that they are run.
+const (
+	SetupStagePair     SetupStage = "pair"
+	SetupStageTrust    SetupStage = "trust"
+	SetupStageConnect  SetupStage = "connect"
+	SetupStageServices SetupStage = "services"
+	SetupStageProfiles SetupStage = "profiles"
+)
+
+// SetupStatus describes the status of a setup stage.
+type SetupStatus string
+
+// The different setup stage statuses.
+const (
+	SetupStatusStarted   SetupStatus = "started"
+	SetupStatusCompleted SetupStatus = "completed"
+	SetupStatusSkipped   SetupStatus = "skipped"
+	SetupStatusFailed    SetupStatus = "failed"
+)
+
+// SetupOptions holds the options for the setup workflow of a device.
+type SetupOptions struct {
+	// SkipTrust indicates whether the device is not marked as trusted.
+	SkipTrust bool `json:"skip_trust,omitempty" doc:"Indicates whether the device is not marked as trusted."`
+
+	// Retry holds the retry policy, which is used to connect to the device.
+	Retry ConnectRetryPolicy `json:"retry,omitempty" doc:"The retry policy, which is used to connect to the device."`
+
+	// ServicesTimeout holds the duration to wait for the services of the device to be resolved.
+	// If it is zero, DefaultSetupServicesTimeout is used.
+	ServicesTimeout time.Duration `json:"services_timeout,omitempty" doc:"The duration to wait for the services of the device to be resolved."`
+
+	// Profiles holds the Bluetooth profile UUIDs, which are connected after the services
+	// of the device are resolved. If it is empty, the profiles stage is skipped.
+	Profiles []uuid.UUID `json:"profiles,omitempty" doc:"The Bluetooth profile UUIDs, which are connected after the services of the device are resolved."`
+}
+
+// SetupEventData holds the setup event information.
+// This is published when a stage of the setup workflow of a device starts and ends.
+type SetupEventData struct {
+	// Address holds the Bluetooth MAC address of the device.
+	Address MacAddress `json:"address,omitempty" doc:"The Bluetooth MAC address of the device."`
+
+	SetupStageResult
+}
+
+// SetupStageResult holds the outcome of a setup stage.
+type SetupStageResult struct {
+	// Stage holds the setup stage.
+	Stage SetupStage `json:"stage,omitempty" enum:"pair,trust,connect,services,profiles" doc:"The setup stage."`
+
+	// Status holds the status of the setup stage.
+	Status SetupStatus `json:"status,omitempty" enum:"started,completed,skipped,failed" doc:"The status of the setup stage."`
+
+	// Error holds the error message of the failed setup stage.
+	Error string `json:"error,omitempty" doc:"The error message of the failed setup stage."`
+}
+
+// SetupResult holds the outcome of the setup workflow of a device.
+type SetupResult struct {
+	// Address holds the Bluetooth MAC address of the device.
+	Address MacAddress `json:"address,omitempty" doc:"The Bluetooth MAC address of the device."`
+
+	// Stages holds the outcome of each setup stage that was run, in order.
+	Stages []SetupStageResult `json:"stages,omitempty" doc:"The outcome of each setup stage that was run, in order."`
+
+	// FailedStage holds the setup stage which failed. If the setup was successful, this is empty.
+	FailedStage SetupStage `json:"failed_stage,omitempty" enum:"pair,trust,connect,services,profiles" doc:"The setup stage which failed. If the setup was successful, this is empty."`
+
+	// Error holds the error message of the failed setup stage.
+	Error string `json:"error,omitempty" doc:"The error message of the failed setup stage."`
+}
+
+// Failed returns whether a stage of the setup failed.
+func (r SetupResult) Failed() bool {
+	return r.FailedStage != ""
+}
+
+// ConnectAttemptEventData holds the connection attempt event information.
+// This is published after each attempt to connect to a device using a retry policy.
+type ConnectAttemptEventData struct {
+	// Address holds the Bluetooth MAC address of the device.
+	Address MacAddress `json:"address,omitempty" doc:"The Bluetooth MAC address of the device."`
+
+	// Attempt holds the number of the connection attempt, starting from 1.
+	Attempt int `json:"attempt,omitempty" doc:"The number of the connection attempt, starting from 1."`
+
+	// MaxAttempts holds the maximum number of connection attempts.
+	MaxAttempts int `json:"max_attempts,omitempty" doc:"The maximum number of connection attempts."`
+
+	// Connected indicates whether the attempt was successful.
+	Connected bool `json:"connected,omitempty" doc:"Indicates whether the attempt was successful."`
+
+	// Error holds the error message of the failed attempt.
+	Error string `json:"error,omitempty" doc:"The error message of the failed attempt."`
+
+	// Retrying indicates whether the connection will be attempted again, after the delay.
+	Retrying bool `json:"retrying,omitempty" doc:"Indicates whether the connection will be attempted again, after the delay."`
+
+	// Delay holds the duration after which the connection will be attempted again.
+	Delay time.Duration `json:"delay,omitempty" doc:"The duration after which the connection will be attempted again."`
+}
+
+// Attempts returns the maximum number of connection attempts of the policy.
+func (p ConnectRetryPolicy) Attempts() int {
+	if p.MaxAttempts <= 0 {
+		return DefaultConnectAttempts
+	}
+
+	return p.MaxAttempts
+}
+
+// Delay returns the duration to wait after the provided failed attempt
+// (starting from 1), before the connection is attempted again.
+func (p ConnectRetryPolicy) Delay(attempt int) time.Duration {
+	delay, maxDelay, multiplier := p.InitialDelay, p.MaxDelay, p.Multiplier
+	if delay <= 0 {
+		delay = DefaultConnectInitialDelay
+	}
+
+	if maxDelay <= 0 {
+		maxDelay = DefaultConnectMaxDelay
+	}
+
+	if multiplier < 1 {
+		multiplier = DefaultConnectMultiplier
+	}
+
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay = time.Duration(float64(delay) * multiplier)
+	}
+
+	return min(delay, maxDelay)
+}
+
 // DeviceData holds the static bluetooth device information installed for a system.
 type DeviceData struct {
 	// Name holds the name of the device.
@@ -56,10 +281,13 @@ type DeviceData struct {
 	// For example, type of the device can be "Phone", "Headset" etc.
 	Type string `json:"type,omitempty" codec:"Type,omitempty" doc:"The type name of the device. For example, type of the device can be 'Phone', 'Headset' etc."`
 
-	// Alias holds the optional or user-assigned name for the adapter.
-	// Usually valid for Linux systems, may be empty or equate to "Name"
-	// for other systems.
-	Alias string `json:"alias,omitempty" codec:"Alias,omitempty" doc:"The optional or user-assigned name for the adapter. Usually valid for Linux systems, may be empty or equate to **name** for other systems."`
+	// ClassInfo holds the decoded device type class specifier, which describes
+	// the major and minor device classes and the major service classes of the device.
+	ClassInfo DeviceClass `json:"class_info,omitempty" codec:"ClassInfo,omitempty" doc:"The decoded device type class specifier, which describes the major and minor device classes and the major service classes of the device."`
+
+	// AddressType holds the type of the device address.
+	// Valid only on Linux systems, will be empty on other systems.
+	AddressType AddressType `json:"address_type,omitempty" codec:"AddressType,omitempty" enum:"public,random" doc:"The type of the device address. Valid only on Linux systems, will be empty on other systems."`
 
 	// LegacyPairing indicates whether the device only supports the pre-2.1 pairing mechanism.
 	// This property is useful during device discovery to anticipate whether
@@ -79,6 +307,11 @@ type DeviceEventData struct {
 	// the device is associated with.
 	AssociatedAdapter MacAddress `json:"associated_adapter,omitempty" codec:"AssociatedAdapter,omitempty" doc:"The Bluetooth MAC address of the adapter the device is associated with."`
 
+	// Alias holds the optional or user-assigned name for the device.
+	// Usually valid for Linux systems, may be empty or equate to "Name"
+	// for other systems.
+	Alias string `json:"alias,omitempty" codec:"Alias,omitempty" doc:"The optional or user-assigned name for the device. Usually valid for Linux systems, may be empty or equate to **name** for other systems."`
+
 	// Paired indicates if the device is paired.
 	Paired bool `json:"paired,omitempty" codec:"Paired,omitempty" doc:"Indicates if the device is paired."`
 
@@ -95,6 +328,10 @@ type DeviceEventData struct {
 	// on other systems.
 	Blocked bool `json:"blocked,omitempty" codec:"Blocked,omitempty" doc:"Indicates if the device is marked as blocked. Valid only on Linux systems, will equate to 'false' on other systems."`
 
+	// WakeAllowed indicates if the device is allowed to wake up the host from system suspend.
+	// Valid only on Linux systems, will equate to "false" on other systems.
+	WakeAllowed bool `json:"wake_allowed,omitempty" codec:"WakeAllowed,omitempty" doc:"Indicates if the device is allowed to wake up the host from system suspend. Valid only on Linux systems, will equate to 'false' on other systems."`
+
 	// Bonded indicates if the device is bonded.
 	Bonded bool `json:"bonded,omitempty" codec:"Bonded,omitempty" doc:"Indicates if the device is bonded."`
 
@@ -106,6 +343,33 @@ type DeviceEventData struct {
 
 	// UUIDs holds the device-supported Bluetooth profile UUIDs.
 	UUIDs []string `json:"uuids,omitempty" codec:"UUIDs,omitempty" doc:"The device-supported Bluetooth profile UUIDs."`
+
+	// ServicesResolved indicates if the services of the device have been resolved.
+	ServicesResolved bool `json:"services_resolved,omitempty" codec:"ServicesResolved,omitempty" doc:"Indicates if the services of the device have been resolved."`
+
+	// Icon holds the name of the icon which represents the device,
+	// according to the freedesktop.org icon naming specification, for example "audio-headset".
+	Icon string `json:"icon,omitempty" codec:"Icon,omitempty" doc:"The name of the icon which represents the device, for example 'audio-headset'."`
+
+	// Appearance holds the external appearance of the device, as advertised by
+	// Bluetooth LE devices. See the Bluetooth Assigned Numbers for its values.
+	Appearance Appearance `json:"appearance,omitempty" codec:"Appearance,omitempty" doc:"The external appearance of the device, as advertised by Bluetooth LE devices."`
+
+	// Modalias holds the vendor and product information of the device.
+	Modalias Modalias `json:"modalias,omitempty" codec:"Modalias,omitempty" doc:"The vendor and product information of the device, for example 'usb:v1D6Bp0246d0540'."`
+
+	// TxPower holds the advertised transmit power level of the device, in dBm.
+	TxPower int16 `json:"tx_power,omitempty" codec:"TxPower,omitempty" doc:"The advertised transmit power level of the device, in dBm."`
+
+	// ManufacturerData holds the advertised manufacturer specific data of the device,
+	// keyed by the manufacturer's company identifier.
+	ManufacturerData map[uint16][]byte `json:"manufacturer_data,omitempty" codec:"ManufacturerData,omitempty" doc:"The advertised manufacturer specific data of the device, keyed by the manufacturer's company identifier."`
+
+	// ServiceData holds the advertised service data of the device, keyed by the service UUID.
+	ServiceData map[string][]byte `json:"service_data,omitempty" codec:"ServiceData,omitempty" doc:"The advertised service data of the device, keyed by the service UUID."`
+
+	// AdvertisingFlags holds the advertising data flags of the device.
+	AdvertisingFlags []byte `json:"advertising_flags,omitempty" codec:"AdvertisingFlags,omitempty" doc:"The advertising data flags of the device."`
 }
 
 // DeviceTypeFromClass parses the device class and returns its type.
@@ -7,7 +7,8 @@ import (
 
 // Events defines a set of possible event data types.
 type Events interface {
-	errorkinds.GenericError | AdapterEventData | DeviceEventData | MediaEventData | FileTransferEventData
+	errorkinds.GenericError | AdapterEventData | DeviceEventData | MediaEventData | FileTransferEventData | SessionEventData |
+		PruneResult | DefaultAdapterEventData | ConnectAttemptEventData | SetupEventData
 }
 
 // Event represents a general event.
@@ -21,6 +22,8 @@ type Event[T Events] struct {
 
 	// Data holds the actual event data.
 	Data T `json:"event_data,omitempty" doc:"The actual event data."`
+
+	emitter *eventbus.Emitter
 }
 
 // Subscriber describes a subscriber token.
@@ -41,6 +44,11 @@ const (
 	EventDevice
 	EventFileTransfer
 	EventMediaPlayer
+	EventSession
+	EventPrune
+	EventDefaultAdapter
+	EventConnectAttempt
+	EventSetup
 )
 
 // EventAction describes an action that is associated with an event.
@@ -57,12 +65,17 @@ const (
 // eventNames holds names of different events.
 var (
 	eventNames = map[EventID]string{
-		EventDefault:      "*",
-		EventError:        "error",
-		EventAdapter:      "adapter",
-		EventDevice:       "device",
-		EventFileTransfer: "filetransfer",
-		EventMediaPlayer:  "mediaplayer",
+		EventDefault:        "*",
+		EventError:          "error",
+		EventAdapter:        "adapter",
+		EventDevice:         "device",
+		EventFileTransfer:   "filetransfer",
+		EventMediaPlayer:    "mediaplayer",
+		EventSession:        "session",
+		EventPrune:          "prune",
+		EventDefaultAdapter: "defaultadapter",
+		EventConnectAttempt: "connectattempt",
+		EventSetup:          "setup",
 	}
 )
 
@@ -76,10 +89,21 @@ func (e EventID) Value() uint {
 	return uint(e)
 }
 
+// On returns a copy of the event interface, which publishes/subscribes to the
+// event stream of the provided event emitter, instead of the global event stream.
+// If the emitter is nil, the global event stream is used.
+func (e Event[T]) On(emitter *eventbus.Emitter) Event[T] {
+	e.emitter = emitter
+
+	return e
+}
+
 // Publish publishes the event to the event stream.
 func (e Event[T]) Publish(data T) {
-	e.Data = data
-	eventbus.Publish(e.ID, e)
+	emitter := e.eventEmitter()
+
+	e.Data, e.emitter = data, nil
+	emitter.Publish(e.ID, e)
 }
 
 // Subscribe listens to the event stream and subscribes to the event.
@@ -89,7 +113,7 @@ func (e Event[T]) Publish(data T) {
 func (e Event[T]) Subscribe() Subscriber[T] {
 	eventChan := make(chan Event[T], 10)
 
-	id := eventbus.Subscribe(e.ID)
+	id := e.eventEmitter().Subscribe(e.ID)
 	if !id.IsActive() {
 		close(eventChan)
 		goto Token
@@ -112,6 +136,15 @@ Token:
 	return Subscriber[T]{C: eventChan, Subscribable: id.IsActive(), Unsubscribe: id.Unsubscribe}
 }
 
+// eventEmitter returns the event emitter which the event is published to.
+func (e Event[T]) eventEmitter() *eventbus.Emitter {
+	if e.emitter == nil {
+		return eventbus.DefaultEmitter()
+	}
+
+	return e.emitter
+}
+
 // AdapterEvent returns an event interface to publish/subscribe to adapter events.
 func AdapterEvent(action ...EventAction) Event[AdapterEventData] {
 	eventAction := EventActionNone
@@ -152,7 +185,36 @@ func FileTransferEvent(action ...EventAction) Event[FileTransferEventData] {
 	return Event[FileTransferEventData]{ID: EventFileTransfer, Action: eventAction}
 }
 
+// SessionEvent returns an event interface to publish/subscribe to session lifecycle events.
+func SessionEvent() Event[SessionEventData] {
+	return Event[SessionEventData]{ID: EventSession, Action: EventActionUpdated}
+}
+
+// PruneEvent returns an event interface to publish/subscribe to events
+// which summarize the stale devices that were removed from an adapter.
+func PruneEvent() Event[PruneResult] {
+	return Event[PruneResult]{ID: EventPrune, Action: EventActionRemoved}
+}
+
+// DefaultAdapterEvent returns an event interface to publish/subscribe to events
+// which are published when the default adapter of the session changes.
+func DefaultAdapterEvent() Event[DefaultAdapterEventData] {
+	return Event[DefaultAdapterEventData]{ID: EventDefaultAdapter, Action: EventActionUpdated}
+}
+
 // ErrorEvent returns an event interface to publish/subscribe to error events.
 func ErrorEvent() Event[errorkinds.GenericError] {
 	return Event[errorkinds.GenericError]{ID: EventError, Action: EventActionAdded}
 }
+
+// ConnectAttemptEvent returns an event interface to publish/subscribe to events
+// which are published after each attempt to connect to a device using a retry policy.
+func ConnectAttemptEvent() Event[ConnectAttemptEventData] {
+	return Event[ConnectAttemptEventData]{ID: EventConnectAttempt, Action: EventActionUpdated}
+}
+
+// SetupEvent returns an event interface to publish/subscribe to events which are
+// published when a stage of the setup workflow of a device starts and ends.
+func SetupEvent() Event[SetupEventData] {
+	return Event[SetupEventData]{ID: EventSetup, Action: EventActionUpdated}
+}
@@ -0,0 +1,177 @@
+// Command gencompanies generates the Bluetooth SIG company identifier table
+// from the Bluetooth SIG assigned numbers repository.
+//
+// The company identifiers are read from the "company_identifiers.yaml" file,
+// which can be provided either as a URL or a local file path:
+//
+//	go run ./internal/gencompanies -i <url-or-path> -o companies.go
+//
+// If the file is read from a local copy, the location which is recorded in the
+// generated file can be set via the -source flag:
+//
+//	go run ./internal/gencompanies -i company_identifiers.yaml -source <url> -o companies.go
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// defaultInput holds the location of the company identifiers in the Bluetooth SIG assigned numbers repository.
+const defaultInput = "https://bitbucket.org/bluetooth-SIG/public/raw/main/assigned_numbers/company_identifiers/company_identifiers.yaml"
+
+// company describes a single company identifier entry.
+type company struct {
+	id   uint16
+	name string
+}
+
+func main() {
+	input := flag.String("i", defaultInput, "The URL or path of the company identifiers YAML file")
+	output := flag.String("o", "companies.go", "The path of the generated Go file")
+	source := flag.String("source", "", "The location which is recorded in the generated file (default: the input)")
+	flag.Parse()
+
+	if *source == "" {
+		*source = *input
+	}
+
+	data, err := read(*input)
+	if err != nil {
+		log.Fatalf("gencompanies: cannot read %q: %v", *input, err)
+	}
+
+	companies, err := parse(bytes.NewReader(data))
+	if err != nil {
+		log.Fatalf("gencompanies: cannot parse %q: %v", *input, err)
+	}
+
+	generated, err := generate(companies, *source)
+	if err != nil {
+		log.Fatalf("gencompanies: cannot generate table: %v", err)
+	}
+
+	if err := os.WriteFile(*output, generated, 0o644); err != nil {
+		log.Fatalf("gencompanies: cannot write %q: %v", *output, err)
+	}
+}
+
+// read reads the contents of the provided URL or file path.
+func read(input string) ([]byte, error) {
+	if !strings.HasPrefix(input, "http://") && !strings.HasPrefix(input, "https://") {
+		return os.ReadFile(input)
+	}
+
+	resp, err := http.Get(input)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q", resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// parse parses the company identifiers YAML file, whose entries are formatted as:
+//
+//	company_identifiers:
+//	  - value: 0x009E
+//	    name: 'Bose Corporation'
+func parse(r io.Reader) ([]company, error) {
+	var companies []company
+	var current *company
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		line = strings.TrimSpace(strings.TrimPrefix(line, "-"))
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+
+		value = strings.TrimSpace(value)
+
+		switch strings.TrimSpace(key) {
+		case "value":
+			id, err := strconv.ParseUint(value, 0, 16)
+			if err != nil {
+				return nil, fmt.Errorf("invalid company identifier %q: %w", value, err)
+			}
+
+			companies = append(companies, company{id: uint16(id)})
+			current = &companies[len(companies)-1]
+
+		case "name":
+			if current == nil {
+				return nil, fmt.Errorf("company name %q without an identifier", value)
+			}
+
+			current.name = unquote(value)
+			current = nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(companies) == 0 {
+		return nil, fmt.Errorf("no company identifiers found")
+	}
+
+	slices.SortFunc(companies, func(a, b company) int {
+		return cmp.Compare(a.id, b.id)
+	})
+
+	return companies, nil
+}
+
+// unquote removes the YAML quotes from a scalar value.
+func unquote(value string) string {
+	switch {
+	case len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'':
+		return strings.ReplaceAll(value[1:len(value)-1], "''", "'")
+
+	case len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"':
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			return unquoted
+		}
+	}
+
+	return value
+}
+
+// generate generates the formatted Go source of the company identifier table.
+func generate(companies []company, source string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "// Code generated by gencompanies from %s; DO NOT EDIT.\n\n", source)
+	fmt.Fprint(&buf, "package bluetooth\n\n")
+	fmt.Fprint(&buf, "// Companies holds the names of the Bluetooth SIG assigned company identifiers,\n")
+	fmt.Fprint(&buf, "// which are used in manufacturer specific data and Bluetooth device IDs.\n")
+	fmt.Fprint(&buf, "var Companies = map[uint16]string{\n")
+
+	for _, c := range companies {
+		fmt.Fprintf(&buf, "\t0x%04x: %q,\n", c.id, c.name)
+	}
+
+	fmt.Fprint(&buf, "}\n")
+
+	return format.Source(buf.Bytes())
+}
@@ -1,8 +1,15 @@
 package bluetooth
 
+import "context"
+
 // MediaPlayer describes a function call interface to invoke media player/control
 // related functions on a device.
 type MediaPlayer interface {
+	// WithContext returns a copy of the media player function call interface, whose
+	// method calls are bound to the provided context. Cancelling the context
+	// aborts any pending method call.
+	WithContext(ctx context.Context) MediaPlayer
+
 	Properties() (MediaData, error)
 
 	Play() error
@@ -0,0 +1,94 @@
+package bluetooth
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+)
+
+// Modalias holds the parsed device ID information of an adapter or a device.
+// The device ID is usually formatted as "<source>:v<vendor>p<product>d<version>",
+// for example, "usb:v1D6Bp0246d0525".
+type Modalias struct {
+	// Source holds the source of the vendor ID, for example, "usb" or "bluetooth".
+	Source string
+
+	// Vendor holds the vendor ID.
+	Vendor uint16
+
+	// Product holds the product ID.
+	Product uint16
+
+	// Version holds the product version.
+	Version uint16
+}
+
+// ParseModalias parses the given device ID, which must be in
+// "<source>:v<vendor>p<product>d<version>" format, where each ID is
+// four hexadecimal digits long. If it cannot be parsed, an error is returned.
+func ParseModalias(s string) (Modalias, error) {
+	var modalias Modalias
+
+	source, ids, ok := strings.Cut(s, ":")
+	if !ok || source == "" {
+		return modalias, errorkinds.ErrPropertyDataParse
+	}
+
+	// Each ID is exactly four hexadecimal digits long, and
+	// nothing may follow the version ID.
+	if len(ids) != 15 || ids[0] != 'v' || ids[5] != 'p' || ids[10] != 'd' {
+		return modalias, errorkinds.ErrPropertyDataParse
+	}
+
+	for i, id := range []*uint16{&modalias.Vendor, &modalias.Product, &modalias.Version} {
+		value, err := strconv.ParseUint(ids[i*5+1:i*5+5], 16, 16)
+		if err != nil {
+			return Modalias{}, errorkinds.ErrPropertyDataParse
+		}
+
+		*id = uint16(value)
+	}
+
+	modalias.Source = source
+
+	return modalias, nil
+}
+
+// IsNil checks if the Modalias is empty.
+func (m Modalias) IsNil() bool {
+	return m == Modalias{}
+}
+
+// String returns the device ID representation of the Modalias,
+// such as "usb:v1D6Bp0246d0525". An empty string is returned
+// if the Modalias is empty.
+func (m Modalias) String() string {
+	if m.IsNil() {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:v%04Xp%04Xd%04X", m.Source, m.Vendor, m.Product, m.Version)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (m Modalias) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Similar to MacAddress, this is mainly used to unmarshal a device ID
+// string to a Modalias within go-codec. Device IDs which cannot be parsed
+// are unmarshalled to an empty Modalias, so that an unknown device ID format
+// does not prevent the rest of the adapter or device properties from being decoded.
+func (m *Modalias) UnmarshalText(data []byte) error {
+	modalias, err := ParseModalias(string(data))
+	if err != nil {
+		modalias = Modalias{}
+	}
+
+	*m = modalias
+
+	return nil
+}
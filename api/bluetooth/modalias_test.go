@@ -0,0 +1,116 @@
+package bluetooth
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+)
+
+func TestParseModalias(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Modalias
+		wantErr bool
+	}{
+		{
+			name:  "Bluetooth",
+			input: "bluetooth:v009Ep4020d0251",
+			want:  Modalias{Source: ModaliasSourceBluetooth, Vendor: 0x009E, Product: 0x4020, Version: 0x0251},
+		},
+		{
+			name:  "USB",
+			input: "usb:v1D6Bp0246d0540",
+			want:  Modalias{Source: ModaliasSourceUSB, Vendor: 0x1D6B, Product: 0x0246, Version: 0x0540},
+		},
+		{
+			name:  "Lowercase",
+			input: "usb:v1d6bp0246d0540",
+			want:  Modalias{Source: ModaliasSourceUSB, Vendor: 0x1D6B, Product: 0x0246, Version: 0x0540},
+		},
+		{name: "TrailingData", input: "usb:v1D6Bp0246d0540zzz", wantErr: true},
+		{name: "ShortIDs", input: "usb:v1p2d3", wantErr: true},
+		{name: "Signed", input: "usb:v+D6Bp0246d0540", wantErr: true},
+		{name: "NoSource", input: ":v1D6Bp0246d0540", wantErr: true},
+		{name: "NoSeparator", input: "v1D6Bp0246d0540", wantErr: true},
+		{name: "InvalidPrefix", input: "usb:x1D6Bp0246d0540", wantErr: true},
+		{name: "Empty", input: "", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseModalias(test.input)
+			if test.wantErr {
+				if !errors.Is(err, errorkinds.ErrPropertyDataParse) || !got.IsNil() {
+					t.Errorf("ParseModalias(%q) = %+v, %v, want error %v", test.input, got, err, errorkinds.ErrPropertyDataParse)
+				}
+
+				return
+			}
+
+			if err != nil || got != test.want {
+				t.Errorf("ParseModalias(%q) = %+v, %v, want %+v", test.input, got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestModaliasUnmarshalText(t *testing.T) {
+	var m Modalias
+	if err := m.UnmarshalText([]byte("usb:v1D6Bp0246d0540zzz")); err != nil || !m.IsNil() {
+		t.Errorf("UnmarshalText of an invalid device ID = %+v, %v, want an empty Modalias", m, err)
+	}
+}
+
+func TestVendorName(t *testing.T) {
+	tests := []struct {
+		name   string
+		device DeviceEventData
+		want   string
+	}{
+		{
+			name:   "BluetoothSource",
+			device: DeviceEventData{Modalias: Modalias{Source: ModaliasSourceBluetooth, Vendor: 0x009E}},
+			want:   "Bose Corporation",
+		},
+		{
+			name:   "USBSource",
+			device: DeviceEventData{Modalias: Modalias{Source: ModaliasSourceUSB, Vendor: 0x046D}},
+			want:   "Logitech, Inc.",
+		},
+		{
+			name:   "UnknownSource",
+			device: DeviceEventData{Modalias: Modalias{Source: "pci", Vendor: 0x009E}},
+		},
+		{
+			name: "ManufacturerData",
+			device: DeviceEventData{ManufacturerData: map[uint16][]byte{
+				0xFFFF: {0x01},
+				0x004C: {0x02},
+				0x0006: {0x03},
+			}},
+			want: "Microsoft",
+		},
+		{
+			name: "ModaliasOverridesManufacturerData",
+			device: DeviceEventData{
+				Modalias:         Modalias{Source: ModaliasSourceBluetooth, Vendor: 0x009E},
+				ManufacturerData: map[uint16][]byte{0x004C: {0x01}},
+			},
+			want: "Bose Corporation",
+		},
+		{
+			name:   "Unknown",
+			device: DeviceEventData{ManufacturerData: map[uint16][]byte{0xFFFF: {0x01}}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.device.VendorName(); got != test.want {
+				t.Errorf("VendorName() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
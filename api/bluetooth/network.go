@@ -1,8 +1,15 @@
 package bluetooth
 
+import "context"
+
 // Network describes a function call interface to invoke Network related functions
 // on specified devices.
 type Network interface {
+	// WithContext returns a copy of the network function call interface, whose
+	// method calls are bound to the provided context. Cancelling the context
+	// aborts any pending connection attempt.
+	WithContext(ctx context.Context) Network
+
 	// Connect connects to a specific device according to the provided NetworkType
 	// and assigns a name to the established connection.
 	Connect(name string, nt NetworkType) error
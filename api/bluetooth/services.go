@@ -82,6 +82,15 @@ const (
 	HdpSinkServiceClass            = 0x1402
 	GenericAccessServiceClass      = 0x1800
 	GenericAttribServiceClass      = 0x1801
+	GlucoseServiceClass            = 0x1808
+	HealthThermometerServiceClass  = 0x1809
+	HeartRateServiceClass          = 0x180d
+	BloodPressureServiceClass      = 0x1810
+	HidOverGattServiceClass        = 0x1812
+	RunningSpeedServiceClass       = 0x1814
+	CyclingSpeedServiceClass       = 0x1816
+	CyclingPowerServiceClass       = 0x1818
+	WeightScaleServiceClass        = 0x181d
 	AppleAgentServiceClass         = 0x2112
 )
 
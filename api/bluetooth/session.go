@@ -3,6 +3,7 @@ package bluetooth
 import (
 	ac "github.com/bluetuith-org/api-native/api/appfeatures"
 	"github.com/bluetuith-org/api-native/api/config"
+	"github.com/bluetuith-org/api-native/api/eventbus"
 )
 
 // Session describes a Bluetooth application session.
@@ -15,9 +16,20 @@ type Session interface {
 	// Stop attempts to stop a session with the system's Bluetooth daemon or service.
 	Stop() error
 
+	// Events returns the event emitter which the session publishes its events to.
+	// Events can be subscribed to using, for example, 'AdapterEvent().On(session.Events()).Subscribe()'.
+	// The subscriptions which were made via the session's emitter are closed when the session is stopped.
+	Events() *eventbus.Emitter
+
 	// Adapters returns a list of known adapters.
 	Adapters() []AdapterData
 
+	// DefaultAdapter returns the default adapter. This is the adapter which is configured
+	// via the session configuration (see config.Configuration.DefaultAdapter) if it exists,
+	// otherwise the first powered adapter, otherwise the adapter with the lowest index
+	// (for example, "hci0"). A DefaultAdapterEvent is published when the default adapter changes.
+	DefaultAdapter() (AdapterData, error)
+
 	// Adapter returns a function call interface to invoke adapter related functions.
 	Adapter(adapterAddress MacAddress) Adapter
 
@@ -34,3 +46,27 @@ type Session interface {
 	// related functions on a device.
 	MediaPlayer(deviceAddress MacAddress) MediaPlayer
 }
+
+// SessionState describes the state of a session.
+type SessionState string
+
+// The different session states.
+const (
+	SessionStarting   SessionState = "starting"
+	SessionReady      SessionState = "ready"
+	SessionDegraded   SessionState = "degraded"
+	SessionDaemonLost SessionState = "daemon-lost"
+	SessionRecovered  SessionState = "recovered"
+	SessionStopping   SessionState = "stopping"
+)
+
+// SessionEventData holds the session event information.
+// This is primarily used to send session lifecycle related data.
+type SessionEventData struct {
+	// State holds the current state of the session.
+	State SessionState `json:"state,omitempty" enum:"starting,ready,degraded,daemon-lost,recovered,stopping" doc:"The current state of the session."`
+
+	// Features holds the currently supported features of the session,
+	// and the errors of the features that could not be activated.
+	Features ac.FeatureSet `json:"features,omitempty" doc:"The currently supported features of the session."`
+}
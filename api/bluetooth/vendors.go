@@ -0,0 +1,82 @@
+package bluetooth
+
+import "slices"
+
+// The company identifier table (see Companies) is generated from the Bluetooth SIG assigned numbers.
+//go:generate go run ./internal/gencompanies -o companies.go
+
+// The different Modalias vendor ID sources.
+const (
+	ModaliasSourceBluetooth = "bluetooth"
+	ModaliasSourceUSB       = "usb"
+)
+
+// USBVendors holds the names of the USB vendor IDs of common Bluetooth adapters and devices.
+// Unlike Companies, this table is not generated: it is a hand-maintained subset of the
+// vendor entries in the USB ID repository (http://www.linux-usb.org/usb.ids), with the
+// vendor names copied verbatim. Vendors should be added to it when their adapters are
+// found to be missing.
+var USBVendors = map[uint16]string{
+	0x045e: "Microsoft Corp.",
+	0x046d: "Logitech, Inc.",
+	0x0489: "Foxconn / Hon Hai",
+	0x04ca: "Lite-On Technology Corp.",
+	0x04e8: "Samsung Electronics Co., Ltd",
+	0x054c: "Sony Corp.",
+	0x05ac: "Apple, Inc.",
+	0x0a12: "Cambridge Silicon Radio, Ltd",
+	0x0a5c: "Broadcom Corp.",
+	0x0b05: "ASUSTek Computer, Inc.",
+	0x0bda: "Realtek Semiconductor Corp.",
+	0x0cf3: "Qualcomm Atheros Communications",
+	0x0e8d: "MediaTek Inc.",
+	0x1286: "Marvell Semiconductor, Inc.",
+	0x13d3: "IMC Networks",
+	0x1d6b: "Linux Foundation",
+	0x2357: "TP-Link",
+	0x8087: "Intel Corp.",
+}
+
+// CompanyName returns the name of the company with the provided Bluetooth SIG
+// company identifier. If the company is unknown, an empty string is returned.
+func CompanyName(companyID uint16) string {
+	return Companies[companyID]
+}
+
+// VendorName returns the name of the vendor of the Modalias, according to the
+// source of its vendor ID. If the vendor is unknown, an empty string is returned.
+func (m Modalias) VendorName() string {
+	switch m.Source {
+	case ModaliasSourceBluetooth:
+		return Companies[m.Vendor]
+
+	case ModaliasSourceUSB:
+		return USBVendors[m.Vendor]
+	}
+
+	return ""
+}
+
+// VendorName returns the name of the vendor of the device. The vendor is determined
+// from the device's Modalias if it is available, otherwise from the company identifiers
+// of its manufacturer specific data. If the vendor is unknown, an empty string is returned.
+func (d DeviceEventData) VendorName() string {
+	if name := d.Modalias.VendorName(); name != "" {
+		return name
+	}
+
+	companyIDs := make([]uint16, 0, len(d.ManufacturerData))
+	for companyID := range d.ManufacturerData {
+		companyIDs = append(companyIDs, companyID)
+	}
+
+	slices.Sort(companyIDs)
+
+	for _, companyID := range companyIDs {
+		if name := CompanyName(companyID); name != "" {
+			return name
+		}
+	}
+
+	return ""
+}
@@ -1,12 +1,85 @@
 package config
 
-import "time"
+import (
+	"time"
+
+	"github.com/bluetuith-org/api-native/api/eventbus"
+)
 
 const (
 	// The default timeout duration for authentication requests.
 	DefaultAuthTimeout = 10 * time.Second
 )
 
+// AgentCapability describes the input/output capability of the pairing agent.
+type AgentCapability string
+
+// The different pairing agent capabilities.
+const (
+	AgentDisplayOnly     AgentCapability = "DisplayOnly"
+	AgentDisplayYesNo    AgentCapability = "DisplayYesNo"
+	AgentKeyboardOnly    AgentCapability = "KeyboardOnly"
+	AgentNoInputNoOutput AgentCapability = "NoInputNoOutput"
+	AgentKeyboardDisplay AgentCapability = "KeyboardDisplay"
+)
+
+// AgentMode describes how the pairing agent is registered.
+type AgentMode uint8
+
+// The different pairing agent registration modes.
+const (
+	// AgentModeDefault registers the pairing agent, and requests it to be the default agent.
+	AgentModeDefault AgentMode = iota
+
+	// AgentModeNonDefault registers the pairing agent, without requesting it to be the
+	// default agent. Pairing requests that are not initiated by the application are
+	// handled by the default agent.
+	AgentModeNonDefault
+
+	// AgentModeDisabled does not register a pairing agent. This is useful for monitoring tools,
+	// which should not handle any pairing requests.
+	AgentModeDisabled
+)
+
+// Valid returns whether the agent capability is a known capability.
+func (a AgentCapability) Valid() bool {
+	switch a {
+	case AgentDisplayOnly, AgentDisplayYesNo, AgentKeyboardOnly,
+		AgentNoInputNoOutput, AgentKeyboardDisplay:
+		return true
+	}
+
+	return false
+}
+
+// AutoReconnect describes the configuration of the automatic reconnection of known devices.
+// Devices are reconnected when they are disconnected unexpectedly, when they are found again
+// (for example, while discovering), when their adapter is powered on, after the session
+// recovers from a daemon restart, and after the system resumes from sleep (on Linux, this
+// is detected via logind). A device which is disconnected via the session is not
+// reconnected until it is connected via the session again.
+type AutoReconnect struct {
+	// Devices holds the addresses (for example, "AA:BB:CC:DD:EE:FF") of the devices
+	// which are reconnected automatically.
+	Devices []string
+
+	// AllTrusted indicates whether all trusted devices are reconnected automatically,
+	// along with the devices in Devices.
+	AllTrusted bool
+
+	// MaxAttempts holds the maximum number of connection attempts for each reconnection.
+	// If it is zero, the default of the connection retry policy is used.
+	MaxAttempts int
+
+	// InitialDelay holds the duration to wait before retrying a failed connection attempt.
+	// If it is zero, the default of the connection retry policy is used.
+	InitialDelay time.Duration
+
+	// MaxDelay holds the maximum duration to wait between connection attempts.
+	// If it is zero, the default of the connection retry policy is used.
+	MaxDelay time.Duration
+}
+
 // Configuration describes a general configuration.
 type Configuration struct {
 	// ExecutablePath holds the path to the executable.
@@ -15,11 +88,38 @@ type Configuration struct {
 
 	// AuthTimeout holds the timeout for authentication requests.
 	AuthTimeout time.Duration
+
+	// AgentCapability holds the input/output capability of the pairing agent.
+	// If this is empty, AgentKeyboardDisplay is used.
+	AgentCapability AgentCapability
+
+	// AgentPath holds the object path of the pairing agent.
+	// Specific to Linux. If this is empty, a default path is used.
+	AgentPath string
+
+	// AgentMode holds the registration mode of the pairing agent.
+	AgentMode AgentMode
+
+	// DefaultAdapter holds the address (for example, "00:1A:7D:DA:71:13") or the unique
+	// name (for example, "hci0") of the adapter which is preferred as the default adapter.
+	// If this is empty or the adapter does not exist, the default adapter is chosen
+	// automatically (see bluetooth.Session.DefaultAdapter).
+	DefaultAdapter string
+
+	// AutoReconnect holds the configuration of the automatic reconnection of known devices.
+	// The reconnection of individual devices can be toggled using (bluetooth.Device).SetAutoReconnect.
+	AutoReconnect AutoReconnect
+
+	// EventEmitter holds the event emitter which the session publishes its events to.
+	// If this is nil, the global event emitter is used. To run multiple independent
+	// sessions, each session should be provided its own emitter (see eventbus.NewEmitter).
+	EventEmitter *eventbus.Emitter
 }
 
 // New returns a new configuration with the default authentication timeout.
 func New() Configuration {
 	return Configuration{
-		AuthTimeout: DefaultAuthTimeout,
+		AuthTimeout:     DefaultAuthTimeout,
+		AgentCapability: AgentKeyboardDisplay,
 	}
 }
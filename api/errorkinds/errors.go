@@ -1,17 +1,30 @@
 package errorkinds
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+)
 
 // The different general error types.
 var (
 	ErrSessionStart    = errors.New("cannot start session")
 	ErrSessionStop     = errors.New("cannot stop session")
+	ErrDaemonLost      = errors.New("bluetooth daemon is not running")
+	ErrSessionReadOnly = errors.New("session is read-only")
 	ErrMethodCall      = errors.New("cannot call method")
 	ErrMethodCanceled  = errors.New("method call was cancelled")
 	ErrInvalidAddress  = errors.New("invalid Bluetooth address")
 	ErrAdapterNotFound = errors.New("adapter not found")
 	ErrDeviceNotFound  = errors.New("device not found")
 
+	ErrPermissionDenied = errors.New("permission denied")
+
+	ErrDeviceUnreachable         = errors.New("device is unreachable")
+	ErrDeviceServicesNotResolved = errors.New("device services were not resolved")
+
+	ErrAdapterSoftBlocked = errors.New("adapter is blocked via rfkill")
+	ErrAdapterHardBlocked = errors.New("adapter is blocked by a hardware switch")
+
 	ErrObexInitSession    = errors.New("obex session is not initialized")
 	ErrNetworkInitSession = errors.New("network session is not initialized")
 
@@ -39,3 +52,33 @@ func (e GenericError) Error() string {
 func (e GenericError) Unwrap() error {
 	return e.Errors
 }
+
+// MarshalJSON encodes the error as its text representation.
+func (e GenericError) MarshalJSON() ([]byte, error) {
+	message := ""
+	if e.Errors != nil {
+		message = e.Errors.Error()
+	}
+
+	return json.Marshal(struct {
+		Errors string `json:"errors,omitempty"`
+	}{message})
+}
+
+// UnmarshalJSON decodes the text representation of an error.
+func (e *GenericError) UnmarshalJSON(data []byte) error {
+	var decoded struct {
+		Errors string `json:"errors,omitempty"`
+	}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	e.Errors = nil
+	if decoded.Errors != "" {
+		e.Errors = errors.New(decoded.Errors)
+	}
+
+	return nil
+}
@@ -12,6 +12,18 @@ type nilEventHandler struct{}
 // defaultEventHandler represents an internal event handler.
 type defaultEventHandler struct {
 	*pubsub.PubSub[uint, any]
+
+	subscribers map[chan any]struct{}
+	mu          sync.Mutex
+}
+
+// scopedEventHandler represents an event handler which publishes events to, and subscribes
+// to events from, the event handlers of a parent emitter, and tracks its own subscriptions.
+type scopedEventHandler struct {
+	parent *Emitter
+
+	subscribers map[chan any]UnsubFunc
+	mu          sync.Mutex
 }
 
 // EventPublisher represents an interface that provides an event publisher.
@@ -26,46 +38,108 @@ type EventSubscriber interface {
 	Subscribe(id uint, name string) SubscriberID
 }
 
+// EventCloser represents an interface that closes all active event subscriptions.
+// An event handler can optionally implement this interface.
+type EventCloser interface {
+	// CloseSubscriptions unsubscribes and closes all active event subscriptions.
+	CloseSubscriptions()
+}
+
 // EventHandler represents an interface that provides an event publisher and subscriber.
 type EventHandler interface {
 	EventPublisher
 	EventSubscriber
 }
 
-// eventHandler represents the main event handler.
-type eventHandler struct {
+// Emitter represents an event emitter, which publishes events to and subscribes
+// to events from its registered event handler. Each session can use its own emitter,
+// so that events from multiple sessions are not mixed up.
+type Emitter struct {
 	p EventPublisher
 	s EventSubscriber
 
 	mu sync.RWMutex
 }
 
-var eventEmitter eventHandler
+var eventEmitter = NewEmitter(DefaultHandler())
+
+// NewEmitter returns a new event emitter with the provided event handler.
+// If the event handler is nil, the default event handler is used.
+func NewEmitter(eh EventHandler) *Emitter {
+	emitter := &Emitter{}
+
+	if eh == nil {
+		eh = DefaultHandler()
+	}
+
+	emitter.RegisterEventHandler(eh)
 
-func init() {
-	RegisterEventHandler(DefaultHandler())
+	return emitter
 }
 
-// RegisterEventHandler registers the event handler interface.
+// DefaultEmitter returns the global event emitter.
+func DefaultEmitter() *Emitter {
+	return eventEmitter
+}
+
+// RegisterEventHandler registers the event handler interface to the global event emitter.
 func RegisterEventHandler(eh EventHandler) {
+	eventEmitter.RegisterEventHandler(eh)
+}
+
+// RegisterEventHandlers registers the event publisher and subscriber interfaces separately
+// to the global event emitter.
+// To disable an EventPublisher or EventSubscriber, pass 'nil' as the parameter.
+// For example: `RegisterEventHandlers(&eventPublisher{}, nil)` can be called to only register
+// an event publisher.
+func RegisterEventHandlers(p EventPublisher, s EventSubscriber) {
+	eventEmitter.RegisterEventHandlers(p, s)
+}
+
+// DisableEvents unregisters the event handler of the global event emitter.
+func DisableEvents() {
+	eventEmitter.DisableEvents()
+}
+
+// Publish calls the registered publisher handler of the global event emitter.
+func Publish(id EventID, data any) {
+	eventEmitter.Publish(id, data)
+}
+
+// Subscribe calls the registered subscriber handler of the global event emitter.
+func Subscribe(id EventID) SubscriberID {
+	return eventEmitter.Subscribe(id)
+}
+
+// Scope returns an emitter which publishes events to, and subscribes to events from, the
+// event handlers of this emitter. Closing the subscriptions of the returned emitter only
+// closes the subscriptions which were made via the returned emitter, so that multiple
+// sessions can share an emitter without closing each other's subscriptions.
+func (e *Emitter) Scope() *Emitter {
+	return NewEmitter(&scopedEventHandler{
+		parent:      e,
+		subscribers: make(map[chan any]UnsubFunc),
+	})
+}
+
+// RegisterEventHandler registers the event handler interface.
+func (e *Emitter) RegisterEventHandler(eh EventHandler) {
 	if eh == nil {
 		return
 	}
 
-	eventEmitter.mu.Lock()
-	defer eventEmitter.mu.Unlock()
+	e.mu.Lock()
+	defer e.mu.Unlock()
 
-	eventEmitter.p = eh.(EventPublisher)
-	eventEmitter.s = eh.(EventSubscriber)
+	e.p = eh.(EventPublisher)
+	e.s = eh.(EventSubscriber)
 }
 
 // RegisterEventHandlers registers the event publisher and subscriber interfaces separately.
 // To disable an EventPublisher or EventSubscriber, pass 'nil' as the parameter.
-// For example: `RegisterEventHandlers(&eventPublisher{}, nil)` can be called to only register
-// an event publisher.
-func RegisterEventHandlers(p EventPublisher, s EventSubscriber) {
-	eventEmitter.mu.Lock()
-	defer eventEmitter.mu.Unlock()
+func (e *Emitter) RegisterEventHandlers(p EventPublisher, s EventSubscriber) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
 
 	if p == nil {
 		p = &nilEventHandler{}
@@ -74,44 +148,60 @@ func RegisterEventHandlers(p EventPublisher, s EventSubscriber) {
 		s = &nilEventHandler{}
 	}
 
-	eventEmitter.p = p
-	eventEmitter.s = s
+	e.p = p
+	e.s = s
 }
 
 // DisableEvents unregisters the event handler.
-func DisableEvents() {
-	RegisterEventHandler(&nilEventHandler{})
+func (e *Emitter) DisableEvents() {
+	e.RegisterEventHandler(&nilEventHandler{})
 }
 
 // Publish calls the registered publisher handler.
-func Publish(id EventID, data any) {
+func (e *Emitter) Publish(id EventID, data any) {
 	if id == nil {
 		return
 	}
 
-	eventEmitter.mu.RLock()
-	p := eventEmitter.p
-	eventEmitter.mu.RUnlock()
+	e.mu.RLock()
+	p := e.p
+	e.mu.RUnlock()
 
 	p.Publish(id.Value(), id.String(), data)
 }
 
+// CloseSubscriptions closes all active subscriptions of the registered subscriber handler,
+// if it implements the EventCloser interface. Events can still be published and subscribed
+// to after the subscriptions are closed.
+func (e *Emitter) CloseSubscriptions() {
+	e.mu.RLock()
+	s := e.s
+	e.mu.RUnlock()
+
+	if closer, ok := s.(EventCloser); ok {
+		closer.CloseSubscriptions()
+	}
+}
+
 // Subscribe calls the registered subscriber handler.
-func Subscribe(id EventID) SubscriberID {
+func (e *Emitter) Subscribe(id EventID) SubscriberID {
 	if id == nil {
 		return (&nilEventHandler{}).Subscribe(0, "")
 	}
 
-	eventEmitter.mu.RLock()
-	s := eventEmitter.s
-	eventEmitter.mu.RUnlock()
+	e.mu.RLock()
+	s := e.s
+	e.mu.RUnlock()
 
 	return s.Subscribe(id.Value(), id.String())
 }
 
 // DefaultHandler returns the default event handler.
 func DefaultHandler() *defaultEventHandler {
-	return &defaultEventHandler{PubSub: pubsub.New[uint, any](10)}
+	return &defaultEventHandler{
+		PubSub:      pubsub.New[uint, any](10),
+		subscribers: make(map[chan any]struct{}),
+	}
 }
 
 // NilHandler returns a disabled event handler.
@@ -127,15 +217,83 @@ func (d *defaultEventHandler) Publish(id uint, name string, data any) {
 // Subscribe subscribes to an event from the event stream.
 func (d *defaultEventHandler) Subscribe(id uint, name string) SubscriberID {
 	ch := d.Sub(id)
+
+	d.mu.Lock()
+	d.subscribers[ch] = struct{}{}
+	d.mu.Unlock()
+
 	return SubscriberID{
 		C:      ch,
 		active: true,
 		unsub: func() {
+			d.mu.Lock()
+			delete(d.subscribers, ch)
+			d.mu.Unlock()
+
 			go d.Unsub(ch, id)
 		},
 	}
 }
 
+// CloseSubscriptions unsubscribes and closes all active event subscriptions.
+func (d *defaultEventHandler) CloseSubscriptions() {
+	d.mu.Lock()
+	subscribers := d.subscribers
+	d.subscribers = make(map[chan any]struct{})
+	d.mu.Unlock()
+
+	for ch := range subscribers {
+		d.Unsub(ch)
+	}
+}
+
+// Publish publishes an event to the event stream of the parent emitter.
+func (s *scopedEventHandler) Publish(id uint, name string, data any) {
+	s.parent.mu.RLock()
+	p := s.parent.p
+	s.parent.mu.RUnlock()
+
+	p.Publish(id, name, data)
+}
+
+// Subscribe subscribes to an event from the event stream of the parent emitter.
+func (s *scopedEventHandler) Subscribe(id uint, name string) SubscriberID {
+	s.parent.mu.RLock()
+	subscriber := s.parent.s
+	s.parent.mu.RUnlock()
+
+	sub := subscriber.Subscribe(id, name)
+
+	s.mu.Lock()
+	s.subscribers[sub.C] = sub.Unsubscribe
+	s.mu.Unlock()
+
+	return SubscriberID{
+		C:      sub.C,
+		active: sub.active,
+		unsub: func() {
+			s.mu.Lock()
+			delete(s.subscribers, sub.C)
+			s.mu.Unlock()
+
+			sub.Unsubscribe()
+		},
+	}
+}
+
+// CloseSubscriptions unsubscribes and closes all the subscriptions which were made
+// via this event handler. The other subscriptions of the parent emitter are not closed.
+func (s *scopedEventHandler) CloseSubscriptions() {
+	s.mu.Lock()
+	subscribers := s.subscribers
+	s.subscribers = make(map[chan any]UnsubFunc)
+	s.mu.Unlock()
+
+	for _, unsub := range subscribers {
+		unsub()
+	}
+}
+
 // Publish does not do anything.
 func (n *nilEventHandler) Publish(uint, string, any) {
 }
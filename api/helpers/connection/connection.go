@@ -0,0 +1,82 @@
+package connection
+
+import (
+	"context"
+	"time"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/api/eventbus"
+)
+
+// ConnectFunc describes a function which attempts to connect to a device.
+type ConnectFunc func() error
+
+// RetryableFunc describes a function which returns whether a connection error is worth retrying.
+type RetryableFunc func(err error) bool
+
+// Retry calls connect until it succeeds, or until it fails with an error which is not
+// retryable, or until the maximum number of attempts of the policy is reached. The delay
+// between attempts is determined by the policy. A connect attempt event is published
+// to the provided emitter after each attempt. If the context is done during an attempt,
+// or while waiting for the next attempt, errorkinds.ErrMethodCanceled is returned.
+func Retry(
+	ctx context.Context, emitter *eventbus.Emitter,
+	deviceAddress bluetooth.MacAddress, policy bluetooth.ConnectRetryPolicy,
+	connect ConnectFunc, retryable RetryableFunc,
+) error {
+	event := bluetooth.ConnectAttemptEvent().On(emitter)
+	maxAttempts := policy.Attempts()
+
+	for attempt := 1; ; attempt++ {
+		data := bluetooth.ConnectAttemptEventData{
+			Address:     deviceAddress,
+			Attempt:     attempt,
+			MaxAttempts: maxAttempts,
+		}
+
+		err := connect()
+		if err == nil {
+			data.Connected = true
+			event.Publish(data)
+
+			return nil
+		}
+
+		data.Error = err.Error()
+		data.Retrying = attempt < maxAttempts && ctx.Err() == nil && retryable(err)
+		if data.Retrying {
+			data.Delay = policy.Delay(attempt)
+		}
+
+		event.Publish(data)
+
+		if ctx.Err() != nil {
+			return errorkinds.ErrMethodCanceled
+		}
+
+		if !data.Retrying {
+			return err
+		}
+
+		if err := wait(ctx, data.Delay); err != nil {
+			return err
+		}
+	}
+}
+
+// wait waits for the provided delay to pass, or returns
+// an error if the context is done before that.
+func wait(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return errorkinds.ErrMethodCanceled
+
+	case <-timer.C:
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+package connection
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/api/eventbus"
+)
+
+// errRetryable and errPermanent are the errors of failed test connection attempts.
+var (
+	errRetryable = errors.New("page timeout")
+	errPermanent = errors.New("not supported")
+)
+
+// isRetryable returns whether the error of a test connection attempt is worth retrying.
+func isRetryable(err error) bool {
+	return errors.Is(err, errRetryable)
+}
+
+// fastPolicy returns a retry policy with the provided maximum number of attempts,
+// whose delays are short enough to not slow down the tests.
+func fastPolicy(maxAttempts int) bluetooth.ConnectRetryPolicy {
+	return bluetooth.ConnectRetryPolicy{
+		MaxAttempts:  maxAttempts,
+		InitialDelay: time.Millisecond,
+		MaxDelay:     time.Millisecond,
+	}
+}
+
+// retry calls Retry with a new event emitter, and returns the connect
+// attempt events which were published, along with its error.
+func retry(
+	ctx context.Context, policy bluetooth.ConnectRetryPolicy, connect ConnectFunc,
+) ([]bluetooth.ConnectAttemptEventData, error) {
+	emitter := eventbus.NewEmitter(nil)
+
+	sub := bluetooth.ConnectAttemptEvent().On(emitter).Subscribe()
+	defer sub.Unsubscribe()
+
+	err := Retry(ctx, emitter, bluetooth.MacAddress{}, policy, connect, isRetryable)
+
+	var events []bluetooth.ConnectAttemptEventData
+
+	for {
+		select {
+		case ev := <-sub.C:
+			events = append(events, ev.Data)
+
+		case <-time.After(100 * time.Millisecond):
+			return events, err
+		}
+	}
+}
+
+func TestConnectRetryPolicyDelay(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy bluetooth.ConnectRetryPolicy
+		want   []time.Duration
+	}{
+		{
+			name:   "Defaults",
+			policy: bluetooth.ConnectRetryPolicy{},
+			want: []time.Duration{
+				time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second,
+				16 * time.Second, 30 * time.Second, 30 * time.Second,
+			},
+		},
+		{
+			name:   "Multiplier",
+			policy: bluetooth.ConnectRetryPolicy{InitialDelay: 100 * time.Millisecond, MaxDelay: time.Second, Multiplier: 3},
+			want:   []time.Duration{100 * time.Millisecond, 300 * time.Millisecond, 900 * time.Millisecond, time.Second},
+		},
+		{
+			name:   "MaxDelayBelowInitialDelay",
+			policy: bluetooth.ConnectRetryPolicy{InitialDelay: 2 * time.Second, MaxDelay: time.Second},
+			want:   []time.Duration{time.Second, time.Second},
+		},
+		{
+			name:   "InvalidMultiplier",
+			policy: bluetooth.ConnectRetryPolicy{InitialDelay: time.Second, MaxDelay: time.Minute, Multiplier: 0.5},
+			want:   []time.Duration{time.Second, 2 * time.Second, 4 * time.Second},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for i, want := range test.want {
+				if delay := test.policy.Delay(i + 1); delay != want {
+					t.Errorf("Delay(%d) = %s, want %s", i+1, delay, want)
+				}
+			}
+		})
+	}
+
+	// The delay must never exceed the maximum delay, even after many attempts.
+	policy := bluetooth.ConnectRetryPolicy{InitialDelay: time.Millisecond, MaxDelay: time.Minute, Multiplier: 10}
+	for attempt := 1; attempt <= 100; attempt++ {
+		if delay := policy.Delay(attempt); delay <= 0 || delay > time.Minute {
+			t.Fatalf("Delay(%d) = %s, want a delay between 0 and %s", attempt, delay, time.Minute)
+		}
+	}
+}
+
+func TestRetry(t *testing.T) {
+	t.Run("SucceedsAfterRetries", func(t *testing.T) {
+		attempts := 0
+
+		events, err := retry(context.Background(), fastPolicy(3), func() error {
+			if attempts++; attempts < 3 {
+				return errRetryable
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Retry() returned error: %v", err)
+		}
+
+		if len(events) != 3 {
+			t.Fatalf("Retry() published %d events, want 3", len(events))
+		}
+
+		for i, ev := range events {
+			connected := i == 2
+			if ev.Attempt != i+1 || ev.MaxAttempts != 3 || ev.Connected != connected || ev.Retrying == connected {
+				t.Errorf("Event %d = %+v, want attempt %d of 3 (connected: %v)", i, ev, i+1, connected)
+			}
+
+			if !connected && (ev.Delay != time.Millisecond || ev.Error != errRetryable.Error()) {
+				t.Errorf("Event %d = %+v, want a delay of 1ms and error %q", i, ev, errRetryable)
+			}
+		}
+	})
+
+	t.Run("MaxAttempts", func(t *testing.T) {
+		attempts := 0
+
+		events, err := retry(context.Background(), fastPolicy(2), func() error {
+			attempts++
+			return errRetryable
+		})
+		if !errors.Is(err, errRetryable) {
+			t.Errorf("Retry() returned %v, want %v", err, errRetryable)
+		}
+
+		if attempts != 2 || len(events) != 2 {
+			t.Fatalf("Retry() made %d attempts and published %d events, want 2", attempts, len(events))
+		}
+
+		if last := events[1]; last.Retrying || last.Delay != 0 {
+			t.Errorf("Last event = %+v, want no retry", last)
+		}
+	})
+
+	t.Run("NotRetryable", func(t *testing.T) {
+		attempts := 0
+
+		events, err := retry(context.Background(), fastPolicy(5), func() error {
+			attempts++
+			return errPermanent
+		})
+		if !errors.Is(err, errPermanent) {
+			t.Errorf("Retry() returned %v, want %v", err, errPermanent)
+		}
+
+		if attempts != 1 || len(events) != 1 || events[0].Retrying {
+			t.Errorf("Retry() made %d attempts with events %+v, want 1 attempt which is not retried", attempts, events)
+		}
+	})
+
+	t.Run("CanceledDuringAttempt", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		attempts := 0
+
+		events, err := retry(ctx, fastPolicy(5), func() error {
+			attempts++
+			cancel()
+
+			return errRetryable
+		})
+		if !errors.Is(err, errorkinds.ErrMethodCanceled) {
+			t.Errorf("Retry() returned %v, want %v", err, errorkinds.ErrMethodCanceled)
+		}
+
+		if attempts != 1 || len(events) != 1 || events[0].Retrying {
+			t.Errorf("Retry() made %d attempts with events %+v, want 1 attempt which is not retried", attempts, events)
+		}
+	})
+
+	t.Run("CanceledDuringDelay", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		policy := bluetooth.ConnectRetryPolicy{MaxAttempts: 5, InitialDelay: time.Minute, MaxDelay: time.Minute}
+		attempts := 0
+
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		start := time.Now()
+		_, err := retry(ctx, policy, func() error {
+			attempts++
+			return errRetryable
+		})
+		if !errors.Is(err, errorkinds.ErrMethodCanceled) {
+			t.Errorf("Retry() returned %v, want %v", err, errorkinds.ErrMethodCanceled)
+		}
+
+		if attempts != 1 {
+			t.Errorf("Retry() made %d attempts, want 1", attempts)
+		}
+
+		if elapsed := time.Since(start); elapsed > 10*time.Second {
+			t.Errorf("Retry() returned after %s, want it to return when the context is canceled", elapsed)
+		}
+	})
+}
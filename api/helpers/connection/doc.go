@@ -0,0 +1,5 @@
+/*
+Package connection provides helpers to implement device connections,
+which are retried according to a retry and backoff policy.
+*/
+package connection
@@ -0,0 +1,161 @@
+package connection
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/api/eventbus"
+)
+
+// setupStage describes a stage of the setup workflow, which returns whether
+// the stage was skipped, or an error if the stage failed.
+type setupStage struct {
+	stage bluetooth.SetupStage
+	run   func() (bool, error)
+}
+
+// Setup runs the setup workflow of the provided device, whose method calls are bound
+// to the provided context. A setup event is published to the provided emitter when
+// each stage starts and ends. If a stage fails, the workflow is stopped, and the result
+// along with the error of the stage is returned.
+func Setup(
+	ctx context.Context, emitter *eventbus.Emitter,
+	device bluetooth.Device, deviceAddress bluetooth.MacAddress, opts bluetooth.SetupOptions,
+) (bluetooth.SetupResult, error) {
+	device = device.WithContext(ctx)
+	event := bluetooth.SetupEvent().On(emitter)
+	result := bluetooth.SetupResult{Address: deviceAddress}
+
+	stages := []setupStage{
+		{bluetooth.SetupStagePair, func() (bool, error) {
+			properties, err := device.Properties()
+			if err != nil || properties.Paired {
+				return properties.Paired, err
+			}
+
+			return false, device.Pair()
+		}},
+		{bluetooth.SetupStageTrust, func() (bool, error) {
+			properties, err := device.Properties()
+			if err != nil || opts.SkipTrust || properties.Trusted {
+				return opts.SkipTrust || properties.Trusted, err
+			}
+
+			return false, device.SetTrusted(true)
+		}},
+		{bluetooth.SetupStageConnect, func() (bool, error) {
+			properties, err := device.Properties()
+			if err != nil || properties.Connected {
+				return properties.Connected, err
+			}
+
+			return false, device.ConnectWithRetry(opts.Retry)
+		}},
+		{bluetooth.SetupStageServices, func() (bool, error) {
+			return false, waitServices(ctx, emitter, device, deviceAddress, opts.ServicesTimeout)
+		}},
+		{bluetooth.SetupStageProfiles, func() (bool, error) {
+			for _, profile := range opts.Profiles {
+				if err := device.ConnectProfile(profile); err != nil {
+					return false, err
+				}
+			}
+
+			return len(opts.Profiles) == 0, nil
+		}},
+	}
+
+	for _, s := range stages {
+		event.Publish(bluetooth.SetupEventData{
+			Address:          deviceAddress,
+			SetupStageResult: bluetooth.SetupStageResult{Stage: s.stage, Status: bluetooth.SetupStatusStarted},
+		})
+
+		stageResult := bluetooth.SetupStageResult{Stage: s.stage, Status: bluetooth.SetupStatusCompleted}
+
+		skipped, err := s.run()
+		switch {
+		case err != nil:
+			stageResult.Status, stageResult.Error = bluetooth.SetupStatusFailed, err.Error()
+			result.FailedStage, result.Error = s.stage, err.Error()
+
+		case skipped:
+			stageResult.Status = bluetooth.SetupStatusSkipped
+		}
+
+		result.Stages = append(result.Stages, stageResult)
+		event.Publish(bluetooth.SetupEventData{Address: deviceAddress, SetupStageResult: stageResult})
+
+		if err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// waitServices waits for the services of the device to be resolved, until the timeout passes,
+// or the context is done. If the device is disconnected while waiting, an error is returned.
+func waitServices(
+	ctx context.Context, emitter *eventbus.Emitter,
+	device bluetooth.Device, deviceAddress bluetooth.MacAddress, timeout time.Duration,
+) error {
+	if timeout <= 0 {
+		timeout = bluetooth.DefaultSetupServicesTimeout
+	}
+
+	sub := bluetooth.DeviceEvent().On(emitter).Subscribe()
+	defer sub.Unsubscribe()
+
+	properties, err := device.Properties()
+	if err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for eventData := properties.DeviceEventData; ; {
+		switch {
+		case eventData.ServicesResolved:
+			return nil
+
+		case !eventData.Connected:
+			return fmt.Errorf("wait %q: device was disconnected: %w",
+				deviceAddress.String(), errorkinds.ErrDeviceServicesNotResolved,
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return errorkinds.ErrMethodCanceled
+
+		case <-timer.C:
+			return fmt.Errorf("wait %q: timed out: %w",
+				deviceAddress.String(), errorkinds.ErrDeviceServicesNotResolved,
+			)
+
+		case ev, ok := <-sub.C:
+			if !ok {
+				return fmt.Errorf("wait %q: event subscription was closed: %w",
+					deviceAddress.String(), errorkinds.ErrDeviceServicesNotResolved,
+				)
+			}
+
+			if ev.Data.Address != deviceAddress {
+				continue
+			}
+
+			if ev.Action == bluetooth.EventActionRemoved {
+				return fmt.Errorf("wait %q: device was removed: %w",
+					deviceAddress.String(), errorkinds.ErrDeviceServicesNotResolved,
+				)
+			}
+
+			eventData = ev.Data
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package discovery
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/eventbus"
+)
+
+// DeviceLookupFunc describes a function which returns the properties of a device.
+type DeviceLookupFunc func(deviceAddress bluetooth.MacAddress) (bluetooth.DeviceData, error)
+
+// DeviceSeenFunc describes a function which returns the time at which a device was last seen,
+// that is, the time at which its signal strength or advertising data was last updated.
+type DeviceSeenFunc func(deviceAddress bluetooth.MacAddress) (time.Time, bool)
+
+// Scans reference-counts the discovery sessions of adapters.
+// The discovery of an adapter is started when the first discovery session
+// is acquired, and stopped when the last discovery session is released.
+type Scans struct {
+	refs   map[bluetooth.MacAddress]int
+	manual map[bluetooth.MacAddress]bool
+
+	mu sync.Mutex
+}
+
+// NewScans returns a new discovery session counter.
+func NewScans() *Scans {
+	return &Scans{
+		refs:   make(map[bluetooth.MacAddress]int),
+		manual: make(map[bluetooth.MacAddress]bool),
+	}
+}
+
+// Acquire acquires a discovery session on an adapter, and calls start
+// if no other discovery session is active on the adapter.
+func (s *Scans) Acquire(adapterAddress bluetooth.MacAddress, start func() error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.acquire(adapterAddress, start)
+}
+
+// Release releases a discovery session on an adapter, and calls stop
+// if no other discovery session is active on the adapter.
+func (s *Scans) Release(adapterAddress bluetooth.MacAddress, stop func() error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.release(adapterAddress, stop)
+}
+
+// Hold acquires the discovery session of an adapter which is started
+// manually (for example, via Adapter.StartDiscovery()). Only one such
+// discovery session is held for each adapter.
+func (s *Scans) Hold(adapterAddress bluetooth.MacAddress, start func() error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.manual[adapterAddress] {
+		return nil
+	}
+
+	if err := s.acquire(adapterAddress, start); err != nil {
+		return err
+	}
+
+	s.manual[adapterAddress] = true
+
+	return nil
+}
+
+// Unhold releases the manually started discovery session of an adapter.
+// If it was not held, stop is called only if no other discovery session
+// is active on the adapter.
+func (s *Scans) Unhold(adapterAddress bluetooth.MacAddress, stop func() error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.manual[adapterAddress] {
+		delete(s.manual, adapterAddress)
+
+		return s.release(adapterAddress, stop)
+	}
+
+	if s.refs[adapterAddress] == 0 {
+		return stop()
+	}
+
+	return nil
+}
+
+// Reset removes all discovery sessions of the provided adapters, or of all adapters
+// if none are provided. This should be called if an adapter is removed, or the session is stopped.
+func (s *Scans) Reset(adapterAddresses ...bluetooth.MacAddress) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if adapterAddresses == nil {
+		clear(s.refs)
+		clear(s.manual)
+
+		return
+	}
+
+	for _, address := range adapterAddresses {
+		delete(s.refs, address)
+		delete(s.manual, address)
+	}
+}
+
+// acquire increments the discovery session count of an adapter.
+func (s *Scans) acquire(adapterAddress bluetooth.MacAddress, start func() error) error {
+	if s.refs[adapterAddress] == 0 {
+		if err := start(); err != nil {
+			return err
+		}
+	}
+
+	s.refs[adapterAddress]++
+
+	return nil
+}
+
+// release decrements the discovery session count of an adapter.
+func (s *Scans) release(adapterAddress bluetooth.MacAddress, stop func() error) error {
+	switch refs := s.refs[adapterAddress]; {
+	case refs == 0:
+		return nil
+
+	case refs > 1:
+		s.refs[adapterAddress]--
+
+		return nil
+	}
+
+	delete(s.refs, adapterAddress)
+
+	return stop()
+}
+
+// Watch subscribes to the device events of the provided emitter, and returns a channel
+// which receives the devices of the adapter that are found or updated, until the context
+// is done or the event subscription is closed. The channel must be drained by the caller.
+//
+// Only devices which are added, or which are seen (see DeviceSeenFunc) after Watch is called
+// are sent, so that devices which were known before, and whose properties change for other
+// reasons (for example, their battery level), are not reported as found. The first event
+// of each device is sent as an "added" event, and any later events as "updated" events.
+func Watch(
+	ctx context.Context, emitter *eventbus.Emitter,
+	adapterAddress bluetooth.MacAddress, lookup DeviceLookupFunc, seen DeviceSeenFunc,
+) <-chan bluetooth.DiscoveryEvent {
+	events := make(chan bluetooth.DiscoveryEvent, 10)
+	sub := bluetooth.DeviceEvent().On(emitter).Subscribe()
+
+	started := time.Now()
+	found := make(map[bluetooth.MacAddress]struct{})
+
+	go func() {
+		defer close(events)
+		defer sub.Unsubscribe()
+
+		for {
+			var ev bluetooth.Event[bluetooth.DeviceEventData]
+
+			select {
+			case <-ctx.Done():
+				return
+
+			case e, ok := <-sub.C:
+				if !ok {
+					return
+				}
+
+				ev = e
+			}
+
+			if ev.Data.AssociatedAdapter != adapterAddress ||
+				(ev.Action != bluetooth.EventActionAdded && ev.Action != bluetooth.EventActionUpdated) {
+				continue
+			}
+
+			action := bluetooth.EventActionUpdated
+			if _, ok := found[ev.Data.Address]; !ok {
+				if ev.Action != bluetooth.EventActionAdded {
+					lastSeen, ok := seen(ev.Data.Address)
+					if !ok || lastSeen.Before(started) {
+						continue
+					}
+				}
+
+				action = bluetooth.EventActionAdded
+			}
+
+			device, err := lookup(ev.Data.Address)
+			if err != nil {
+				continue
+			}
+
+			found[ev.Data.Address] = struct{}{}
+
+			select {
+			case <-ctx.Done():
+				return
+
+			case events <- bluetooth.DiscoveryEvent{Action: action, Device: device}:
+			}
+		}
+	}()
+
+	return events
+}
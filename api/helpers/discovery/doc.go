@@ -0,0 +1,6 @@
+/*
+Package discovery provides helpers to implement scoped discovery sessions,
+which reference-count the discovery of each adapter, and stream the devices
+that are found or updated during a discovery session.
+*/
+package discovery
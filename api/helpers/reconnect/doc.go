@@ -0,0 +1,7 @@
+/*
+Package reconnect provides a manager which automatically reconnects known devices,
+when they are disconnected unexpectedly, when they are found again, when their adapter
+is powered on, after the session recovers from a daemon restart, and after the system
+resumes from sleep (see Manager.Resumed).
+*/
+package reconnect
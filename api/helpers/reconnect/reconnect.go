@@ -0,0 +1,360 @@
+package reconnect
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/config"
+)
+
+// ReconnectFunc describes a function which reconnects to a device, and retries the
+// connection attempt according to the provided policy, until the context is done.
+// Unlike bluetooth.Device.Connect, it must not release a held device (see Manager.Release),
+// since only connections which are made on purpose should do that.
+type ReconnectFunc func(ctx context.Context, deviceAddress bluetooth.MacAddress, policy bluetooth.ConnectRetryPolicy) error
+
+// Manager reconnects the devices of a session automatically, according to the
+// session's auto-reconnect configuration and the per-device overrides.
+type Manager struct {
+	session bluetooth.Session
+	policy  bluetooth.ConnectRetryPolicy
+	connect ReconnectFunc
+
+	allTrusted bool
+	devices    map[bluetooth.MacAddress]struct{}
+	enabled    map[bluetooth.MacAddress]bool
+	held       map[bluetooth.MacAddress]struct{}
+	pending    map[bluetooth.MacAddress]context.CancelFunc
+
+	connected map[bluetooth.MacAddress]bool
+	seen      map[bluetooth.MacAddress]bool
+	powered   map[bluetooth.MacAddress]bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	unsubs []func()
+
+	wg sync.WaitGroup
+	mu sync.Mutex
+}
+
+// NewManager returns a new auto-reconnect manager for the provided session, which
+// reconnects devices using the provided function. Device addresses in the configuration
+// which cannot be parsed are ignored.
+func NewManager(session bluetooth.Session, cfg config.AutoReconnect, reconnect ReconnectFunc) *Manager {
+	m := &Manager{
+		session: session,
+		connect: reconnect,
+		policy: bluetooth.ConnectRetryPolicy{
+			MaxAttempts:  cfg.MaxAttempts,
+			InitialDelay: cfg.InitialDelay,
+			MaxDelay:     cfg.MaxDelay,
+		},
+		allTrusted: cfg.AllTrusted,
+		devices:    make(map[bluetooth.MacAddress]struct{}, len(cfg.Devices)),
+		enabled:    make(map[bluetooth.MacAddress]bool),
+		held:       make(map[bluetooth.MacAddress]struct{}),
+		pending:    make(map[bluetooth.MacAddress]context.CancelFunc),
+		connected:  make(map[bluetooth.MacAddress]bool),
+		seen:       make(map[bluetooth.MacAddress]bool),
+		powered:    make(map[bluetooth.MacAddress]bool),
+	}
+
+	for _, device := range cfg.Devices {
+		if address, err := bluetooth.ParseMAC(device); err == nil {
+			m.devices[address] = struct{}{}
+		}
+	}
+
+	return m
+}
+
+// Start subscribes to the adapter, device and session events of the session,
+// and starts reconnecting devices. The initial state of the adapters and devices
+// is fetched from the session, so this should be called after the session is started.
+func (m *Manager) Start() {
+	emitter := m.session.Events()
+
+	adapterSub := bluetooth.AdapterEvent().On(emitter).Subscribe()
+	deviceSub := bluetooth.DeviceEvent().On(emitter).Subscribe()
+	sessionSub := bluetooth.SessionEvent().On(emitter).Subscribe()
+
+	adapters := m.session.Adapters()
+	devices := make([]bluetooth.DeviceData, 0, len(adapters))
+
+	for _, adapter := range adapters {
+		adapterDevices, _ := m.session.Adapter(adapter.Address).Devices()
+		devices = append(devices, adapterDevices...)
+	}
+
+	m.mu.Lock()
+	m.ctx, m.cancel = context.WithCancel(context.Background())
+	m.unsubs = []func(){adapterSub.Unsubscribe, deviceSub.Unsubscribe, sessionSub.Unsubscribe}
+	ctx := m.ctx
+
+	for _, adapter := range adapters {
+		m.powered[adapter.Address] = adapter.Powered
+	}
+
+	for _, device := range devices {
+		m.connected[device.Address] = device.Connected
+		m.seen[device.Address] = device.RSSI != 0
+	}
+	m.wg.Add(1)
+	m.mu.Unlock()
+
+	go func() {
+		defer m.wg.Done()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case ev, ok := <-adapterSub.C:
+				if !ok {
+					return
+				}
+
+				m.adapterChanged(ev)
+
+			case ev, ok := <-deviceSub.C:
+				if !ok {
+					return
+				}
+
+				m.deviceChanged(ev)
+
+			case ev, ok := <-sessionSub.C:
+				if !ok {
+					return
+				}
+
+				if ev.Data.State == bluetooth.SessionRecovered {
+					m.reconnectAll()
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops reconnecting devices, cancels all pending reconnections, and waits for
+// them to return. Stop must not be called while holding a lock that a reconnection
+// attempt acquires. Stop, Resumed, SetEnabled, Hold and Release do nothing on a nil manager.
+func (m *Manager) Stop() {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	if m.cancel != nil {
+		m.cancel()
+	}
+
+	for _, unsub := range m.unsubs {
+		unsub()
+	}
+
+	m.unsubs = nil
+	clear(m.pending)
+	m.mu.Unlock()
+
+	m.wg.Wait()
+}
+
+// Resumed reconnects the disconnected devices of all powered adapters.
+// This should be called when the system resumes from sleep, since devices
+// are disconnected when the system sleeps, and they are not reconnected by
+// the adapter being powered on again.
+func (m *Manager) Resumed() {
+	if m == nil {
+		return
+	}
+
+	m.reconnectAll()
+}
+
+// SetEnabled sets whether the device is reconnected automatically, overriding the
+// configuration for the device. If it is disabled, any pending reconnection is cancelled.
+func (m *Manager) SetEnabled(deviceAddress bluetooth.MacAddress, enable bool) {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.enabled[deviceAddress] = enable
+	if !enable {
+		m.cancelPending(deviceAddress)
+	}
+}
+
+// Hold stops the device from being reconnected automatically, and cancels any pending
+// reconnection. This should be called when the device is disconnected on purpose.
+// It returns whether the device was not held before, so that the device can be
+// released again if it cannot be disconnected.
+func (m *Manager) Hold(deviceAddress bluetooth.MacAddress) bool {
+	if m == nil {
+		return false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, held := m.held[deviceAddress]
+
+	m.held[deviceAddress] = struct{}{}
+	m.cancelPending(deviceAddress)
+
+	return !held
+}
+
+// Release allows the device to be reconnected automatically again, after it was held.
+// This should be called when the device is connected on purpose.
+func (m *Manager) Release(deviceAddress bluetooth.MacAddress) {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.held, deviceAddress)
+}
+
+// adapterChanged reconnects the devices of an adapter when it is powered on.
+func (m *Manager) adapterChanged(ev bluetooth.Event[bluetooth.AdapterEventData]) {
+	address := ev.Data.Address
+
+	m.mu.Lock()
+	if ev.Action == bluetooth.EventActionRemoved {
+		delete(m.powered, address)
+		m.mu.Unlock()
+
+		return
+	}
+
+	wasPowered := m.powered[address]
+	m.powered[address] = ev.Data.Powered
+	m.mu.Unlock()
+
+	if ev.Data.Powered && !wasPowered {
+		m.reconnectAdapter(address)
+	}
+}
+
+// deviceChanged reconnects a device when it is disconnected unexpectedly, or when it is found again.
+func (m *Manager) deviceChanged(ev bluetooth.Event[bluetooth.DeviceEventData]) {
+	device := ev.Data
+
+	m.mu.Lock()
+	if ev.Action == bluetooth.EventActionRemoved {
+		delete(m.connected, device.Address)
+		delete(m.seen, device.Address)
+		delete(m.held, device.Address)
+		m.cancelPending(device.Address)
+		m.mu.Unlock()
+
+		return
+	}
+
+	wasConnected, wasSeen := m.connected[device.Address], m.seen[device.Address]
+	m.connected[device.Address] = device.Connected
+	m.seen[device.Address] = device.RSSI != 0
+	m.mu.Unlock()
+
+	switch {
+	case device.Connected:
+		return
+
+	case ev.Action == bluetooth.EventActionAdded, wasConnected, device.RSSI != 0 && !wasSeen:
+		m.reconnect(device)
+	}
+}
+
+// reconnectAll reconnects the disconnected devices of all powered adapters.
+func (m *Manager) reconnectAll() {
+	for _, adapter := range m.session.Adapters() {
+		if adapter.Powered {
+			m.reconnectAdapter(adapter.Address)
+		}
+	}
+}
+
+// reconnectAdapter reconnects all disconnected devices of an adapter.
+func (m *Manager) reconnectAdapter(adapterAddress bluetooth.MacAddress) {
+	devices, err := m.session.Adapter(adapterAddress).Devices()
+	if err != nil {
+		return
+	}
+
+	for _, device := range devices {
+		if !device.Connected {
+			m.reconnect(device.DeviceEventData)
+		}
+	}
+}
+
+// reconnect starts reconnecting to the device in the background, if it is eligible,
+// and if it is not already being reconnected to. The progress of the reconnection
+// is published as connect attempt events by the session.
+func (m *Manager) reconnect(device bluetooth.DeviceEventData) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.ctx == nil || m.ctx.Err() != nil || !m.eligible(device) {
+		return
+	}
+
+	if _, ok := m.pending[device.Address]; ok {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(m.ctx)
+	m.pending[device.Address] = cancel
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		defer cancel()
+
+		_ = m.connect(ctx, device.Address, m.policy)
+
+		m.mu.Lock()
+		if ctx.Err() == nil {
+			delete(m.pending, device.Address)
+		}
+		m.mu.Unlock()
+	}()
+}
+
+// eligible returns whether the device should be reconnected automatically.
+func (m *Manager) eligible(device bluetooth.DeviceEventData) bool {
+	if !device.Paired || device.Blocked {
+		return false
+	}
+
+	if _, ok := m.held[device.Address]; ok {
+		return false
+	}
+
+	if enabled, ok := m.enabled[device.Address]; ok {
+		return enabled
+	}
+
+	if _, ok := m.devices[device.Address]; ok {
+		return true
+	}
+
+	return m.allTrusted && device.Trusted
+}
+
+// cancelPending cancels the pending reconnection of the device, if any.
+func (m *Manager) cancelPending(deviceAddress bluetooth.MacAddress) {
+	if cancel, ok := m.pending[deviceAddress]; ok {
+		cancel()
+		delete(m.pending, deviceAddress)
+	}
+}
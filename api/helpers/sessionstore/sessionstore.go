@@ -1,9 +1,16 @@
 package sessionstore
 
 import (
+	"bytes"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
+	"unicode"
 
 	"github.com/bluetuith-org/api-native/api/bluetooth"
 	"github.com/bluetuith-org/api-native/api/errorkinds"
@@ -22,6 +29,10 @@ type MergeDeviceDataFunc func(*bluetooth.DeviceData) error
 type SessionStore struct {
 	adapters *xsync.MapOf[bluetooth.MacAddress, bluetooth.AdapterData]
 	devices  *xsync.MapOf[bluetooth.MacAddress, bluetooth.DeviceData]
+	lastSeen *xsync.MapOf[bluetooth.MacAddress, time.Time]
+
+	defaultAdapter bluetooth.MacAddress
+	defaultMu      sync.Mutex
 
 	init    sync.WaitGroup
 	waiting atomic.Bool
@@ -32,6 +43,7 @@ func NewSessionStore() SessionStore {
 	return SessionStore{
 		adapters: xsync.NewMapOf[bluetooth.MacAddress, bluetooth.AdapterData](),
 		devices:  xsync.NewMapOf[bluetooth.MacAddress, bluetooth.DeviceData](),
+		lastSeen: xsync.NewMapOf[bluetooth.MacAddress, time.Time](),
 	}
 }
 
@@ -142,6 +154,77 @@ func (s *SessionStore) UpdateAdapter(
 	return adapter.AdapterEventData, nil
 }
 
+// DefaultAdapter returns the default adapter from the store. The preferred adapter,
+// which is either an adapter address or a unique name (for example, "hci0"), is chosen
+// if it exists. Otherwise, the first powered adapter is chosen, and if no adapters are
+// powered, the adapter with the lowest index (for example, "hci0" before "hci1") is chosen.
+func (s *SessionStore) DefaultAdapter(preferred string) (bluetooth.AdapterData, error) {
+	adapters := s.Adapters()
+	if len(adapters) == 0 {
+		return bluetooth.AdapterData{}, fmt.Errorf("get default: %w", errorkinds.ErrAdapterNotFound)
+	}
+
+	slices.SortFunc(adapters, func(a, b bluetooth.AdapterData) int {
+		return compareAdapterNames(a.UniqueName, b.UniqueName)
+	})
+
+	if preferred != "" {
+		address, err := bluetooth.ParseMAC(preferred)
+
+		for _, adapter := range adapters {
+			if (err == nil && adapter.Address == address) || adapter.UniqueName == preferred {
+				return adapter, nil
+			}
+		}
+	}
+
+	for _, adapter := range adapters {
+		if adapter.Powered {
+			return adapter, nil
+		}
+	}
+
+	return adapters[0], nil
+}
+
+// RefreshDefaultAdapter chooses the default adapter again (see DefaultAdapter), and
+// returns the default adapter event data and true if the default adapter has changed
+// since the last time it was chosen. This should be called each time adapters
+// are added or removed, or their powered state changes.
+func (s *SessionStore) RefreshDefaultAdapter(preferred string) (bluetooth.DefaultAdapterEventData, bool) {
+	var current bluetooth.DefaultAdapterEventData
+
+	if adapter, err := s.DefaultAdapter(preferred); err == nil {
+		current.Address, current.UniqueName = adapter.Address, adapter.UniqueName
+	}
+
+	s.defaultMu.Lock()
+	defer s.defaultMu.Unlock()
+
+	if current.Address == s.defaultAdapter {
+		return current, false
+	}
+
+	current.Previous, s.defaultAdapter = s.defaultAdapter, current.Address
+
+	return current, true
+}
+
+// Devices returns a list of all devices from the store.
+func (s *SessionStore) Devices() []bluetooth.DeviceData {
+	s.init.Wait()
+
+	devices := make([]bluetooth.DeviceData, 0, s.devices.Size())
+
+	s.devices.Range(func(_ bluetooth.MacAddress, device bluetooth.DeviceData) bool {
+		devices = append(devices, device)
+
+		return true
+	})
+
+	return devices
+}
+
 // Device returns a device which matches the provided address.
 func (s *SessionStore) Device(deviceAddress bluetooth.MacAddress) (bluetooth.DeviceData, error) {
 	s.init.Wait()
@@ -155,6 +238,48 @@ func (s *SessionStore) Device(deviceAddress bluetooth.MacAddress) (bluetooth.Dev
 	return device, nil
 }
 
+// DeviceLastSeen returns the time at which the device was last seen, that is, the time
+// at which the device was last marked as seen (see MarkDeviceSeen), or at which its
+// signal strength or advertising data was last updated. If the device was not seen
+// since it was added to the store, false is returned.
+func (s *SessionStore) DeviceLastSeen(deviceAddress bluetooth.MacAddress) (time.Time, bool) {
+	return s.lastSeen.Load(deviceAddress)
+}
+
+// MarkDeviceSeen marks the device as seen at the current time. This should be called
+// when a device is added to the store because it was found, for example while discovering,
+// since adding a device to the store does not mark it as seen.
+func (s *SessionStore) MarkDeviceSeen(deviceAddress bluetooth.MacAddress) {
+	if _, ok := s.devices.Load(deviceAddress); ok {
+		s.lastSeen.Store(deviceAddress, time.Now())
+	}
+}
+
+// StaleDevices returns a list of devices associated with the specified adapter address,
+// which are not paired, bonded, trusted or connected, and which have not been seen
+// for the provided duration, or at all since they were added to the store.
+func (s *SessionStore) StaleDevices(adapterAddress bluetooth.MacAddress, olderThan time.Duration) ([]bluetooth.DeviceData, error) {
+	devices, err := s.AdapterDevices(adapterAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	stale := make([]bluetooth.DeviceData, 0, len(devices))
+	for _, device := range devices {
+		if device.Paired || device.Bonded || device.Trusted || device.Connected {
+			continue
+		}
+
+		if lastSeen, ok := s.lastSeen.Load(device.Address); ok && time.Since(lastSeen) < olderThan {
+			continue
+		}
+
+		stale = append(stale, device)
+	}
+
+	return stale, nil
+}
+
 // AddDevice adds a device to the store.
 func (s *SessionStore) AddDevice(device bluetooth.DeviceData) {
 	s.devices.Store(device.Address, device)
@@ -170,9 +295,12 @@ func (s *SessionStore) AddDevices(devices ...bluetooth.DeviceData) {
 // RemoveDevice removes a device from the store.
 func (s *SessionStore) RemoveDevice(deviceAddress bluetooth.MacAddress) {
 	s.devices.Delete(deviceAddress)
+	s.lastSeen.Delete(deviceAddress)
 }
 
-// UpdateDevice updates the properties of the device in the store.
+// UpdateDevice updates the properties of the device in the store. The device is marked
+// as seen if its signal strength or advertising data was updated, since those are only
+// updated when the device is in range.
 func (s *SessionStore) UpdateDevice(
 	deviceAddress bluetooth.MacAddress,
 	mergefn MergeDeviceDataFunc,
@@ -185,11 +313,56 @@ func (s *SessionStore) UpdateDevice(
 			fmt.Errorf("update %q: %w", deviceAddress.String(), errorkinds.ErrDeviceNotFound)
 	}
 
+	previous := device
 	if err := mergefn(&device); err != nil {
 		return bluetooth.DeviceEventData{}, err
 	}
 
 	s.devices.Store(deviceAddress, device)
+	if (device.RSSI != 0 && device.RSSI != previous.RSSI) ||
+		!maps.EqualFunc(device.ManufacturerData, previous.ManufacturerData, bytes.Equal) ||
+		!maps.EqualFunc(device.ServiceData, previous.ServiceData, bytes.Equal) {
+		s.lastSeen.Store(deviceAddress, time.Now())
+	}
 
 	return device.DeviceEventData, nil
 }
+
+// Clear removes all adapters and devices from the store.
+func (s *SessionStore) Clear() {
+	s.devices.Clear()
+	s.lastSeen.Clear()
+	s.adapters.Clear()
+
+	s.defaultMu.Lock()
+	s.defaultAdapter = bluetooth.MacAddress{}
+	s.defaultMu.Unlock()
+}
+
+// compareAdapterNames compares the unique names of two adapters by their index,
+// for example, "hci2" is ordered before "hci10". Names without an index are
+// ordered after the names with an index.
+func compareAdapterNames(a, b string) int {
+	indexOf := func(name string) (int, bool) {
+		index, err := strconv.Atoi(name[len(strings.TrimRightFunc(name, unicode.IsDigit)):])
+
+		return index, err == nil
+	}
+
+	ai, aok := indexOf(a)
+	bi, bok := indexOf(b)
+
+	switch {
+	case aok && bok && ai != bi:
+		return ai - bi
+
+	case aok != bok:
+		if aok {
+			return -1
+		}
+
+		return 1
+	}
+
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,147 @@
+package sessionstore
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+)
+
+// testAdapter returns the data of an adapter with the provided address, name and powered state.
+func testAdapter(t *testing.T, address, name string, powered bool) bluetooth.AdapterData {
+	t.Helper()
+
+	mac, err := bluetooth.ParseMAC(address)
+	if err != nil {
+		t.Fatalf("Cannot parse address %q: %v", address, err)
+	}
+
+	adapter := bluetooth.AdapterData{UniqueName: name}
+	adapter.Address, adapter.Powered = mac, powered
+
+	return adapter
+}
+
+func TestCompareAdapterNames(t *testing.T) {
+	names := []string{"hci10", "hci2", "hci1", "hci", "hci0", "usb"}
+	want := []string{"hci0", "hci1", "hci2", "hci10", "hci", "usb"}
+
+	slices.SortFunc(names, compareAdapterNames)
+	if !slices.Equal(names, want) {
+		t.Errorf("Sorted adapter names = %v, want %v", names, want)
+	}
+}
+
+func TestDefaultAdapter(t *testing.T) {
+	hci0 := testAdapter(t, "00:00:00:00:00:01", "hci0", false)
+	hci2 := testAdapter(t, "00:00:00:00:00:02", "hci2", true)
+	hci10 := testAdapter(t, "00:00:00:00:00:03", "hci10", true)
+	hci1 := testAdapter(t, "00:00:00:00:00:04", "hci1", false)
+
+	tests := []struct {
+		name      string
+		adapters  []bluetooth.AdapterData
+		preferred string
+		want      bluetooth.AdapterData
+	}{
+		{
+			name:      "PreferredName",
+			adapters:  []bluetooth.AdapterData{hci0, hci2, hci10},
+			preferred: "hci10",
+			want:      hci10,
+		},
+		{
+			name:      "PreferredAddress",
+			adapters:  []bluetooth.AdapterData{hci0, hci2, hci10},
+			preferred: hci0.Address.String(),
+			want:      hci0,
+		},
+		{
+			name:      "MissingPreferred",
+			adapters:  []bluetooth.AdapterData{hci0, hci10, hci2},
+			preferred: "hci5",
+			want:      hci2,
+		},
+		{
+			name:     "FirstPowered",
+			adapters: []bluetooth.AdapterData{hci10, hci0, hci2},
+			want:     hci2,
+		},
+		{
+			name:     "NonePowered",
+			adapters: []bluetooth.AdapterData{hci1, hci0},
+			want:     hci0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			store := NewSessionStore()
+			store.AddAdapters(test.adapters...)
+
+			adapter, err := store.DefaultAdapter(test.preferred)
+			if err != nil {
+				t.Fatalf("DefaultAdapter(%q) returned error: %v", test.preferred, err)
+			}
+
+			if adapter.Address != test.want.Address {
+				t.Errorf("DefaultAdapter(%q) = %s (%s), want %s (%s)", test.preferred,
+					adapter.UniqueName, adapter.Address, test.want.UniqueName, test.want.Address,
+				)
+			}
+		})
+	}
+
+	empty := NewSessionStore()
+	if _, err := empty.DefaultAdapter(""); err == nil {
+		t.Error("DefaultAdapter() of an empty store returned no error")
+	}
+}
+
+func TestRefreshDefaultAdapter(t *testing.T) {
+	hci0 := testAdapter(t, "00:00:00:00:00:01", "hci0", false)
+	hci1 := testAdapter(t, "00:00:00:00:00:02", "hci1", true)
+
+	store := NewSessionStore()
+
+	if ev, changed := store.RefreshDefaultAdapter(""); changed {
+		t.Errorf("RefreshDefaultAdapter() of an empty store = %+v, reported a change", ev)
+	}
+
+	store.AddAdapter(hci0)
+
+	ev, changed := store.RefreshDefaultAdapter("")
+	if !changed || ev.Address != hci0.Address || ev.UniqueName != "hci0" || !ev.Previous.IsNil() {
+		t.Errorf("RefreshDefaultAdapter() = %+v, %v, want a change to hci0", ev, changed)
+	}
+
+	if ev, changed := store.RefreshDefaultAdapter(""); changed {
+		t.Errorf("RefreshDefaultAdapter() without any changes = %+v, reported a change", ev)
+	}
+
+	// A powered adapter is preferred over an adapter with a lower index.
+	store.AddAdapter(hci1)
+
+	ev, changed = store.RefreshDefaultAdapter("")
+	if !changed || ev.Address != hci1.Address || ev.Previous != hci0.Address {
+		t.Errorf("RefreshDefaultAdapter() = %+v, %v, want a change from hci0 to hci1", ev, changed)
+	}
+
+	// The configured default adapter wins over the powered adapter.
+	ev, changed = store.RefreshDefaultAdapter("hci0")
+	if !changed || ev.Address != hci0.Address || ev.Previous != hci1.Address {
+		t.Errorf("RefreshDefaultAdapter(hci0) = %+v, %v, want a change from hci1 to hci0", ev, changed)
+	}
+
+	if ev, changed := store.RefreshDefaultAdapter("hci0"); changed {
+		t.Errorf("RefreshDefaultAdapter(hci0) without any changes = %+v, reported a change", ev)
+	}
+
+	store.RemoveAdapter(hci0.Address)
+	store.RemoveAdapter(hci1.Address)
+
+	ev, changed = store.RefreshDefaultAdapter("hci0")
+	if !changed || !ev.Address.IsNil() || ev.Previous != hci0.Address {
+		t.Errorf("RefreshDefaultAdapter() after removing all adapters = %+v, %v, want a change from hci0", ev, changed)
+	}
+}
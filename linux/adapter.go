@@ -4,6 +4,8 @@ package linux
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"path/filepath"
 
 	"github.com/Southclaws/fault"
@@ -12,6 +14,7 @@ import (
 	"github.com/Southclaws/fault/ftag"
 	bluetooth "github.com/bluetuith-org/api-native/api/bluetooth"
 	errorkinds "github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/api/helpers/discovery"
 	dbh "github.com/bluetuith-org/api-native/linux/internal/dbushelper"
 	"github.com/godbus/dbus/v5"
 )
@@ -20,10 +23,20 @@ import (
 type adapter struct {
 	b    *BluezSession
 	path dbus.ObjectPath
+	ctx  context.Context
 
 	Address bluetooth.MacAddress
 }
 
+// WithContext returns a copy of the adapter function call interface, whose
+// method calls are bound to the provided context.
+func (a *adapter) WithContext(ctx context.Context) bluetooth.Adapter {
+	adapter := *a
+	adapter.ctx = ctx
+
+	return &adapter
+}
+
 // StartDiscovery will put the adapter into "discovering" mode, which means
 // the bluetooth device will be able to discover other bluetooth devices
 // that are in pairing mode.
@@ -32,7 +45,7 @@ func (a *adapter) StartDiscovery() error {
 		return err
 	}
 
-	if err := a.callAdapter("StartDiscovery", 0).Store(); err != nil {
+	if err := a.b.scans.Hold(a.Address, a.startDiscovery); err != nil {
 		return fault.Wrap(err,
 			fctx.With(context.Background(),
 				"error_at", "adapter-start-discovery",
@@ -48,12 +61,14 @@ func (a *adapter) StartDiscovery() error {
 
 // StopDiscovery will stop the  "discovering" mode, which means the bluetooth device will
 // no longer be able to discover other bluetooth devices that are in pairing mode.
+// If scoped discovery sessions (see Discover()) are active, the adapter keeps
+// discovering until they have ended.
 func (a *adapter) StopDiscovery() error {
 	if _, err := a.check(); err != nil {
 		return err
 	}
 
-	if err := a.callAdapter("StopDiscovery", 0).Store(); err != nil {
+	if err := a.b.scans.Unhold(a.Address, a.stopDiscovery); err != nil {
 		return fault.Wrap(err,
 			fctx.With(context.Background(),
 				"error_at", "adapter-stop-discovery",
@@ -67,12 +82,116 @@ func (a *adapter) StopDiscovery() error {
 	return nil
 }
 
+// Discover starts a scoped discovery session, and returns a channel which receives
+// the devices that are found or updated during the discovery.
+func (a *adapter) Discover(ctx context.Context, opts bluetooth.DiscoveryOptions) (<-chan bluetooth.DiscoveryEvent, error) {
+	if _, err := a.check(); err != nil {
+		return nil, err
+	}
+
+	if opts.Filter != nil {
+		if err := a.SetDiscoveryFilter(*opts.Filter); err != nil {
+			return nil, err
+		}
+	}
+
+	var cancel context.CancelFunc
+	if opts.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	events := discovery.Watch(ctx, a.b.state.Emitter, a.Address, a.b.store.Device, a.b.store.DeviceLastSeen)
+
+	if err := a.b.scans.Acquire(a.Address, a.startDiscovery); err != nil {
+		cancel()
+
+		return nil, fault.Wrap(err,
+			fctx.With(context.Background(),
+				"error_at", "adapter-discover",
+				"address", a.Address.String(),
+			),
+			ftag.With(ftag.Internal),
+			fmsg.With("An error occurred while starting device discovery"),
+		)
+	}
+
+	stopper := *a
+	stopper.ctx = nil
+
+	context.AfterFunc(ctx, func() {
+		defer cancel()
+
+		if err := a.b.scans.Release(a.Address, stopper.stopDiscovery); err != nil {
+			a.b.state.PublishError(err,
+				"An error occurred while stopping device discovery",
+				"error_at", "adapter-discover-stop",
+				"address", a.Address.String(),
+			)
+		}
+	})
+
+	return events, nil
+}
+
+// SetDiscoveryFilter sets the filter which is applied to the devices that are found
+// during discovery. An empty filter clears the existing filter.
+func (a *adapter) SetDiscoveryFilter(filter bluetooth.DiscoveryFilter) error {
+	if _, err := a.check(); err != nil {
+		return err
+	}
+
+	if err := a.callAdapter("SetDiscoveryFilter", 0, discoveryFilterMap(filter)).Store(); err != nil {
+		return fault.Wrap(err,
+			fctx.With(context.Background(),
+				"error_at", "adapter-set-discovery-filter",
+				"address", a.Address.String(),
+			),
+			ftag.With(ftag.Internal),
+			fmsg.With("An error occurred while setting the discovery filter"),
+		)
+	}
+
+	return nil
+}
+
+// DiscoveryFilters returns the keys of the discovery filter parameters
+// that are supported by the Bluez daemon.
+func (a *adapter) DiscoveryFilters() ([]string, error) {
+	var filters []string
+
+	if _, err := a.check(); err != nil {
+		return nil, err
+	}
+
+	if err := a.callAdapter("GetDiscoveryFilters", 0).Store(&filters); err != nil {
+		return nil, fault.Wrap(err,
+			fctx.With(context.Background(),
+				"error_at", "adapter-get-discovery-filters",
+				"address", a.Address.String(),
+			),
+			ftag.With(ftag.Internal),
+			fmsg.With("An error occurred while fetching the discovery filters"),
+		)
+	}
+
+	return filters, nil
+}
+
 // SetPoweredState sets the powered state of the adapter.
 func (a *adapter) SetPoweredState(enable bool) error {
-	if _, err := a.check(); err != nil {
+	adapter, err := a.check()
+	if err != nil {
 		return err
 	}
 
+	if enable {
+		if err := a.checkBlocked(adapter, "adapter-setpowered-state"); err != nil {
+			return err
+		}
+	}
+
 	if err := a.setAdapterProperty("Powered", enable); err != nil {
 		return fault.Wrap(err,
 			fctx.With(context.Background(),
@@ -87,6 +206,45 @@ func (a *adapter) SetPoweredState(enable bool) error {
 	return nil
 }
 
+// UnblockAndPowerOn removes the soft-block (rfkill) on the adapter if it is
+// soft-blocked, and then powers on the adapter.
+func (a *adapter) UnblockAndPowerOn() error {
+	adapter, err := a.check()
+	if err != nil {
+		return err
+	}
+
+	if adapter.HardBlocked {
+		return a.checkBlocked(adapter, "adapter-unblock")
+	}
+
+	if adapter.SoftBlocked {
+		if err := a.b.rfkill.Unblock(a.callContext(), adapter.UniqueName); err != nil {
+			return fault.Wrap(err,
+				fctx.With(context.Background(),
+					"error_at", "adapter-unblock",
+					"address", a.Address.String(),
+				),
+				ftag.With(ftag.Internal),
+				fmsg.With("An error occurred while unblocking the adapter"),
+			)
+		}
+	}
+
+	if err := a.setAdapterProperty("Powered", true); err != nil {
+		return fault.Wrap(err,
+			fctx.With(context.Background(),
+				"error_at", "adapter-unblock-setpowered",
+				"address", a.Address.String(),
+			),
+			ftag.With(ftag.Internal),
+			fmsg.With("An error occurred on setting powered state"),
+		)
+	}
+
+	return nil
+}
+
 // SetDiscoverableState sets the discoverable state of the adapter.
 func (a *adapter) SetDiscoverableState(enable bool) error {
 	if _, err := a.check(); err != nil {
@@ -127,6 +285,68 @@ func (a *adapter) SetPairableState(enable bool) error {
 	return nil
 }
 
+// SetAlias sets the user-assigned name of the adapter.
+func (a *adapter) SetAlias(alias string) error {
+	if _, err := a.check(); err != nil {
+		return err
+	}
+
+	if err := a.setAdapterProperty("Alias", alias); err != nil {
+		return fault.Wrap(err,
+			fctx.With(context.Background(),
+				"error_at", "adapter-setalias",
+				"address", a.Address.String(),
+			),
+			ftag.With(ftag.Internal),
+			fmsg.With("An error occurred on setting the adapter alias"),
+		)
+	}
+
+	return nil
+}
+
+// SetDiscoverableTimeout sets the timeout (in seconds) after which the adapter
+// is no longer discoverable.
+func (a *adapter) SetDiscoverableTimeout(timeout uint32) error {
+	if _, err := a.check(); err != nil {
+		return err
+	}
+
+	if err := a.setAdapterProperty("DiscoverableTimeout", timeout); err != nil {
+		return fault.Wrap(err,
+			fctx.With(context.Background(),
+				"error_at", "adapter-setdiscoverable-timeout",
+				"address", a.Address.String(),
+			),
+			ftag.With(ftag.Internal),
+			fmsg.With("An error occurred on setting discoverable timeout"),
+		)
+	}
+
+	return nil
+}
+
+// SetPairableTimeout sets the timeout (in seconds) after which the adapter
+// is no longer pairable.
+func (a *adapter) SetPairableTimeout(timeout uint32) error {
+	if _, err := a.check(); err != nil {
+		return err
+	}
+
+	if err := a.setAdapterProperty("PairableTimeout", timeout); err != nil {
+		return fault.Wrap(err,
+			fctx.With(context.Background(),
+				"error_at", "adapter-setpairable-timeout",
+				"address", a.Address.String(),
+			),
+			ftag.With(ftag.Internal),
+			fmsg.With("An error occurred on setting pairable timeout"),
+		)
+	}
+
+	return nil
+}
+
 // Properties returns all the properties of the adapter.
 func (a *adapter) Properties() (bluetooth.AdapterData, error) {
 	return a.check()
@@ -154,14 +374,109 @@ func (a *adapter) Devices() ([]bluetooth.DeviceData, error) {
 	return devices, nil
 }
 
+// ConnectDevice connects to a device with the provided address, without discovering
+// the device first. If the device object already exists, the device is connected to directly.
+func (a *adapter) ConnectDevice(address bluetooth.MacAddress, addressType bluetooth.AddressType) error {
+	if _, err := a.check(); err != nil {
+		return err
+	}
+
+	props := map[string]dbus.Variant{
+		"Address": dbus.MakeVariant(address.String()),
+	}
+
+	switch addressType {
+	case bluetooth.AddressTypePublic, bluetooth.AddressTypeRandom:
+		props["AddressType"] = dbus.MakeVariant(string(addressType))
+	}
+
+	var devicePath dbus.ObjectPath
+
+	err := a.callAdapter("ConnectDevice", 0, props).Store(&devicePath)
+	switch {
+	case err == nil:
+		return nil
+
+	case dbh.IsError(err, dbh.BluezErrorAlreadyExists):
+		return (&device{b: a.b, ctx: a.callContext(), Address: address}).connect()
+	}
+
+	return fault.Wrap(err,
+		fctx.With(context.Background(),
+			"error_at", "adapter-connect-device",
+			"address", a.Address.String(),
+			"device_address", address.String(),
+		),
+		ftag.With(ftag.Internal),
+		fmsg.With("Cannot connect to device"),
+	)
+}
+
+// PruneDevices removes the stale devices of the adapter, which are devices that are
+// not paired, trusted or connected, and which have not been seen for the provided duration.
+func (a *adapter) PruneDevices(opts bluetooth.PruneOptions) (bluetooth.PruneResult, error) {
+	result := bluetooth.PruneResult{Address: a.Address, DryRun: opts.DryRun}
+
+	if _, err := a.check(); err != nil {
+		return result, err
+	}
+
+	devices, err := a.b.store.StaleDevices(a.Address, opts.OlderThan)
+	if err != nil {
+		return result, fault.Wrap(err,
+			fctx.With(context.Background(),
+				"error_at", "adapter-prune-devices",
+				"address", a.Address.String(),
+			),
+			ftag.With(ftag.Internal),
+			fmsg.With("Error while fetching stale adapter devices"),
+		)
+	}
+
+	if opts.DryRun {
+		result.Devices = devices
+
+		return result, nil
+	}
+
+	var errs []error
+
+	for _, device := range devices {
+		devicePath, ok := a.b.state.Paths.DbusPath(dbh.DbusPathDevice, device.Address)
+		if !ok {
+			continue
+		}
+
+		if err := a.callAdapter("RemoveDevice", 0, devicePath).Store(); err != nil {
+			errs = append(errs, fmt.Errorf("remove %q: %w", device.Address.String(), err))
+
+			continue
+		}
+
+		result.Devices = append(result.Devices, device)
+	}
+
+	bluetooth.PruneEvent().On(a.b.state.Emitter).Publish(result)
+
+	if len(errs) > 0 {
+		return result, fault.Wrap(errors.Join(errs...),
+			fctx.With(context.Background(),
+				"error_at", "adapter-prune-removedevice",
+				"address", a.Address.String(),
+			),
+			ftag.With(ftag.Internal),
+			fmsg.With("Cannot remove some of the stale devices"),
+		)
+	}
+
+	return result, nil
+}
+
 // check validates whether a valid DBus path is associated with the provided
 // adapter's address ((*Adapter).Address), and checks whether the adapter
 // properties are present within the global session store.
 func (a *adapter) check() (bluetooth.AdapterData, error) {
-	dbusPath, exists := dbh.PathConverter.DbusPath(dbh.DbusPathAdapter, a.Address)
-
-	switch {
-	case a.b == nil:
+	if a.b == nil || a.b.state == nil {
 		return bluetooth.AdapterData{}, fault.Wrap(errorkinds.ErrAdapterNotFound,
 			fctx.With(context.Background(),
 				"error_at", "adapter-check-bus",
@@ -170,8 +485,10 @@ func (a *adapter) check() (bluetooth.AdapterData, error) {
 			ftag.With(ftag.Internal),
 			fmsg.With("Error while fetching adapter data"),
 		)
+	}
 
-	case !exists:
+	dbusPath, exists := a.b.state.Paths.DbusPath(dbh.DbusPathAdapter, a.Address)
+	if !exists {
 		return bluetooth.AdapterData{}, fault.Wrap(errorkinds.ErrAdapterNotFound,
 			fctx.With(context.Background(),
 				"error_at", "adapter-check-path",
@@ -199,18 +516,64 @@ func (a *adapter) check() (bluetooth.AdapterData, error) {
 	return adapter, nil
 }
 
+// startDiscovery calls the "StartDiscovery" method of the adapter.
+func (a *adapter) startDiscovery() error {
+	return a.callAdapter("StartDiscovery", 0).Store()
+}
+
+// stopDiscovery calls the "StopDiscovery" method of the adapter.
+func (a *adapter) stopDiscovery() error {
+	return a.callAdapter("StopDiscovery", 0).Store()
+}
+
+// checkBlocked returns an error if the adapter is soft-blocked or hard-blocked.
+func (a *adapter) checkBlocked(adapter bluetooth.AdapterData, errorAt string) error {
+	switch {
+	case adapter.HardBlocked:
+		return fault.Wrap(errorkinds.ErrAdapterHardBlocked,
+			fctx.With(context.Background(),
+				"error_at", errorAt,
+				"address", a.Address.String(),
+			),
+			ftag.With(ftag.PermissionDenied),
+			fmsg.With("Adapter is blocked by a hardware switch (for example, an airplane mode switch)"),
+		)
+
+	case adapter.SoftBlocked:
+		return fault.Wrap(errorkinds.ErrAdapterSoftBlocked,
+			fctx.With(context.Background(),
+				"error_at", errorAt,
+				"address", a.Address.String(),
+			),
+			ftag.With(ftag.PermissionDenied),
+			fmsg.With("Adapter is blocked via rfkill (for example, by airplane mode)"),
+		)
+	}
+
+	return nil
+}
+
 // callAdapter is used to interact with the bluez Adapter dbus interface.
 // https://git.kernel.org/pub/scm/bluetooth/bluez.git/tree/doc/adapter-api.txt
 func (a *adapter) callAdapter(method string, flags dbus.Flags, args ...interface{}) *dbus.Call {
 	return a.b.systemBus.Object(dbh.BluezBusName, a.path).
-		Call(dbh.BluezAdapterIface+"."+method, flags, args...)
+		CallWithContext(a.callContext(), dbh.BluezAdapterIface+"."+method, flags, args...)
+}
+
+// callContext returns the context which the adapter's method calls are bound to.
+func (a *adapter) callContext() context.Context {
+	if a.ctx == nil {
+		return context.Background()
+	}
+
+	return a.ctx
 }
 
 // adapterProperties gathers all the properties for a bluetooth adapter.
 func (a *adapter) adapterProperties() (map[string]dbus.Variant, error) {
 	result := make(map[string]dbus.Variant)
 	if err := a.b.systemBus.Object(dbh.BluezBusName, a.path).
-		Call(dbh.DbusGetAllPropertiesIface, 0, dbh.BluezAdapterIface).
+		CallWithContext(a.callContext(), dbh.DbusGetAllPropertiesIface, 0, dbh.BluezAdapterIface).
 		Store(&result); err != nil {
 		return result, err
 	}
@@ -220,12 +583,48 @@ func (a *adapter) adapterProperties() (map[string]dbus.Variant, error) {
 
 // setAdapterProperty can be used to set certain properties for a bluetooth adapter.
 func (a *adapter) setAdapterProperty(key string, value interface{}) error {
-	return a.b.systemBus.Object(dbh.BluezBusName, a.path).Call(
-		dbh.DbusSetPropertiesIface, 0, dbh.BluezAdapterIface,
+	return a.b.systemBus.Object(dbh.BluezBusName, a.path).CallWithContext(
+		a.callContext(), dbh.DbusSetPropertiesIface, 0, dbh.BluezAdapterIface,
 		key, dbus.MakeVariant(value),
 	).Store()
 }
 
+// discoveryFilterMap converts a discovery filter to the dictionary
+// which is accepted by the "SetDiscoveryFilter" method.
+func discoveryFilterMap(filter bluetooth.DiscoveryFilter) map[string]dbus.Variant {
+	values := make(map[string]dbus.Variant)
+
+	if len(filter.UUIDs) > 0 {
+		values["UUIDs"] = dbus.MakeVariant(filter.UUIDs.Strings())
+	}
+
+	if filter.RSSI != nil {
+		values["RSSI"] = dbus.MakeVariant(*filter.RSSI)
+	}
+
+	if filter.Pathloss != nil {
+		values["Pathloss"] = dbus.MakeVariant(*filter.Pathloss)
+	}
+
+	if filter.Transport != "" {
+		values["Transport"] = dbus.MakeVariant(string(filter.Transport))
+	}
+
+	if filter.DuplicateData != nil {
+		values["DuplicateData"] = dbus.MakeVariant(*filter.DuplicateData)
+	}
+
+	if filter.Discoverable != nil {
+		values["Discoverable"] = dbus.MakeVariant(*filter.Discoverable)
+	}
+
+	if filter.Pattern != "" {
+		values["Pattern"] = dbus.MakeVariant(filter.Pattern)
+	}
+
+	return values
+}
+
 // convertAndStoreObjectseObjectseObjects converts a map of dbus objects to a common AdapterData structure.
 func (a *adapter) convertAndStoreObjects(values map[string]dbus.Variant) error {
 	/*
@@ -247,7 +646,7 @@ func (a *adapter) convertAndStoreObjects(values map[string]dbus.Variant) error {
 	*/
 	var adapter bluetooth.AdapterData
 
-	if err := dbh.DecodeVariantMap(values, &adapter, "Address"); err != nil {
+	if err := a.b.state.DecodeVariantMap(values, &adapter, "Address"); err != nil {
 		return fault.Wrap(err,
 			fctx.With(context.Background(),
 				"error_at", "adapter-map-decode",
@@ -258,8 +657,9 @@ func (a *adapter) convertAndStoreObjects(values map[string]dbus.Variant) error {
 		)
 	}
 
-	dbh.PathConverter.AddDbusPath(dbh.DbusPathAdapter, a.path, adapter.Address)
+	a.b.state.Paths.AddDbusPath(dbh.DbusPathAdapter, a.path, adapter.Address)
 	adapter.UniqueName = filepath.Base(string(a.path))
+	a.b.setBlockedState(&adapter)
 
 	a.b.store.AddAdapter(adapter)
 
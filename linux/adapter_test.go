@@ -0,0 +1,157 @@
+//go:build linux
+
+package linux
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/linux/bluezmock"
+	dbh "github.com/bluetuith-org/api-native/linux/internal/dbushelper"
+	"github.com/godbus/dbus/v5"
+)
+
+// waitDiscoveryEvent waits for the next discovery event.
+func waitDiscoveryEvent(t *testing.T, events <-chan bluetooth.DiscoveryEvent) bluetooth.DiscoveryEvent {
+	t.Helper()
+
+	select {
+	case ev, ok := <-events:
+		if !ok {
+			t.Fatal("Discovery events channel was closed")
+		}
+
+		return ev
+
+	case <-time.After(eventTimeout):
+		t.Fatal("Timed out waiting for discovery event")
+	}
+
+	return bluetooth.DiscoveryEvent{}
+}
+
+func TestDiscover(t *testing.T) {
+	session, mock := startTestSession(t, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := session.Adapter(mustParseMAC(t, testAdapterAddress)).Discover(ctx, bluetooth.DiscoveryOptions{})
+	if err != nil {
+		t.Fatalf("Discover() returned error: %v", err)
+	}
+
+	adapterPath := dbus.ObjectPath("/org/bluez/hci0")
+	devicePath := bluezmock.DevicePath(adapterPath, testDeviceAddress)
+	deviceAddress := mustParseMAC(t, testDeviceAddress)
+
+	sub := bluetooth.DeviceEvent().On(session.Events()).Subscribe()
+	defer sub.Unsubscribe()
+
+	// A known device whose properties change for other reasons is not found.
+	if err := mock.SetProperty(devicePath, dbh.BluezDeviceIface, "Connected", true); err != nil {
+		t.Fatalf("Cannot set property: %v", err)
+	}
+
+	waitEvent(t, sub, func(ev bluetooth.Event[bluetooth.DeviceEventData]) bool {
+		return ev.Data.Address == deviceAddress && ev.Data.Connected
+	})
+
+	if _, err := mock.AddDevice(adapterPath, "AA:BB:CC:DD:EE:02", map[string]interface{}{"RSSI": int16(-70)}); err != nil {
+		t.Fatalf("Cannot add device: %v", err)
+	}
+
+	ev := waitDiscoveryEvent(t, events)
+	if ev.Action != bluetooth.EventActionAdded || ev.Device.Address != mustParseMAC(t, "AA:BB:CC:DD:EE:02") {
+		t.Fatalf("Discovery event = %s %s, want added AA:BB:CC:DD:EE:02", ev.Action, ev.Device.Address)
+	}
+
+	// A known device is found once it is seen.
+	if err := mock.SetProperty(devicePath, dbh.BluezDeviceIface, "RSSI", int16(-40)); err != nil {
+		t.Fatalf("Cannot set property: %v", err)
+	}
+
+	ev = waitDiscoveryEvent(t, events)
+	if ev.Action != bluetooth.EventActionAdded || ev.Device.Address != deviceAddress || ev.Device.RSSI != -40 {
+		t.Fatalf("Discovery event = %s %s (RSSI %d), want added %s (RSSI -40)",
+			ev.Action, ev.Device.Address, ev.Device.RSSI, deviceAddress,
+		)
+	}
+
+	// Any later changes of a found device are sent as updates.
+	if err := mock.SetProperty(devicePath, dbh.BluezDeviceIface, "Connected", false); err != nil {
+		t.Fatalf("Cannot set property: %v", err)
+	}
+
+	ev = waitDiscoveryEvent(t, events)
+	if ev.Action != bluetooth.EventActionUpdated || ev.Device.Address != deviceAddress || ev.Device.Connected {
+		t.Fatalf("Discovery event = %s %s (connected: %v), want updated %s (connected: false)",
+			ev.Action, ev.Device.Address, ev.Device.Connected, deviceAddress,
+		)
+	}
+}
+
+func TestAdapterCheckWithoutSession(t *testing.T) {
+	for _, a := range []*adapter{{}, {b: &BluezSession{}}} {
+		if _, err := a.check(); !errors.Is(err, errorkinds.ErrAdapterNotFound) {
+			t.Errorf("check() without a started session returned %v, want %v", err, errorkinds.ErrAdapterNotFound)
+		}
+	}
+}
+
+func TestDefaultAdapterEvent(t *testing.T) {
+	session, mock := startTestSession(t, nil)
+
+	hci0Path := dbus.ObjectPath("/org/bluez/hci0")
+	hci0Address := mustParseMAC(t, testAdapterAddress)
+	hci10Address := mustParseMAC(t, "00:11:22:33:44:66")
+
+	adapterSub := bluetooth.AdapterEvent().On(session.Events()).Subscribe()
+	defer adapterSub.Unsubscribe()
+
+	defaultSub := bluetooth.DefaultAdapterEvent().On(session.Events()).Subscribe()
+	defer defaultSub.Unsubscribe()
+
+	// setPowered sets the powered state of an adapter, and waits for it to be updated.
+	setPowered := func(path dbus.ObjectPath, address bluetooth.MacAddress, powered bool) {
+		t.Helper()
+
+		if err := mock.SetProperty(path, dbh.BluezAdapterIface, "Powered", powered); err != nil {
+			t.Fatalf("Cannot set property: %v", err)
+		}
+
+		waitEvent(t, adapterSub, func(ev bluetooth.Event[bluetooth.AdapterEventData]) bool {
+			return ev.Action == bluetooth.EventActionUpdated && ev.Data.Address == address && ev.Data.Powered == powered
+		})
+	}
+
+	// None of these changes select another default adapter, since hci0 is either
+	// the first powered adapter, or the adapter with the lowest index.
+	hci10Path, err := mock.AddAdapter("hci10", hci10Address.String(), map[string]interface{}{"Powered": true})
+	if err != nil {
+		t.Fatalf("Cannot add adapter: %v", err)
+	}
+
+	waitEvent(t, adapterSub, func(ev bluetooth.Event[bluetooth.AdapterEventData]) bool {
+		return ev.Action == bluetooth.EventActionAdded && ev.Data.Address == hci10Address
+	})
+
+	setPowered(hci10Path, hci10Address, false)
+	setPowered(hci0Path, hci0Address, false)
+
+	setPowered(hci10Path, hci10Address, true)
+
+	// The first default adapter event must be the one which selects hci10.
+	ev := waitEvent(t, defaultSub, func(bluetooth.Event[bluetooth.DefaultAdapterEventData]) bool { return true })
+	if ev.Data.Address != hci10Address || ev.Data.UniqueName != "hci10" || ev.Data.Previous != hci0Address {
+		t.Errorf("Default adapter event = %+v, want a change from hci0 to hci10", ev.Data)
+	}
+
+	if adapter, err := session.DefaultAdapter(); err != nil || adapter.Address != hci10Address {
+		t.Errorf("DefaultAdapter() = %s, %v, want hci10", adapter.Address, err)
+	}
+}
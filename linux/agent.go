@@ -4,9 +4,12 @@ package linux
 
 import (
 	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	bluetooth "github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/config"
 	dbh "github.com/bluetuith-org/api-native/linux/internal/dbushelper"
 	"github.com/godbus/dbus/v5"
 	"github.com/godbus/dbus/v5/introspect"
@@ -19,12 +22,16 @@ import (
 // Any errors are published to the global error event stream.
 type agent struct {
 	systemBus *dbus.Conn
+	state     *dbh.SessionState
+	path      dbus.ObjectPath
 
 	authHandler bluetooth.SessionAuthorizer
 	authTimeout time.Duration
-	ctx         bluetooth.AuthTimeout
+	ctx         *bluetooth.AuthTimeout
 
 	initialized bool
+
+	mu sync.Mutex
 }
 
 const (
@@ -32,8 +39,6 @@ const (
 	agentPassKey uint32 = 1024
 )
 
-var bluezAgent agent
-
 // RequestPinCode returns a predefined pincode to the agent's pincode request.
 func (b *agent) RequestPinCode(_ dbus.ObjectPath) (string, *dbus.Error) {
 	return agentPinCode, nil
@@ -50,9 +55,9 @@ func (b *agent) DisplayPinCode(devicePath dbus.ObjectPath, pincode string) *dbus
 		return nil
 	}
 
-	address, ok := dbh.PathConverter.Address(dbh.DbusPathDevice, devicePath)
+	address, ok := b.state.Paths.Address(dbh.DbusPathDevice, devicePath)
 	if !ok {
-		dbh.PublishError(errors.New(string(devicePath)),
+		b.state.PublishError(errors.New(string(devicePath)),
 			"Bluez agent error: Device not found",
 			"error_at", "displaypin-device-address",
 		)
@@ -60,11 +65,11 @@ func (b *agent) DisplayPinCode(devicePath dbus.ObjectPath, pincode string) *dbus
 		return dbus.MakeFailedError(errors.New("address not found"))
 	}
 
-	b.ctx = bluetooth.NewAuthTimeout(b.authTimeout)
+	timeout := b.newAuthTimeout()
 	defer b.Cancel()
 
-	if err := b.authHandler.DisplayPinCode(b.ctx, address, pincode); err != nil {
-		dbh.PublishError(err,
+	if err := b.authHandler.DisplayPinCode(timeout, address, pincode); err != nil {
+		b.state.PublishError(err,
 			"Bluez agent error: Authorization callback returned an error",
 			"error_at", "displaypin-device-address",
 		)
@@ -81,9 +86,9 @@ func (b *agent) DisplayPasskey(devicePath dbus.ObjectPath, passkey uint32, enter
 		return nil
 	}
 
-	address, ok := dbh.PathConverter.Address(dbh.DbusPathDevice, devicePath)
+	address, ok := b.state.Paths.Address(dbh.DbusPathDevice, devicePath)
 	if !ok {
-		dbh.PublishError(errors.New(string(devicePath)),
+		b.state.PublishError(errors.New(string(devicePath)),
 			"Bluez agent error: Device not found",
 			"error_at", "displaypk-device-address",
 		)
@@ -91,11 +96,11 @@ func (b *agent) DisplayPasskey(devicePath dbus.ObjectPath, passkey uint32, enter
 		return dbus.MakeFailedError(errors.New("address not found"))
 	}
 
-	b.ctx = bluetooth.NewAuthTimeout(b.authTimeout)
+	timeout := b.newAuthTimeout()
 	defer b.Cancel()
 
-	if err := b.authHandler.DisplayPasskey(b.ctx, address, passkey, entered); err != nil {
-		dbh.PublishError(err,
+	if err := b.authHandler.DisplayPasskey(timeout, address, passkey, entered); err != nil {
+		b.state.PublishError(err,
 			"Bluez agent error: Authorization callback returned an error",
 			"error_at", "displaypk-device-address",
 		)
@@ -112,9 +117,9 @@ func (b *agent) RequestConfirmation(devicePath dbus.ObjectPath, passkey uint32)
 		return nil
 	}
 
-	address, ok := dbh.PathConverter.Address(dbh.DbusPathDevice, devicePath)
+	address, ok := b.state.Paths.Address(dbh.DbusPathDevice, devicePath)
 	if !ok {
-		dbh.PublishError(errors.New(string(devicePath)),
+		b.state.PublishError(errors.New(string(devicePath)),
 			"Bluez agent error: Device not found",
 			"error_at", "authpk-device-address",
 		)
@@ -122,11 +127,11 @@ func (b *agent) RequestConfirmation(devicePath dbus.ObjectPath, passkey uint32)
 		return dbus.MakeFailedError(errors.New("address not found"))
 	}
 
-	b.ctx = bluetooth.NewAuthTimeout(b.authTimeout)
+	timeout := b.newAuthTimeout()
 	defer b.Cancel()
 
-	if err := b.authHandler.ConfirmPasskey(b.ctx, address, passkey); err != nil {
-		dbh.PublishError(err,
+	if err := b.authHandler.ConfirmPasskey(timeout, address, passkey); err != nil {
+		b.state.PublishError(err,
 			"Bluez agent error: Authorization callback returned an error",
 			"error_at", "authpk-device-address",
 		)
@@ -143,9 +148,9 @@ func (b *agent) RequestAuthorization(devicePath dbus.ObjectPath) *dbus.Error {
 		return nil
 	}
 
-	address, ok := dbh.PathConverter.Address(dbh.DbusPathDevice, devicePath)
+	address, ok := b.state.Paths.Address(dbh.DbusPathDevice, devicePath)
 	if !ok {
-		dbh.PublishError(errors.New(string(devicePath)),
+		b.state.PublishError(errors.New(string(devicePath)),
 			"Bluez agent error: Device not found",
 			"error_at", "authpairing-device-address",
 		)
@@ -153,11 +158,11 @@ func (b *agent) RequestAuthorization(devicePath dbus.ObjectPath) *dbus.Error {
 		return dbus.MakeFailedError(errors.New("address not found"))
 	}
 
-	b.ctx = bluetooth.NewAuthTimeout(b.authTimeout)
+	timeout := b.newAuthTimeout()
 	defer b.Cancel()
 
-	if err := b.authHandler.AuthorizePairing(b.ctx, address); err != nil {
-		dbh.PublishError(err,
+	if err := b.authHandler.AuthorizePairing(timeout, address); err != nil {
+		b.state.PublishError(err,
 			"Bluez agent error: Authorization callback returned an error",
 			"error_at", "authpairing-device-address",
 		)
@@ -174,9 +179,9 @@ func (b *agent) AuthorizeService(devicePath dbus.ObjectPath, uuidstr string) *db
 		return nil
 	}
 
-	address, ok := dbh.PathConverter.Address(dbh.DbusPathDevice, devicePath)
+	address, ok := b.state.Paths.Address(dbh.DbusPathDevice, devicePath)
 	if !ok {
-		dbh.PublishError(errors.New(string(devicePath)),
+		b.state.PublishError(errors.New(string(devicePath)),
 			"Bluez agent error: Device not found",
 			"error_at", "authservice-device-address",
 		)
@@ -185,11 +190,11 @@ func (b *agent) AuthorizeService(devicePath dbus.ObjectPath, uuidstr string) *db
 	}
 
 	u, _ := uuid.Parse(uuidstr)
-	b.ctx = bluetooth.NewAuthTimeout(b.authTimeout)
+	timeout := b.newAuthTimeout()
 	defer b.Cancel()
 
-	if err := b.authHandler.AuthorizeService(b.ctx, address, u); err != nil {
-		dbh.PublishError(err,
+	if err := b.authHandler.AuthorizeService(timeout, address, u); err != nil {
+		b.state.PublishError(err,
 			"Bluez agent error: Authorization callback returned an error",
 			"error_at", "authservice-device-address",
 		)
@@ -202,7 +207,13 @@ func (b *agent) AuthorizeService(devicePath dbus.ObjectPath, uuidstr string) *db
 
 // Cancel is called when the Bluez agent request was cancelled.
 func (b *agent) Cancel() *dbus.Error {
-	b.Cancel()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.ctx != nil {
+		b.ctx.Cancel()
+		b.ctx = nil
+	}
 
 	return nil
 }
@@ -212,22 +223,63 @@ func (b *agent) Release() *dbus.Error {
 	return nil
 }
 
+// newAuthTimeout creates a new authentication timeout for an agent request.
+// The timeout is cancelled when the request is cancelled by the Agent Manager.
+func (b *agent) newAuthTimeout() bluetooth.AuthTimeout {
+	timeout := bluetooth.NewAuthTimeout(b.authTimeout)
+
+	b.mu.Lock()
+	b.ctx = &timeout
+	b.mu.Unlock()
+
+	return timeout
+}
+
 // setupAgent creates a new BluezAgent, exports all its methods
-// to the bluez DBus interface, and registers the agent.
-func setupAgent(systemBus *dbus.Conn, authHandler bluetooth.SessionAuthorizer, authTimeout time.Duration) error {
+// to the bluez DBus interface, and registers the agent according to
+// the agent options in the provided configuration. If the agent mode
+// is set to 'AgentModeDisabled', no agent is registered.
+func setupAgent(
+	systemBus *dbus.Conn, state *dbh.SessionState,
+	authHandler bluetooth.SessionAuthorizer, cfg config.Configuration,
+) (*agent, error) {
+	if cfg.AgentMode == config.AgentModeDisabled {
+		return nil, nil
+	}
+
 	if authHandler == nil {
-		return errors.New("No authorization handler interface specified")
+		return nil, errors.New("No authorization handler interface specified")
 	}
 
-	ag := agent{
+	capability := cfg.AgentCapability
+	if capability == "" {
+		capability = config.AgentKeyboardDisplay
+	}
+
+	if !capability.Valid() {
+		return nil, fmt.Errorf("Invalid agent capability '%s'", capability)
+	}
+
+	path := dbh.BluezAgentPath
+	if cfg.AgentPath != "" {
+		path = dbus.ObjectPath(cfg.AgentPath)
+		if !path.IsValid() {
+			return nil, fmt.Errorf("Invalid agent path '%s'", cfg.AgentPath)
+		}
+	}
+
+	ag := &agent{
 		systemBus:   systemBus,
+		state:       state,
+		path:        path,
 		authHandler: authHandler,
+		authTimeout: cfg.AuthTimeout,
 		initialized: true,
 	}
 
-	err := systemBus.Export(ag, dbh.BluezAgentPath, dbh.BluezAgentIface)
+	err := systemBus.Export(ag, path, dbh.BluezAgentIface)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	node := &introspect.Node{
@@ -240,32 +292,39 @@ func setupAgent(systemBus *dbus.Conn, authHandler bluetooth.SessionAuthorizer, a
 		},
 	}
 
-	if err := systemBus.Export(introspect.NewIntrospectable(node), dbh.BluezAgentPath, dbh.DbusIntrospectableIface); err != nil {
-		return err
+	if err := systemBus.Export(introspect.NewIntrospectable(node), path, dbh.DbusIntrospectableIface); err != nil {
+		return nil, err
 	}
 
-	if err := ag.callAgentManager("RegisterAgent", dbh.BluezAgentPath, "KeyboardDisplay").Store(); err != nil {
-		return err
+	if err := ag.callAgentManager("RegisterAgent", path, string(capability)).Store(); err != nil {
+		return nil, err
 	}
 
-	if err := ag.callAgentManager("RequestDefaultAgent", dbh.BluezAgentPath).Store(); err != nil {
-		return err
+	if cfg.AgentMode != config.AgentModeDefault {
+		return ag, nil
 	}
 
-	ag.authTimeout = authTimeout
+	if err := ag.callAgentManager("RequestDefaultAgent", path).Store(); err != nil {
+		_ = ag.remove()
 
-	bluezAgent = ag
+		return nil, err
+	}
 
-	return nil
+	return ag, nil
 }
 
-// removeAgent removes the agent.
-func removeAgent() error {
-	if !bluezAgent.initialized {
+// remove unregisters the agent.
+func (b *agent) remove() error {
+	if b == nil || !b.initialized {
 		return nil
 	}
 
-	return bluezAgent.callAgentManager("UnregisterAgent", dbh.BluezAgentPath).Store()
+	defer func() {
+		_ = b.systemBus.Export(nil, b.path, dbh.BluezAgentIface)
+		_ = b.systemBus.Export(nil, b.path, dbh.DbusIntrospectableIface)
+	}()
+
+	return b.callAgentManager("UnregisterAgent", b.path).Store()
 }
 
 // callAgentManager calls the AgentManager1 interface with the provided arguments.
@@ -0,0 +1,86 @@
+//go:build linux
+
+package linux
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/linux/bluezmock"
+	"github.com/godbus/dbus/v5"
+)
+
+// testAuthorizer describes an authorization handler which records
+// the passkey confirmation requests, and replies with an error.
+type testAuthorizer struct {
+	bluetooth.DefaultAuthorizer
+
+	err error
+
+	address bluetooth.MacAddress
+	passkey uint32
+	mu      sync.Mutex
+}
+
+// ConfirmPasskey records the passkey confirmation request.
+func (a *testAuthorizer) ConfirmPasskey(_ bluetooth.AuthTimeout, address bluetooth.MacAddress, passkey uint32) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.address, a.passkey = address, passkey
+
+	return a.err
+}
+
+func TestAgentRequestConfirmation(t *testing.T) {
+	devicePath := bluezmock.DevicePath("/org/bluez/hci0", testDeviceAddress)
+
+	tests := []struct {
+		name    string
+		err     error
+		wantErr bool
+	}{
+		{name: "Accepted"},
+		{name: "Rejected", err: errors.New("rejected"), wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			authorizer := &testAuthorizer{err: test.err}
+			_, mock := startTestSession(t, authorizer)
+
+			err := mock.CallAgent("RequestConfirmation", devicePath, uint32(123456)).Err
+			if (err != nil) != test.wantErr {
+				t.Fatalf("RequestConfirmation returned error %v, want error: %v", err, test.wantErr)
+			}
+
+			authorizer.mu.Lock()
+			defer authorizer.mu.Unlock()
+
+			if authorizer.address != mustParseMAC(t, testDeviceAddress) || authorizer.passkey != 123456 {
+				t.Errorf("ConfirmPasskey was called with %s and %d, want %s and 123456",
+					authorizer.address, authorizer.passkey, testDeviceAddress,
+				)
+			}
+		})
+	}
+}
+
+func TestAgentUnknownDevice(t *testing.T) {
+	authorizer := &testAuthorizer{}
+	_, mock := startTestSession(t, authorizer)
+
+	devicePath := dbus.ObjectPath("/org/bluez/hci0/dev_AA_BB_CC_DD_EE_FF")
+	if err := mock.CallAgent("RequestConfirmation", devicePath, uint32(123456)).Err; err == nil {
+		t.Error("RequestConfirmation for an unknown device returned no error")
+	}
+
+	authorizer.mu.Lock()
+	defer authorizer.mu.Unlock()
+
+	if authorizer.passkey != 0 {
+		t.Error("ConfirmPasskey was called for an unknown device")
+	}
+}
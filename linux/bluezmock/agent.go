@@ -0,0 +1,92 @@
+//go:build linux
+
+package bluezmock
+
+import (
+	"errors"
+
+	dbh "github.com/bluetuith-org/api-native/linux/internal/dbushelper"
+	"github.com/godbus/dbus/v5"
+)
+
+// AgentRegistration holds the details of the agent registered with the agent manager.
+type AgentRegistration struct {
+	// Sender holds the unique bus name of the connection which registered the agent.
+	Sender string
+
+	// Path holds the object path of the agent.
+	Path dbus.ObjectPath
+
+	// Capability holds the IO capability of the agent.
+	Capability string
+
+	// Default indicates whether the agent was requested to be the default agent.
+	Default bool
+}
+
+// Agent returns the currently registered agent.
+func (b *Bluez) Agent() (AgentRegistration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.agent, b.agent.Path != ""
+}
+
+// CallAgent calls a method of the registered agent's "org.bluez.Agent1" interface.
+// For example, CallAgent("RequestConfirmation", devicePath, passkey) can be used to
+// request a passkey confirmation from the agent.
+func (b *Bluez) CallAgent(method string, args ...interface{}) *dbus.Call {
+	agent, ok := b.Agent()
+	if !ok {
+		return &dbus.Call{Err: errors.New("no agent is registered")}
+	}
+
+	return b.conn.Object(agent.Sender, agent.Path).
+		Call(dbh.BluezAgentIface+"."+method, 0, args...)
+}
+
+// exportAgentManager exports the AgentManager1 interface.
+func (b *Bluez) exportAgentManager() error {
+	return b.conn.ExportMethodTable(map[string]interface{}{
+		"RegisterAgent": func(sender dbus.Sender, path dbus.ObjectPath, capability string) *dbus.Error {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			if b.agent.Path != "" && b.agent.Sender == string(sender) {
+				return NewError(ErrorAlreadyExists, "Already Exists")
+			}
+
+			b.agent = AgentRegistration{
+				Sender:     string(sender),
+				Path:       path,
+				Capability: capability,
+			}
+
+			return nil
+		},
+		"UnregisterAgent": func(sender dbus.Sender, path dbus.ObjectPath) *dbus.Error {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			if b.agent.Path != path || b.agent.Sender != string(sender) {
+				return NewError(ErrorDoesNotExist, "Does Not Exist")
+			}
+
+			b.agent = AgentRegistration{}
+
+			return nil
+		},
+		"RequestDefaultAgent": func(sender dbus.Sender, path dbus.ObjectPath) *dbus.Error {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			if b.agent.Path != path || b.agent.Sender != string(sender) {
+				return NewError(ErrorDoesNotExist, "Does Not Exist")
+			}
+
+			b.agent.Default = true
+
+			return nil
+		},
+	}, dbh.BluezAgentManagerPath, dbh.BluezAgentManagerIface)
+}
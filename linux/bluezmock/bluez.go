@@ -0,0 +1,385 @@
+//go:build linux
+
+package bluezmock
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	dbh "github.com/bluetuith-org/api-native/linux/internal/dbushelper"
+	"github.com/godbus/dbus/v5"
+)
+
+// The Bluez specific error names.
+const (
+	ErrorFailed            = "org.bluez.Error.Failed"
+	ErrorInProgress        = "org.bluez.Error.InProgress"
+	ErrorDoesNotExist      = "org.bluez.Error.DoesNotExist"
+	ErrorInvalidArguments  = "org.bluez.Error.InvalidArguments"
+	ErrorNotReady          = "org.bluez.Error.NotReady"
+	ErrorAlreadyExists     = "org.bluez.Error.AlreadyExists"
+	ErrorAuthFailed        = "org.bluez.Error.AuthenticationFailed"
+	ErrorAuthRejected      = "org.bluez.Error.AuthenticationRejected"
+	ErrorAuthCanceled      = "org.bluez.Error.AuthenticationCanceled"
+	ErrorNotSupported      = "org.bluez.Error.NotSupported"
+	ErrorNotConnected      = "org.bluez.Error.NotConnected"
+	ErrorAlreadyConnected  = "org.bluez.Error.AlreadyConnected"
+	ErrorNotAuthorized     = "org.bluez.Error.NotAuthorized"
+	ErrorNotPermitted      = "org.bluez.Error.NotPermitted"
+	ErrorNotAvailable      = "org.bluez.Error.NotAvailable"
+	ErrorConnectionAttempt = "org.bluez.Error.ConnectionAttemptFailed"
+)
+
+const (
+	dbusPropertiesIface    = "org.freedesktop.DBus.Properties"
+	dbusObjectManagerIface = "org.freedesktop.DBus.ObjectManager"
+)
+
+// MethodHandler describes a function which handles a method call on an exported object.
+// The path is the object path the method was called on, and args are the
+// arguments of the method call.
+type MethodHandler func(path dbus.ObjectPath, args ...interface{}) *dbus.Error
+
+// Bluez describes a fake Bluez DBus service.
+type Bluez struct {
+	conn *dbus.Conn
+
+	objects  map[dbus.ObjectPath]*object
+	handlers map[string]MethodHandler
+	filters  map[dbus.ObjectPath]map[string]dbus.Variant
+	agent    AgentRegistration
+
+	mu sync.Mutex
+}
+
+// object holds the interfaces and properties of an exported object.
+type object struct {
+	interfaces map[string]map[string]dbus.Variant
+}
+
+// New connects to the DBus daemon at the provided address, acquires the
+// "org.bluez" bus name and exports the object and agent managers.
+func New(address string) (*Bluez, error) {
+	conn, err := dbus.Connect(address)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := conn.RequestName(dbh.BluezBusName, dbus.NameFlagDoNotQueue)
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	if reply != dbus.RequestNameReplyPrimaryOwner {
+		_ = conn.Close()
+
+		return nil, errors.New("bus name " + dbh.BluezBusName + " is already owned")
+	}
+
+	b := &Bluez{
+		conn:     conn,
+		objects:  make(map[dbus.ObjectPath]*object),
+		handlers: make(map[string]MethodHandler),
+		filters:  make(map[dbus.ObjectPath]map[string]dbus.Variant),
+	}
+
+	b.setDefaultHandlers()
+
+	if err := conn.ExportMethodTable(map[string]interface{}{
+		"GetManagedObjects": b.managedObjects,
+	}, "/", dbusObjectManagerIface); err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	if err := b.exportAgentManager(); err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// Close releases the "org.bluez" bus name and closes the connection.
+func (b *Bluez) Close() error {
+	_, _ = b.conn.ReleaseName(dbh.BluezBusName)
+
+	return b.conn.Close()
+}
+
+// HandleMethod overrides the default handler for a method of an interface.
+// For example, HandleMethod(dbh.BluezDeviceIface, "Pair", fn) can be used
+// to script a pairing failure.
+func (b *Bluez) HandleMethod(iface, method string, fn MethodHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[iface+"."+method] = fn
+}
+
+// AddInterface adds an interface with the provided properties to an object,
+// and emits an InterfacesAdded signal. The object is created if it does not exist.
+func (b *Bluez) AddInterface(path dbus.ObjectPath, iface string, props map[string]interface{}) error {
+	b.mu.Lock()
+
+	obj, ok := b.objects[path]
+	if !ok {
+		obj = &object{interfaces: make(map[string]map[string]dbus.Variant)}
+		b.objects[path] = obj
+
+		if err := b.exportProperties(path); err != nil {
+			delete(b.objects, path)
+			b.mu.Unlock()
+
+			return err
+		}
+	}
+
+	variants := make(map[string]dbus.Variant, len(props))
+	for name, value := range props {
+		variants[name] = dbus.MakeVariant(value)
+	}
+
+	obj.interfaces[iface] = variants
+	b.mu.Unlock()
+
+	if err := b.exportMethods(path, iface); err != nil {
+		return err
+	}
+
+	return b.conn.Emit("/", dbh.DbusSignalInterfacesAddedIface,
+		path, map[string]map[string]dbus.Variant{iface: copyVariants(variants)},
+	)
+}
+
+// RemoveInterface removes an interface from an object, and emits an
+// InterfacesRemoved signal. The object is removed if it has no interfaces left.
+func (b *Bluez) RemoveInterface(path dbus.ObjectPath, iface string) error {
+	b.mu.Lock()
+
+	obj, ok := b.objects[path]
+	if !ok {
+		b.mu.Unlock()
+
+		return errors.New("object " + string(path) + " does not exist")
+	}
+
+	delete(obj.interfaces, iface)
+	_ = b.conn.Export(nil, path, iface)
+
+	if len(obj.interfaces) == 0 {
+		delete(b.objects, path)
+		_ = b.conn.Export(nil, path, dbusPropertiesIface)
+	}
+
+	b.mu.Unlock()
+
+	return b.conn.Emit("/", dbh.DbusSignalInterfacesRemovedIface, path, []string{iface})
+}
+
+// RemoveObject removes an object, along with all of its child objects,
+// and emits an InterfacesRemoved signal for each removed object.
+func (b *Bluez) RemoveObject(path dbus.ObjectPath) error {
+	b.mu.Lock()
+
+	paths := make([]dbus.ObjectPath, 0, len(b.objects))
+	for p := range b.objects {
+		if p == path || strings.HasPrefix(string(p), string(path)+"/") {
+			paths = append(paths, p)
+		}
+	}
+
+	if len(paths) == 0 {
+		b.mu.Unlock()
+
+		return errors.New("object " + string(path) + " does not exist")
+	}
+
+	// Remove the deepest objects first, so that devices are removed before their adapters.
+	sort.Slice(paths, func(i, j int) bool { return len(paths[i]) > len(paths[j]) })
+
+	removed := make(map[dbus.ObjectPath][]string, len(paths))
+	for _, p := range paths {
+		ifaces := make([]string, 0, len(b.objects[p].interfaces))
+		for iface := range b.objects[p].interfaces {
+			ifaces = append(ifaces, iface)
+			_ = b.conn.Export(nil, p, iface)
+		}
+
+		_ = b.conn.Export(nil, p, dbusPropertiesIface)
+		delete(b.objects, p)
+
+		removed[p] = ifaces
+	}
+
+	b.mu.Unlock()
+
+	for _, p := range paths {
+		if err := b.conn.Emit("/", dbh.DbusSignalInterfacesRemovedIface, p, removed[p]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Property returns the value of a property of an object's interface.
+func (b *Bluez) Property(path dbus.ObjectPath, iface, name string) (interface{}, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	obj, ok := b.objects[path]
+	if !ok {
+		return nil, false
+	}
+
+	value, ok := obj.interfaces[iface][name]
+	if !ok {
+		return nil, false
+	}
+
+	return value.Value(), true
+}
+
+// SetProperty sets the value of a property of an object's interface,
+// and emits a PropertiesChanged signal.
+func (b *Bluez) SetProperty(path dbus.ObjectPath, iface, name string, value interface{}) error {
+	return b.SetProperties(path, iface, map[string]interface{}{name: value})
+}
+
+// SetProperties sets multiple property values of an object's interface,
+// and emits a single PropertiesChanged signal.
+func (b *Bluez) SetProperties(path dbus.ObjectPath, iface string, props map[string]interface{}) error {
+	b.mu.Lock()
+
+	obj, ok := b.objects[path]
+	if !ok {
+		b.mu.Unlock()
+
+		return errors.New("object " + string(path) + " does not exist")
+	}
+
+	values, ok := obj.interfaces[iface]
+	if !ok {
+		b.mu.Unlock()
+
+		return errors.New("interface " + iface + " does not exist on object " + string(path))
+	}
+
+	changed := make(map[string]dbus.Variant, len(props))
+	for name, value := range props {
+		variant := dbus.MakeVariant(value)
+
+		values[name] = variant
+		changed[name] = variant
+	}
+
+	b.mu.Unlock()
+
+	return b.conn.Emit(path, dbh.DbusSignalPropertyChangedIface, iface, changed, []string{})
+}
+
+// Objects returns the paths of all exported objects which implement the provided interface.
+func (b *Bluez) Objects(iface string) []dbus.ObjectPath {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	paths := make([]dbus.ObjectPath, 0, len(b.objects))
+	for path, obj := range b.objects {
+		if _, ok := obj.interfaces[iface]; ok {
+			paths = append(paths, path)
+		}
+	}
+
+	sort.Slice(paths, func(i, j int) bool { return paths[i] < paths[j] })
+
+	return paths
+}
+
+// managedObjects is exported as the ObjectManager's GetManagedObjects method.
+func (b *Bluez) managedObjects() (map[dbus.ObjectPath]map[string]map[string]dbus.Variant, *dbus.Error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	objects := make(map[dbus.ObjectPath]map[string]map[string]dbus.Variant, len(b.objects))
+	for path, obj := range b.objects {
+		interfaces := make(map[string]map[string]dbus.Variant, len(obj.interfaces))
+		for iface, values := range obj.interfaces {
+			interfaces[iface] = copyVariants(values)
+		}
+
+		objects[path] = interfaces
+	}
+
+	return objects, nil
+}
+
+// exportProperties exports the Properties interface for an object.
+func (b *Bluez) exportProperties(path dbus.ObjectPath) error {
+	return b.conn.ExportMethodTable(map[string]interface{}{
+		"Get": func(iface, name string) (dbus.Variant, *dbus.Error) {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			obj, ok := b.objects[path]
+			if !ok {
+				return dbus.Variant{}, NewError(ErrorDoesNotExist, "No such object")
+			}
+
+			value, ok := obj.interfaces[iface][name]
+			if !ok {
+				return dbus.Variant{}, NewError(ErrorInvalidArguments, "No such property '"+name+"'")
+			}
+
+			return value, nil
+		},
+		"GetAll": func(iface string) (map[string]dbus.Variant, *dbus.Error) {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			obj, ok := b.objects[path]
+			if !ok {
+				return nil, NewError(ErrorDoesNotExist, "No such object")
+			}
+
+			values, ok := obj.interfaces[iface]
+			if !ok {
+				return nil, NewError(ErrorInvalidArguments, "No such interface '"+iface+"'")
+			}
+
+			return copyVariants(values), nil
+		},
+		"Set": func(iface, name string, value dbus.Variant) *dbus.Error {
+			if _, ok := b.Property(path, iface, name); !ok {
+				return NewError(ErrorInvalidArguments, "No such property '"+name+"'")
+			}
+
+			if err := b.SetProperty(path, iface, name, value.Value()); err != nil {
+				return dbus.MakeFailedError(err)
+			}
+
+			return nil
+		},
+	}, path, dbusPropertiesIface)
+}
+
+// copyVariants returns a copy of a map of variants.
+func copyVariants(values map[string]dbus.Variant) map[string]dbus.Variant {
+	copied := make(map[string]dbus.Variant, len(values))
+	for name, value := range values {
+		copied[name] = value
+	}
+
+	return copied
+}
+
+// NewError returns a new DBus error with the provided name and message.
+func NewError(name, message string) *dbus.Error {
+	return dbus.NewError(name, []interface{}{message})
+}
@@ -0,0 +1,134 @@
+//go:build linux
+
+package bluezmock
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Bus describes a private DBus daemon.
+type Bus struct {
+	// Address holds the DBus address of the daemon.
+	Address string
+
+	dir string
+	cmd *exec.Cmd
+}
+
+// busConfig holds the configuration for the private DBus daemon.
+const busConfig = `<!DOCTYPE busconfig PUBLIC "-//freedesktop//DTD D-Bus Bus Configuration 1.0//EN"
+ "http://www.freedesktop.org/standards/dbus/1.0/busconfig.dtd">
+<busconfig>
+  <type>session</type>
+  <listen>unix:dir=%s</listen>
+  <auth>EXTERNAL</auth>
+  <policy context="default">
+    <allow send_destination="*" eavesdrop="true"/>
+    <allow eavesdrop="true"/>
+    <allow own="*"/>
+  </policy>
+</busconfig>
+`
+
+// StartBus starts a new private DBus daemon.
+// The "dbus-daemon" executable must be present in PATH.
+func StartBus() (*Bus, error) {
+	daemon, err := exec.LookPath("dbus-daemon")
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "bluezmock-")
+	if err != nil {
+		return nil, err
+	}
+
+	config := filepath.Join(dir, "bus.conf")
+	if err := os.WriteFile(config, []byte(fmt.Sprintf(busConfig, dir)), 0o600); err != nil {
+		_ = os.RemoveAll(dir)
+
+		return nil, err
+	}
+
+	cmd := exec.Command(daemon, "--config-file="+config, "--nofork", "--print-address=1")
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		_ = os.RemoveAll(dir)
+
+		return nil, err
+	}
+
+	if err := cmd.Start(); err != nil {
+		_ = os.RemoveAll(dir)
+
+		return nil, err
+	}
+
+	address, err := bufio.NewReader(stdout).ReadString('\n')
+	if err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		_ = os.RemoveAll(dir)
+
+		return nil, errors.New("cannot read address from DBus daemon: " + err.Error())
+	}
+
+	return &Bus{
+		Address: strings.TrimSpace(address),
+		dir:     dir,
+		cmd:     cmd,
+	}, nil
+}
+
+// SetEnv points both the system and session bus addresses of the current
+// process to the private daemon, so that any new system or session bus
+// connections are made to it. The returned function restores the
+// previous addresses.
+func (b *Bus) SetEnv() (restore func()) {
+	keys := []string{"DBUS_SYSTEM_BUS_ADDRESS", "DBUS_SESSION_BUS_ADDRESS"}
+
+	previous := make(map[string]*string, len(keys))
+	for _, key := range keys {
+		if value, ok := os.LookupEnv(key); ok {
+			previous[key] = &value
+		} else {
+			previous[key] = nil
+		}
+
+		_ = os.Setenv(key, b.Address)
+	}
+
+	return func() {
+		for key, value := range previous {
+			if value == nil {
+				_ = os.Unsetenv(key)
+
+				continue
+			}
+
+			_ = os.Setenv(key, *value)
+		}
+	}
+}
+
+// Close stops the private DBus daemon.
+func (b *Bus) Close() error {
+	if b.cmd == nil || b.cmd.Process == nil {
+		return nil
+	}
+
+	if err := b.cmd.Process.Kill(); err != nil {
+		return err
+	}
+
+	_ = b.cmd.Wait()
+
+	return os.RemoveAll(b.dir)
+}
@@ -0,0 +1,11 @@
+/*
+Package bluezmock provides a fake Bluez DBus service, which exports
+"org.bluez" adapter, device, battery, media and agent manager objects
+on a private DBus daemon.
+
+It can be used to exercise a Linux Bluez session without any Bluetooth
+hardware or a running Bluetooth daemon. Objects and their properties can
+be scripted, and the appropriate PropertiesChanged and InterfacesAdded/Removed
+signals are emitted for every change.
+*/
+package bluezmock
@@ -0,0 +1,341 @@
+//go:build linux
+
+package bluezmock
+
+import (
+	"slices"
+	"strings"
+
+	dbh "github.com/bluetuith-org/api-native/linux/internal/dbushelper"
+	"github.com/godbus/dbus/v5"
+)
+
+// DefaultPasskey is the passkey which is sent to the registered agent
+// for confirmation by the default "Pair" method handler.
+const DefaultPasskey uint32 = 123456
+
+// DiscoveryFilters holds the discovery filter keys which are
+// reported as supported by the "GetDiscoveryFilters" method.
+var DiscoveryFilters = []string{
+	"UUIDs", "RSSI", "Pathloss", "Transport",
+	"DuplicateData", "Discoverable", "Pattern",
+}
+
+// DiscoveryFilter returns the discovery filter which was last set on an adapter.
+func (b *Bluez) DiscoveryFilter(adapterPath dbus.ObjectPath) (map[string]dbus.Variant, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	filter, ok := b.filters[adapterPath]
+
+	return copyVariants(filter), ok
+}
+
+// AddAdapter adds an adapter object with the provided name (for example, "hci0")
+// and address. Any provided properties override the default adapter properties.
+func (b *Bluez) AddAdapter(name, address string, props map[string]interface{}) (dbus.ObjectPath, error) {
+	path := dbus.ObjectPath("/org/bluez/" + name)
+
+	values := map[string]interface{}{
+		"Address":             address,
+		"AddressType":         "public",
+		"Name":                name,
+		"Alias":               name,
+		"Class":               uint32(0),
+		"Powered":             false,
+		"Discoverable":        false,
+		"DiscoverableTimeout": uint32(180),
+		"Pairable":            false,
+		"PairableTimeout":     uint32(0),
+		"Discovering":         false,
+		"UUIDs":               []string{},
+		"Modalias":            "usb:v1D6Bp0246d0540",
+		"Roles":               []string{"central", "peripheral"},
+	}
+	for key, value := range props {
+		values[key] = value
+	}
+
+	return path, b.AddInterface(path, dbh.BluezAdapterIface, values)
+}
+
+// AddDevice adds a device object with the provided address to an adapter.
+// Any provided properties override the default device properties.
+func (b *Bluez) AddDevice(adapterPath dbus.ObjectPath, address string, props map[string]interface{}) (dbus.ObjectPath, error) {
+	path := DevicePath(adapterPath, address)
+
+	values := map[string]interface{}{
+		"Address":          address,
+		"AddressType":      "public",
+		"Alias":            strings.ReplaceAll(address, ":", "-"),
+		"Adapter":          adapterPath,
+		"Paired":           false,
+		"Bonded":           false,
+		"Trusted":          false,
+		"Blocked":          false,
+		"WakeAllowed":      false,
+		"Connected":        false,
+		"ServicesResolved": false,
+		"LegacyPairing":    false,
+		"UUIDs":            []string{},
+	}
+	for key, value := range props {
+		values[key] = value
+	}
+
+	return path, b.AddInterface(path, dbh.BluezDeviceIface, values)
+}
+
+// AddBattery adds a battery interface with the provided percentage to a device object.
+func (b *Bluez) AddBattery(devicePath dbus.ObjectPath, percentage byte) error {
+	return b.AddInterface(devicePath, dbh.BluezBatteryIface, map[string]interface{}{
+		"Percentage": percentage,
+		"Source":     "bluezmock",
+	})
+}
+
+// AddMediaPlayer adds a media player object to a device object, and adds a connected
+// media control interface to the device. Any provided properties override the
+// default media player properties.
+func (b *Bluez) AddMediaPlayer(devicePath dbus.ObjectPath, props map[string]interface{}) (dbus.ObjectPath, error) {
+	path := devicePath + "/player0"
+
+	values := map[string]interface{}{
+		"Name":     "bluezmock",
+		"Type":     "Audio",
+		"Status":   "paused",
+		"Position": uint32(0),
+		"Device":   devicePath,
+		"Track": map[string]dbus.Variant{
+			"Title":          dbus.MakeVariant("Title"),
+			"Album":          dbus.MakeVariant("Album"),
+			"Artist":         dbus.MakeVariant("Artist"),
+			"Duration":       dbus.MakeVariant(uint32(180000)),
+			"TrackNumber":    dbus.MakeVariant(uint32(1)),
+			"NumberOfTracks": dbus.MakeVariant(uint32(10)),
+		},
+	}
+	for key, value := range props {
+		values[key] = value
+	}
+
+	if err := b.AddInterface(path, dbh.BluezMediaPlayerIface, values); err != nil {
+		return path, err
+	}
+
+	return path, b.AddInterface(devicePath, dbh.BluezMediaControlIface, map[string]interface{}{
+		"Connected": true,
+		"Player":    path,
+	})
+}
+
+// DevicePath returns the Bluez object path of a device with the provided address.
+func DevicePath(adapterPath dbus.ObjectPath, address string) dbus.ObjectPath {
+	return adapterPath + "/dev_" + dbus.ObjectPath(strings.ReplaceAll(strings.ToUpper(address), ":", "_"))
+}
+
+// exportMethods exports the methods of an interface for an object.
+func (b *Bluez) exportMethods(path dbus.ObjectPath, iface string) error {
+	var methods map[string]interface{}
+
+	switch iface {
+	case dbh.BluezAdapterIface:
+		methods = map[string]interface{}{
+			"StartDiscovery": func() *dbus.Error {
+				return b.call(iface, "StartDiscovery", path)
+			},
+			"StopDiscovery": func() *dbus.Error {
+				return b.call(iface, "StopDiscovery", path)
+			},
+			"RemoveDevice": func(device dbus.ObjectPath) *dbus.Error {
+				return b.call(iface, "RemoveDevice", path, device)
+			},
+			"SetDiscoveryFilter": func(filter map[string]dbus.Variant) *dbus.Error {
+				return b.call(iface, "SetDiscoveryFilter", path, filter)
+			},
+			"GetDiscoveryFilters": func() ([]string, *dbus.Error) {
+				return DiscoveryFilters, b.call(iface, "GetDiscoveryFilters", path)
+			},
+			"ConnectDevice": func(props map[string]dbus.Variant) (dbus.ObjectPath, *dbus.Error) {
+				address, _ := props["Address"].Value().(string)
+
+				return DevicePath(path, address), b.call(iface, "ConnectDevice", path, props)
+			},
+		}
+
+	case dbh.BluezDeviceIface:
+		methods = map[string]interface{}{
+			"Pair": func() *dbus.Error {
+				return b.call(iface, "Pair", path)
+			},
+			"CancelPairing": func() *dbus.Error {
+				return b.call(iface, "CancelPairing", path)
+			},
+			"Connect": func() *dbus.Error {
+				return b.call(iface, "Connect", path)
+			},
+			"Disconnect": func() *dbus.Error {
+				return b.call(iface, "Disconnect", path)
+			},
+			"ConnectProfile": func(uuid string) *dbus.Error {
+				return b.call(iface, "ConnectProfile", path, uuid)
+			},
+			"DisconnectProfile": func(uuid string) *dbus.Error {
+				return b.call(iface, "DisconnectProfile", path, uuid)
+			},
+		}
+
+	case dbh.BluezMediaPlayerIface:
+		methods = make(map[string]interface{})
+		for _, method := range []string{"Play", "Pause", "Stop", "Next", "Previous", "FastForward", "Rewind"} {
+			method := method
+			methods[method] = func() *dbus.Error {
+				return b.call(iface, method, path)
+			}
+		}
+
+	default:
+		return nil
+	}
+
+	return b.conn.ExportMethodTable(methods, path, iface)
+}
+
+// call calls the registered handler for a method of an interface.
+func (b *Bluez) call(iface, method string, path dbus.ObjectPath, args ...interface{}) *dbus.Error {
+	b.mu.Lock()
+	handler, ok := b.handlers[iface+"."+method]
+	b.mu.Unlock()
+
+	if !ok {
+		return NewError(ErrorNotSupported, "Method '"+method+"' is not supported")
+	}
+
+	return handler(path, args...)
+}
+
+// setDefaultHandlers sets the default method handlers, which update the
+// object properties like the Bluez daemon would.
+func (b *Bluez) setDefaultHandlers() {
+	setters := func(iface string, props map[string]interface{}) MethodHandler {
+		return func(path dbus.ObjectPath, _ ...interface{}) *dbus.Error {
+			if err := b.SetProperties(path, iface, props); err != nil {
+				return NewError(ErrorDoesNotExist, err.Error())
+			}
+
+			return nil
+		}
+	}
+
+	setter := func(iface, name string, value interface{}) MethodHandler {
+		return setters(iface, map[string]interface{}{name: value})
+	}
+
+	connection := func(connected bool) MethodHandler {
+		return setters(dbh.BluezDeviceIface, map[string]interface{}{
+			"Connected":        connected,
+			"ServicesResolved": connected,
+		})
+	}
+
+	nop := func(dbus.ObjectPath, ...interface{}) *dbus.Error {
+		return nil
+	}
+
+	b.handlers = map[string]MethodHandler{
+		dbh.BluezAdapterIface + ".StartDiscovery": setter(dbh.BluezAdapterIface, "Discovering", true),
+		dbh.BluezAdapterIface + ".StopDiscovery":  setter(dbh.BluezAdapterIface, "Discovering", false),
+		dbh.BluezAdapterIface + ".SetDiscoveryFilter": func(path dbus.ObjectPath, args ...interface{}) *dbus.Error {
+			filter, _ := args[0].(map[string]dbus.Variant)
+			for key := range filter {
+				if !slices.Contains(DiscoveryFilters, key) {
+					return NewError(ErrorInvalidArguments, "Invalid discovery filter key '"+key+"'")
+				}
+			}
+
+			b.mu.Lock()
+			b.filters[path] = copyVariants(filter)
+			b.mu.Unlock()
+
+			return nil
+		},
+		dbh.BluezAdapterIface + ".GetDiscoveryFilters": nop,
+		dbh.BluezAdapterIface + ".RemoveDevice": func(_ dbus.ObjectPath, args ...interface{}) *dbus.Error {
+			if err := b.RemoveObject(args[0].(dbus.ObjectPath)); err != nil {
+				return NewError(ErrorDoesNotExist, err.Error())
+			}
+
+			return nil
+		},
+		dbh.BluezAdapterIface + ".ConnectDevice": b.connectDevice,
+
+		dbh.BluezDeviceIface + ".Pair":              b.pair,
+		dbh.BluezDeviceIface + ".CancelPairing":     nop,
+		dbh.BluezDeviceIface + ".Connect":           connection(true),
+		dbh.BluezDeviceIface + ".Disconnect":        connection(false),
+		dbh.BluezDeviceIface + ".ConnectProfile":    connection(true),
+		dbh.BluezDeviceIface + ".DisconnectProfile": nop,
+
+		dbh.BluezMediaPlayerIface + ".Play":        setter(dbh.BluezMediaPlayerIface, "Status", "playing"),
+		dbh.BluezMediaPlayerIface + ".Pause":       setter(dbh.BluezMediaPlayerIface, "Status", "paused"),
+		dbh.BluezMediaPlayerIface + ".Stop":        setter(dbh.BluezMediaPlayerIface, "Status", "stopped"),
+		dbh.BluezMediaPlayerIface + ".FastForward": setter(dbh.BluezMediaPlayerIface, "Status", "forward-seek"),
+		dbh.BluezMediaPlayerIface + ".Rewind":      setter(dbh.BluezMediaPlayerIface, "Status", "reverse-seek"),
+		dbh.BluezMediaPlayerIface + ".Next":        nop,
+		dbh.BluezMediaPlayerIface + ".Previous":    nop,
+	}
+}
+
+// connectDevice is the default handler for the adapter "ConnectDevice" method.
+// It creates a connected device object, if no device with the provided address exists.
+func (b *Bluez) connectDevice(path dbus.ObjectPath, args ...interface{}) *dbus.Error {
+	props, _ := args[0].(map[string]dbus.Variant)
+
+	address, ok := props["Address"].Value().(string)
+	if !ok || address == "" {
+		return NewError(ErrorInvalidArguments, "Invalid device address")
+	}
+
+	if _, ok := b.Property(DevicePath(path, address), dbh.BluezDeviceIface, "Address"); ok {
+		return NewError(ErrorAlreadyExists, "Already Exists")
+	}
+
+	addressType := "public"
+	if value, ok := props["AddressType"].Value().(string); ok {
+		addressType = value
+	}
+
+	if _, err := b.AddDevice(path, address, map[string]interface{}{
+		"AddressType":      addressType,
+		"Connected":        true,
+		"ServicesResolved": true,
+	}); err != nil {
+		return NewError(ErrorFailed, err.Error())
+	}
+
+	return nil
+}
+
+// pair is the default handler for the device "Pair" method.
+// If an agent is registered, it asks the agent to confirm the DefaultPasskey
+// before marking the device as paired.
+func (b *Bluez) pair(path dbus.ObjectPath, _ ...interface{}) *dbus.Error {
+	if paired, ok := b.Property(path, dbh.BluezDeviceIface, "Paired"); ok && paired.(bool) {
+		return NewError(ErrorAlreadyExists, "Already Paired")
+	}
+
+	if _, ok := b.Agent(); ok {
+		if err := b.CallAgent("RequestConfirmation", path, DefaultPasskey).Store(); err != nil {
+			return NewError(ErrorAuthRejected, err.Error())
+		}
+	}
+
+	if err := b.SetProperties(path, dbh.BluezDeviceIface, map[string]interface{}{
+		"Paired": true,
+		"Bonded": true,
+	}); err != nil {
+		return NewError(ErrorDoesNotExist, err.Error())
+	}
+
+	return nil
+}
@@ -4,6 +4,8 @@ package linux
 
 import (
 	"context"
+	"errors"
+	"slices"
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
@@ -11,19 +13,46 @@ import (
 	"github.com/Southclaws/fault/ftag"
 	bluetooth "github.com/bluetuith-org/api-native/api/bluetooth"
 	errorkinds "github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/api/helpers/connection"
 	dbh "github.com/bluetuith-org/api-native/linux/internal/dbushelper"
 	"github.com/godbus/dbus/v5"
 	"github.com/google/uuid"
 )
 
+// retryableConnectReasons holds the reasons of failed connection attempts, which are
+// returned by Bluez as the message of an "org.bluez.Error.Failed" error, and are worth retrying.
+// Attempts which were aborted locally, or whose socket could not be created (for example, if the
+// profile is not available), are not retried, since retrying them would fail in the same way.
+var retryableConnectReasons = []string{
+	"br-connection-page-timeout",
+	"br-connection-busy",
+	"br-connection-timeout",
+	"le-connection-page-timeout",
+	"le-connection-busy",
+	"le-connection-timeout",
+	"Page Timeout",
+	"Host is down",
+	"Software caused connection abort",
+}
+
 // device describes a function call interface to invoke device related functions.
 type device struct {
 	b    *BluezSession
 	path dbus.ObjectPath
+	ctx  context.Context
 
 	Address bluetooth.MacAddress
 }
 
+// WithContext returns a copy of the device function call interface, whose
+// method calls are bound to the provided context.
+func (d *device) WithContext(ctx context.Context) bluetooth.Device {
+	device := *d
+	device.ctx = ctx
+
+	return &device
+}
+
 // Pair will attempt to pair a bluetooth device that is in pairing mode.
 func (d *device) Pair() error {
 	if _, err := d.check(); err != nil {
@@ -31,6 +60,11 @@ func (d *device) Pair() error {
 	}
 
 	if err := d.callDevice("Pair", 0).Store(); err != nil {
+		if d.callContext().Err() != nil {
+			_ = d.b.systemBus.Object(dbh.BluezBusName, d.path).
+				Call(dbh.BluezDeviceIface+".CancelPairing", 0).Store()
+		}
+
 		return fault.Wrap(err,
 			fctx.With(context.Background(),
 				"error_at", "device-pair",
@@ -67,6 +101,43 @@ func (d *device) CancelPairing() error {
 // Connect will attempt to connect an already paired bluetooth device
 // to an adapter.
 func (d *device) Connect() error {
+	// The device is connected on purpose, so it can be reconnected automatically again.
+	d.b.reconnectManager().Release(d.Address)
+
+	return d.connectDevice()
+}
+
+// connectDevice connects to the device, or if the device object does not exist,
+// connects to it via the default adapter. Unlike Connect, a device which is held
+// by the reconnection manager is not released.
+func (d *device) connectDevice() error {
+	if _, err := d.check(); err != nil {
+		if !errors.Is(err, errorkinds.ErrDeviceNotFound) {
+			return err
+		}
+
+		adapter, aerr := d.b.DefaultAdapter()
+		if aerr != nil {
+			return err
+		}
+
+		// Bluez reports a public address type for BR/EDR devices as well, so the known
+		// address type is only used for LE devices, which do not have a device class.
+		// Otherwise, the address type is omitted and the device is connected to over BR/EDR.
+		var addressType bluetooth.AddressType
+		if device, serr := d.b.store.Device(d.Address); serr == nil && device.Class == 0 {
+			addressType = device.AddressType
+		}
+
+		return d.b.Adapter(adapter.Address).WithContext(d.callContext()).
+			ConnectDevice(d.Address, addressType)
+	}
+
+	return d.connect()
+}
+
+// connect connects to an existing device object.
+func (d *device) connect() error {
 	if _, err := d.check(); err != nil {
 		return err
 	}
@@ -85,13 +156,39 @@ func (d *device) Connect() error {
 	return nil
 }
 
+// ConnectWithRetry will attempt to connect to the device, and retries the connection
+// attempt according to the provided policy if it fails with a retryable Bluez error.
+func (d *device) ConnectWithRetry(policy bluetooth.ConnectRetryPolicy) error {
+	d.b.reconnectManager().Release(d.Address)
+
+	return d.connectWithRetry(policy)
+}
+
+// connectWithRetry retries connecting to the device according to the provided policy,
+// without releasing the device from the reconnection manager.
+func (d *device) connectWithRetry(policy bluetooth.ConnectRetryPolicy) error {
+	return connection.Retry(d.callContext(), d.b.state.Emitter, d.Address, policy,
+		d.connectDevice, isRetryableConnectError,
+	)
+}
+
 // Disconnect will disconnect the bluetooth device from the adapter.
 func (d *device) Disconnect() error {
 	if _, err := d.check(); err != nil {
 		return err
 	}
 
+	// The device is disconnected on purpose, so it should not be reconnected automatically.
+	// It is held before the call, since the disconnection can be signalled before the call
+	// returns, and is released again if it cannot be disconnected.
+	manager := d.b.reconnectManager()
+	held := manager.Hold(d.Address)
+
 	if err := d.callDevice("Disconnect", 0).Store(); err != nil {
+		if held {
+			manager.Release(d.Address)
+		}
+
 		return fault.Wrap(err,
 			fctx.With(context.Background(),
 				"error_at", "device-disconnect",
@@ -154,7 +251,7 @@ func (d *device) Remove() error {
 		return err
 	}
 
-	adapterPath, ok := dbh.PathConverter.DbusPath(dbh.DbusPathAdapter, device.AssociatedAdapter)
+	adapterPath, ok := d.b.state.Paths.DbusPath(dbh.DbusPathAdapter, device.AssociatedAdapter)
 	if !ok {
 		return fault.Wrap(errorkinds.ErrAdapterNotFound,
 			fctx.With(context.Background(),
@@ -166,7 +263,8 @@ func (d *device) Remove() error {
 		)
 	}
 
-	if err := d.b.adapter(adapterPath).callAdapter("RemoveDevice", 0, d.path).Store(); err != nil {
+	if err := d.b.adapter(adapterPath).WithContext(d.callContext()).(*adapter).
+		callAdapter("RemoveDevice", 0, d.path).Store(); err != nil {
 		return fault.Wrap(err,
 			fctx.With(context.Background(),
 				"error_at", "device-remove-methodcall",
@@ -180,6 +278,105 @@ func (d *device) Remove() error {
 	return nil
 }
 
+// SetTrusted sets the trusted state of the device.
+func (d *device) SetTrusted(enable bool) error {
+	if _, err := d.check(); err != nil {
+		return err
+	}
+
+	if err := d.setDeviceProperty("Trusted", enable); err != nil {
+		return fault.Wrap(err,
+			fctx.With(context.Background(),
+				"error_at", "device-settrusted",
+				"address", d.Address.String(),
+			),
+			ftag.With(ftag.Internal),
+			fmsg.With("An error occurred on setting trusted state"),
+		)
+	}
+
+	return nil
+}
+
+// SetBlocked sets the blocked state of the device.
+// Blocking a connected device disconnects it.
+func (d *device) SetBlocked(enable bool) error {
+	if _, err := d.check(); err != nil {
+		return err
+	}
+
+	if err := d.setDeviceProperty("Blocked", enable); err != nil {
+		return fault.Wrap(err,
+			fctx.With(context.Background(),
+				"error_at", "device-setblocked",
+				"address", d.Address.String(),
+			),
+			ftag.With(ftag.Internal),
+			fmsg.With("An error occurred on setting blocked state"),
+		)
+	}
+
+	return nil
+}
+
+// SetAlias sets the alias of the device.
+// If the alias is empty, the device name is used as the alias.
+func (d *device) SetAlias(alias string) error {
+	if _, err := d.check(); err != nil {
+		return err
+	}
+
+	if err := d.setDeviceProperty("Alias", alias); err != nil {
+		return fault.Wrap(err,
+			fctx.With(context.Background(),
+				"error_at", "device-setalias",
+				"address", d.Address.String(),
+			),
+			ftag.With(ftag.Internal),
+			fmsg.With("An error occurred on setting the device alias"),
+		)
+	}
+
+	return nil
+}
+
+// SetWakeAllowed sets whether the device is allowed to wake up the host from system suspend.
+func (d *device) SetWakeAllowed(enable bool) error {
+	if _, err := d.check(); err != nil {
+		return err
+	}
+
+	if err := d.setDeviceProperty("WakeAllowed", enable); err != nil {
+		return fault.Wrap(err,
+			fctx.With(context.Background(),
+				"error_at", "device-setwake-allowed",
+				"address", d.Address.String(),
+			),
+			ftag.With(ftag.Internal),
+			fmsg.With("An error occurred on setting wake allowed state"),
+		)
+	}
+
+	return nil
+}
+
+// SetAutoReconnect sets whether the device is reconnected automatically.
+func (d *device) SetAutoReconnect(enable bool) error {
+	if _, err := d.check(); err != nil {
+		return err
+	}
+
+	d.b.reconnectManager().SetEnabled(d.Address, enable)
+
+	return nil
+}
+
+// Setup runs the setup workflow of the device, which pairs, trusts and connects to
+// the device, and waits for its services to be resolved.
+func (d *device) Setup(ctx context.Context, opts bluetooth.SetupOptions) (bluetooth.SetupResult, error) {
+	return connection.Setup(ctx, d.b.state.Emitter, d, d.Address, opts)
+}
+
 // Properties returns all the properties of the device.
 func (d *device) Properties() (bluetooth.DeviceData, error) {
 	return d.check()
@@ -189,10 +386,7 @@ func (d *device) Properties() (bluetooth.DeviceData, error) {
 // device's address ((*Device).Address), and checks whether the device
 // properties are present within the global session store.
 func (d *device) check() (bluetooth.DeviceData, error) {
-	dbusPath, exists := dbh.PathConverter.DbusPath(dbh.DbusPathDevice, d.Address)
-
-	switch {
-	case d.b == nil:
+	if d.b == nil || d.b.state == nil {
 		return bluetooth.DeviceData{}, fault.Wrap(errorkinds.ErrDeviceNotFound,
 			fctx.With(context.Background(),
 				"error_at", "device-check-bus",
@@ -201,8 +395,10 @@ func (d *device) check() (bluetooth.DeviceData, error) {
 			ftag.With(ftag.Internal),
 			fmsg.With("Error while fetching device data"),
 		)
+	}
 
-	case !exists:
+	dbusPath, exists := d.b.state.Paths.DbusPath(dbh.DbusPathDevice, d.Address)
+	if !exists {
 		return bluetooth.DeviceData{}, fault.Wrap(errorkinds.ErrDeviceNotFound,
 			fctx.With(context.Background(),
 				"error_at", "device-check-path",
@@ -234,7 +430,24 @@ func (d *device) check() (bluetooth.DeviceData, error) {
 // https://git.kernel.org/pub/scm/bluetooth/bluez.git/tree/doc/device-api.txt
 func (d *device) callDevice(method string, flags dbus.Flags, args ...interface{}) *dbus.Call {
 	return d.b.systemBus.Object(dbh.BluezBusName, d.path).
-		Call(dbh.BluezDeviceIface+"."+method, flags, args...)
+		CallWithContext(d.callContext(), dbh.BluezDeviceIface+"."+method, flags, args...)
+}
+
+// setDeviceProperty can be used to set certain properties of a bluetooth device.
+func (d *device) setDeviceProperty(key string, value interface{}) error {
+	return d.b.systemBus.Object(dbh.BluezBusName, d.path).CallWithContext(
+		d.callContext(), dbh.DbusSetPropertiesIface, 0, dbh.BluezDeviceIface,
+		key, dbus.MakeVariant(value),
+	).Store()
+}
+
+// callContext returns the context which the device's method calls are bound to.
+func (d *device) callContext() context.Context {
+	if d.ctx == nil {
+		return context.Background()
+	}
+
+	return d.ctx
 }
 
 // convertAndStoreObjects converts a map of dbus objects to a common DeviceData structure.
@@ -262,7 +475,7 @@ func (d *device) convertAndStoreObjects(values map[string]dbus.Variant) error {
 		bluetooth.DeviceData
 	}{}
 
-	if err := dbh.DecodeVariantMap(values, &device, "Name", "Address"); err != nil {
+	if err := d.b.state.DecodeVariantMap(values, &device, "Name", "Address"); err != nil {
 		return fault.Wrap(err,
 			fctx.With(context.Background(),
 				"error_at", "device-map-decode",
@@ -300,13 +513,14 @@ func (d *device) convertAndStoreObjects(values map[string]dbus.Variant) error {
 	}
 
 	device.AssociatedAdapter = adapterMac
-	device.Type = bluetooth.DeviceTypeFromClass(device.Class)
+	device.Type = bluetooth.ResolveDeviceType(device.Appearance, device.Class, device.UUIDs)
+	device.ClassInfo = bluetooth.DecodeDeviceClass(device.Class)
 
 	if p, err := d.batteryPercentage(); err == nil {
 		device.Percentage = int(p)
 	}
 
-	dbh.PathConverter.AddDbusPath(dbh.DbusPathDevice, d.path, device.Address)
+	d.b.state.Paths.AddDbusPath(dbh.DbusPathDevice, d.path, device.Address)
 	d.b.store.AddDevice(device.DeviceData)
 
 	return nil
@@ -324,3 +538,15 @@ func (d *device) batteryPercentage() (byte, error) {
 
 	return result, nil
 }
+
+// isRetryableConnectError returns whether a connection attempt which failed with
+// the provided error is worth retrying.
+func isRetryableConnectError(err error) bool {
+	if dbh.IsError(err, dbh.BluezErrorInProgress) {
+		return true
+	}
+
+	reason, ok := dbh.ErrorMessage(err, dbh.BluezErrorFailed)
+
+	return ok && slices.Contains(retryableConnectReasons, reason)
+}
@@ -0,0 +1,222 @@
+//go:build linux
+
+package linux
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/linux/bluezmock"
+	dbh "github.com/bluetuith-org/api-native/linux/internal/dbushelper"
+	"github.com/godbus/dbus/v5"
+)
+
+func TestDeviceCheckWithoutSession(t *testing.T) {
+	for _, d := range []*device{{}, {b: &BluezSession{}}} {
+		if _, err := d.check(); !errors.Is(err, errorkinds.ErrDeviceNotFound) {
+			t.Errorf("check() without a started session returned %v, want %v", err, errorkinds.ErrDeviceNotFound)
+		}
+	}
+}
+
+func TestDecodeDeviceProperties(t *testing.T) {
+	session, mock := startTestSession(t, nil)
+
+	devicePath := bluezmock.DevicePath("/org/bluez/hci0", testDeviceAddress)
+	deviceAddress := mustParseMAC(t, testDeviceAddress)
+
+	sub := bluetooth.DeviceEvent(bluetooth.EventActionUpdated).On(session.Events()).Subscribe()
+	defer sub.Unsubscribe()
+
+	// setProperties sets the device properties, and returns the device's
+	// properties once the update has been applied.
+	setProperties := func(t *testing.T, props map[string]interface{}, applied func(bluetooth.DeviceEventData) bool) bluetooth.DeviceData {
+		t.Helper()
+
+		if err := mock.SetProperties(devicePath, dbh.BluezDeviceIface, props); err != nil {
+			t.Fatalf("Cannot set properties: %v", err)
+		}
+
+		waitEvent(t, sub, func(ev bluetooth.Event[bluetooth.DeviceEventData]) bool {
+			return ev.Data.Address == deviceAddress && applied(ev.Data)
+		})
+
+		device, err := session.Device(deviceAddress).Properties()
+		if err != nil {
+			t.Fatalf("Properties() returned error: %v", err)
+		}
+
+		return device
+	}
+
+	t.Run("ManufacturerData", func(t *testing.T) {
+		setProperties(t, map[string]interface{}{
+			"ManufacturerData": map[uint16]dbus.Variant{
+				0x004c: dbus.MakeVariant([]byte{0x01, 0x02}),
+				0x0006: dbus.MakeVariant([]byte{0x03}),
+			},
+		}, func(device bluetooth.DeviceEventData) bool { return len(device.ManufacturerData) == 2 })
+
+		device := setProperties(t, map[string]interface{}{
+			"ManufacturerData": map[uint16]dbus.Variant{
+				0x009e: dbus.MakeVariant([]byte{0x04, 0x05}),
+			},
+		}, func(device bluetooth.DeviceEventData) bool { return len(device.ManufacturerData) == 1 })
+
+		want := map[uint16][]byte{0x009e: {0x04, 0x05}}
+		if !reflect.DeepEqual(device.ManufacturerData, want) {
+			t.Errorf("ManufacturerData = %v, want %v", device.ManufacturerData, want)
+		}
+	})
+
+	t.Run("ServiceData", func(t *testing.T) {
+		const (
+			batteryService = "0000180f-0000-1000-8000-00805f9b34fb"
+			heartRate      = "0000180d-0000-1000-8000-00805f9b34fb"
+		)
+
+		setProperties(t, map[string]interface{}{
+			"ServiceData": map[string]dbus.Variant{batteryService: dbus.MakeVariant([]byte{0x64})},
+		}, func(device bluetooth.DeviceEventData) bool { return len(device.ServiceData) == 1 })
+
+		device := setProperties(t, map[string]interface{}{
+			"ServiceData": map[string]dbus.Variant{heartRate: dbus.MakeVariant([]byte{0x48})},
+		}, func(device bluetooth.DeviceEventData) bool { return device.ServiceData[heartRate] != nil })
+
+		want := map[string][]byte{heartRate: {0x48}}
+		if !reflect.DeepEqual(device.ServiceData, want) {
+			t.Errorf("ServiceData = %v, want %v", device.ServiceData, want)
+		}
+	})
+
+	t.Run("ModaliasAndAdvertisingFlags", func(t *testing.T) {
+		device := setProperties(t, map[string]interface{}{
+			"Modalias":         "bluetooth:v009Ep4020d0251",
+			"AdvertisingFlags": []byte{0x06},
+		}, func(device bluetooth.DeviceEventData) bool { return !device.Modalias.IsNil() })
+
+		want := bluetooth.Modalias{Source: "bluetooth", Vendor: 0x009e, Product: 0x4020, Version: 0x0251}
+		if device.Modalias != want {
+			t.Errorf("Modalias = %+v, want %+v", device.Modalias, want)
+		}
+
+		if !bytes.Equal(device.AdvertisingFlags, []byte{0x06}) {
+			t.Errorf("AdvertisingFlags = %v, want [6]", device.AdvertisingFlags)
+		}
+	})
+
+	t.Run("ClassAndAppearance", func(t *testing.T) {
+		// Phone, Smartphone, with the Networking, Object Transfer, Audio and Telephony services.
+		// The class is not sent with the device events, so the icon is changed along with it.
+		device := setProperties(t, map[string]interface{}{
+			"Class": uint32(0x5a020c),
+			"Icon":  "phone",
+		}, func(device bluetooth.DeviceEventData) bool { return device.Icon == "phone" })
+
+		if device.ClassInfo.MajorClass != bluetooth.MajorClassPhone || device.ClassInfo.MinorClassName != "Smartphone" {
+			t.Errorf("ClassInfo = %+v, want a smartphone", device.ClassInfo)
+		}
+
+		if device.Type != "Phone" {
+			t.Errorf("Type = %q after the class changed, want %q", device.Type, "Phone")
+		}
+
+		device = setProperties(t, map[string]interface{}{
+			"Appearance": uint16(0x03c1),
+		}, func(device bluetooth.DeviceEventData) bool { return device.Appearance == 0x03c1 })
+
+		if device.Type != "Keyboard" {
+			t.Errorf("Type = %q after the appearance changed, want %q", device.Type, "Keyboard")
+		}
+
+		if device.ClassInfo.MajorClass != bluetooth.MajorClassPhone {
+			t.Errorf("ClassInfo = %+v after the appearance changed, want it to be unchanged", device.ClassInfo)
+		}
+	})
+}
+
+func TestConnectDeviceFallback(t *testing.T) {
+	session, mock := startTestSession(t, nil)
+	adapterAddress := mustParseMAC(t, testAdapterAddress)
+
+	addressTypes := make(chan interface{}, 1)
+	mock.HandleMethod(dbh.BluezAdapterIface, "ConnectDevice", func(_ dbus.ObjectPath, args ...interface{}) *dbus.Error {
+		props, _ := args[0].(map[string]dbus.Variant)
+
+		var addressType interface{}
+		if value, ok := props["AddressType"]; ok {
+			addressType = value.Value()
+		}
+		addressTypes <- addressType
+
+		return nil
+	})
+
+	// The devices are only added to the store, so that no device objects exist for them.
+	leDevice := bluetooth.DeviceData{AddressType: bluetooth.AddressTypeRandom}
+	leDevice.Address = mustParseMAC(t, "AA:BB:CC:DD:EE:10")
+	leDevice.AssociatedAdapter = adapterAddress
+
+	brDevice := bluetooth.DeviceData{AddressType: bluetooth.AddressTypePublic, Class: 0x240404}
+	brDevice.Address = mustParseMAC(t, "AA:BB:CC:DD:EE:11")
+	brDevice.AssociatedAdapter = adapterAddress
+
+	session.store.AddDevices(leDevice, brDevice)
+
+	tests := []struct {
+		name    string
+		address bluetooth.MacAddress
+		want    interface{}
+	}{
+		{name: "Unknown", address: mustParseMAC(t, "AA:BB:CC:DD:EE:12")},
+		{name: "LE", address: leDevice.Address, want: string(bluetooth.AddressTypeRandom)},
+		{name: "BREDR", address: brDevice.Address},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := session.Device(test.address).Connect(); err != nil {
+				t.Fatalf("Connect() returned error: %v", err)
+			}
+
+			select {
+			case addressType := <-addressTypes:
+				if addressType != test.want {
+					t.Errorf("ConnectDevice was called with address type %v, want %v", addressType, test.want)
+				}
+
+			case <-time.After(eventTimeout):
+				t.Fatal("ConnectDevice was not called")
+			}
+		})
+	}
+}
+
+func TestIsRetryableConnectError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "InProgress", err: dbus.Error{Name: dbh.BluezErrorInProgress}, want: true},
+		{name: "PageTimeout", err: dbus.Error{Name: dbh.BluezErrorFailed, Body: []interface{}{"br-connection-page-timeout"}}, want: true},
+		{name: "LEBusy", err: dbus.Error{Name: dbh.BluezErrorFailed, Body: []interface{}{"le-connection-busy"}}, want: true},
+		{name: "AbortedByLocal", err: dbus.Error{Name: dbh.BluezErrorFailed, Body: []interface{}{"br-connection-aborted-by-local"}}},
+		{name: "CreateSocket", err: dbus.Error{Name: dbh.BluezErrorFailed, Body: []interface{}{"br-connection-create-socket"}}},
+		{name: "ProfileUnavailable", err: dbus.Error{Name: dbh.BluezErrorFailed, Body: []interface{}{"br-connection-profile-unavailable"}}},
+		{name: "OtherError", err: errors.New("br-connection-page-timeout")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := isRetryableConnectError(test.err); got != test.want {
+				t.Errorf("isRetryableConnectError(%v) = %v, want %v", test.err, got, test.want)
+			}
+		})
+	}
+}
@@ -13,9 +13,11 @@ const (
 	DbusIntrospectableIface   = "org.freedesktop.DBus.Introspectable"
 
 	DbusSignalAddMatchIface          = "org.freedesktop.DBus.AddMatch"
+	DbusSignalRemoveMatchIface       = "org.freedesktop.DBus.RemoveMatch"
 	DbusSignalPropertyChangedIface   = "org.freedesktop.DBus.Properties.PropertiesChanged"
 	DbusSignalInterfacesAddedIface   = "org.freedesktop.DBus.ObjectManager.InterfacesAdded"
 	DbusSignalInterfacesRemovedIface = "org.freedesktop.DBus.ObjectManager.InterfacesRemoved"
+	DbusSignalNameOwnerChangedIface  = "org.freedesktop.DBus.NameOwnerChanged"
 
 	BluezBusName           = "org.bluez"
 	BluezAdapterIface      = "org.bluez.Adapter1"
@@ -40,4 +42,14 @@ const (
 	ObexAgentManagerIface = "org.bluez.obex.AgentManager1"
 	ObexAgentManagerPath  = dbus.ObjectPath("/org/bluez/obex")
 	ObexAgentPath         = dbus.ObjectPath("/org/bluez/obex/agent/bluerestd")
+
+	LogindBusName               = "org.freedesktop.login1"
+	LogindSignalPrepareForSleep = "org.freedesktop.login1.Manager.PrepareForSleep"
+)
+
+// The Bluez specific error names.
+const (
+	BluezErrorAlreadyExists = "org.bluez.Error.AlreadyExists"
+	BluezErrorInProgress    = "org.bluez.Error.InProgress"
+	BluezErrorFailed        = "org.bluez.Error.Failed"
 )
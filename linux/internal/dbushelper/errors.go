@@ -15,13 +15,13 @@ import (
 )
 
 // PublishSignalError publishes an error message with DBus signal data to the error event stream.
-func PublishSignalError(err error, signal *dbus.Signal, message string, metadata ...string) {
-	bluetooth.ErrorEvent().Publish(wrapSignalErrors(err, signal, message, metadata...))
+func (s *SessionState) PublishSignalError(err error, signal *dbus.Signal, message string, metadata ...string) {
+	bluetooth.ErrorEvent().On(s.Emitter).Publish(wrapSignalErrors(err, signal, message, metadata...))
 }
 
 // PublishError publishes an error to the error event stream.
-func PublishError(err error, message string, metadata ...string) {
-	bluetooth.ErrorEvent().Publish(
+func (s *SessionState) PublishError(err error, message string, metadata ...string) {
+	bluetooth.ErrorEvent().On(s.Emitter).Publish(
 		errorkinds.GenericError{
 			Errors: fault.Wrap(err,
 				fctx.With(context.Background(), metadata...),
@@ -10,11 +10,15 @@ import (
 )
 
 // PublishAdapterUpdateEvent publishes an adapter event after updating the session store.
-func PublishAdapterUpdateEvent(store *sstore.SessionStore, signal *dbus.Signal, variants map[string]dbus.Variant) {
+// If the updated function is not nil, it is called after the event is published.
+func (s *SessionState) PublishAdapterUpdateEvent(
+	store *sstore.SessionStore, signal *dbus.Signal, variants map[string]dbus.Variant,
+	updated func(),
+) {
 	go func() {
-		address, ok := PathConverter.Address(DbusPathAdapter, signal.Path)
+		address, ok := s.Paths.Address(DbusPathAdapter, signal.Path)
 		if !ok {
-			PublishSignalError(errorkinds.ErrAdapterNotFound, signal,
+			s.PublishSignalError(errorkinds.ErrAdapterNotFound, signal,
 				"Bluez event handler error",
 				"error_at", "pchanged-adapter-address",
 			)
@@ -22,9 +26,9 @@ func PublishAdapterUpdateEvent(store *sstore.SessionStore, signal *dbus.Signal,
 			return
 		}
 
-		updated, err := store.UpdateAdapter(address, DecodeAdapterFunc(variants))
+		adapter, err := store.UpdateAdapter(address, s.Decoder.DecodeAdapterFunc(variants))
 		if err != nil {
-			PublishSignalError(err, signal,
+			s.PublishSignalError(err, signal,
 				"Bluez event handler error",
 				"error_at", "pchanged-adapter-update",
 			)
@@ -32,16 +36,20 @@ func PublishAdapterUpdateEvent(store *sstore.SessionStore, signal *dbus.Signal,
 			return
 		}
 
-		bluetooth.AdapterEvent(bluetooth.EventActionUpdated).Publish(updated)
+		bluetooth.AdapterEvent(bluetooth.EventActionUpdated).On(s.Emitter).Publish(adapter)
+
+		if updated != nil {
+			updated()
+		}
 	}()
 }
 
 // PublishDeviceUpdateEvent publishes a device event after updating the session store.
-func PublishDeviceUpdateEvent(store *sstore.SessionStore, signal *dbus.Signal, variants map[string]dbus.Variant) {
+func (s *SessionState) PublishDeviceUpdateEvent(store *sstore.SessionStore, signal *dbus.Signal, variants map[string]dbus.Variant) {
 	go func() {
-		address, ok := PathConverter.Address(DbusPathDevice, signal.Path)
+		address, ok := s.Paths.Address(DbusPathDevice, signal.Path)
 		if !ok {
-			PublishSignalError(errorkinds.ErrDeviceNotFound, signal,
+			s.PublishSignalError(errorkinds.ErrDeviceNotFound, signal,
 				"Bluez event handler error",
 				"error_at", "pchanged-adapter-address",
 			)
@@ -49,9 +57,9 @@ func PublishDeviceUpdateEvent(store *sstore.SessionStore, signal *dbus.Signal, v
 			return
 		}
 
-		updated, err := store.UpdateDevice(address, DecodeDeviceFunc(variants))
+		updated, err := store.UpdateDevice(address, s.Decoder.DecodeDeviceFunc(variants))
 		if err != nil {
-			PublishSignalError(err, signal,
+			s.PublishSignalError(err, signal,
 				"Bluez event handler error",
 				"error_at", "pchanged-adapter-update",
 			)
@@ -59,6 +67,6 @@ func PublishDeviceUpdateEvent(store *sstore.SessionStore, signal *dbus.Signal, v
 			return
 		}
 
-		bluetooth.DeviceEvent(bluetooth.EventActionUpdated).Publish(updated)
+		bluetooth.DeviceEvent(bluetooth.EventActionUpdated).On(s.Emitter).Publish(updated)
 	}()
 }
@@ -29,33 +29,36 @@ type dbusPath struct {
 	path     dbus.ObjectPath
 }
 
-// dbusPathConverter holds a list of Bluez DBus paths and maps them
-// to their respective Bluetooth addresses.
-type dbusPathConverter struct {
+// PathConverter holds a list of Bluez DBus paths and maps them
+// to their respective Bluetooth addresses. It is used to obtain respective
+// Bluetooth addresses that are mapped to Bluez DBus paths, and is mainly used
+// to identify adapters and devices.
+type PathConverter struct {
 	paths *xsync.MapOf[dbusPath, bluetooth.MacAddress]
 }
 
-// PathConverter is used to obtain respective Bluetooth addresses that are mapped to
-// Bluez DBus paths. This is mainly used to identify adapters and devices.
-var PathConverter = dbusPathConverter{paths: xsync.NewMapOf[dbusPath, bluetooth.MacAddress]()}
+// NewPathConverter returns a new path converter.
+func NewPathConverter() *PathConverter {
+	return &PathConverter{paths: xsync.NewMapOf[dbusPath, bluetooth.MacAddress]()}
+}
 
 // AddDbusPath adds a mapping of a Bluez DBus path and a Bluetooth address to the path converter.
-func (d *dbusPathConverter) AddDbusPath(pathType DbusPathType, path dbus.ObjectPath, address bluetooth.MacAddress) {
+func (d *PathConverter) AddDbusPath(pathType DbusPathType, path dbus.ObjectPath, address bluetooth.MacAddress) {
 	d.paths.Store(dbusPath{pathType: pathType, path: path}, address)
 }
 
 // RemoveDbusPath removes a mapping of a Bluez DBus path and a Bluetooth address from the path converter.
-func (d *dbusPathConverter) RemoveDbusPath(pathType DbusPathType, path dbus.ObjectPath) {
+func (d *PathConverter) RemoveDbusPath(pathType DbusPathType, path dbus.ObjectPath) {
 	d.paths.Delete(dbusPath{pathType: pathType, path: path})
 }
 
 // Address returns a Bluetooth address that is mapped to the provided Bluez DBus path.
-func (d *dbusPathConverter) Address(pathType DbusPathType, path dbus.ObjectPath) (bluetooth.MacAddress, bool) {
+func (d *PathConverter) Address(pathType DbusPathType, path dbus.ObjectPath) (bluetooth.MacAddress, bool) {
 	return d.paths.Load(dbusPath{pathType: pathType, path: path})
 }
 
 // DbusPath returns a Bluez DBus path that is mapped to the provided Bluetooth address.
-func (d *dbusPathConverter) DbusPath(pathType DbusPathType, address bluetooth.MacAddress) (dbus.ObjectPath, bool) {
+func (d *PathConverter) DbusPath(pathType DbusPathType, address bluetooth.MacAddress) (dbus.ObjectPath, bool) {
 	var dpath dbus.ObjectPath
 
 	d.paths.Range(func(p dbusPath, addr bluetooth.MacAddress) bool {
@@ -70,3 +73,18 @@ func (d *dbusPathConverter) DbusPath(pathType DbusPathType, address bluetooth.Ma
 
 	return dpath, dpath != ""
 }
+
+// Clear removes all mappings of the provided DBus path types from the path converter.
+func (d *PathConverter) Clear(pathTypes ...DbusPathType) {
+	d.paths.Range(func(p dbusPath, _ bluetooth.MacAddress) bool {
+		for _, pathType := range pathTypes {
+			if p.pathType == pathType {
+				d.paths.Delete(p)
+
+				break
+			}
+		}
+
+		return true
+	})
+}
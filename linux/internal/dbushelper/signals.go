@@ -0,0 +1,73 @@
+//go:build linux
+
+package dbushelper
+
+import "github.com/godbus/dbus/v5"
+
+// SignalWatcher describes a watcher for DBus signals which match a set of rules.
+type SignalWatcher struct {
+	conn  *dbus.Conn
+	rules []string
+
+	ch   chan *dbus.Signal
+	done chan struct{}
+}
+
+// WatchSignals adds the provided match rules to the DBus connection, and calls
+// the handler for each received signal until the watcher is stopped.
+func WatchSignals(conn *dbus.Conn, handler func(signal *dbus.Signal), rules ...string) *SignalWatcher {
+	w := &SignalWatcher{
+		conn:  conn,
+		rules: rules,
+		ch:    make(chan *dbus.Signal, 1),
+		done:  make(chan struct{}),
+	}
+
+	for _, rule := range rules {
+		conn.BusObject().Call(DbusSignalAddMatchIface, 0, rule)
+	}
+
+	conn.Signal(w.ch)
+
+	go func() {
+		defer close(w.done)
+
+		for signal := range w.ch {
+			handler(signal)
+		}
+	}()
+
+	return w
+}
+
+// Stop removes the match rules from the DBus connection, and waits for
+// the signal handler to return. It must not be called from within the handler.
+func (w *SignalWatcher) Stop() {
+	if w == nil {
+		return
+	}
+
+	select {
+	case <-w.done:
+		return
+
+	default:
+	}
+
+	// If the connection is closed, the signal channel is closed by the
+	// connection's signal handler, and the watcher exits on its own.
+	if !w.conn.Connected() {
+		<-w.done
+
+		return
+	}
+
+	for _, rule := range w.rules {
+		w.conn.BusObject().Call(DbusSignalRemoveMatchIface, 0, rule)
+	}
+
+	w.conn.RemoveSignal(w.ch)
+	close(w.ch)
+
+	<-w.done
+}
@@ -0,0 +1,46 @@
+//go:build linux
+
+package dbushelper
+
+import (
+	"github.com/bluetuith-org/api-native/api/eventbus"
+	"github.com/godbus/dbus/v5"
+)
+
+// SessionState holds the state of a single Bluez session, which is shared
+// between the Bluez, OBEX and media player handlers of the session.
+type SessionState struct {
+	// Paths maps the Bluez DBus paths of the session to their Bluetooth addresses.
+	Paths *PathConverter
+
+	// Decoder decodes DBus variant values into their respective data types.
+	Decoder *VariantDecoder
+
+	// Emitter is the event emitter which the session's events are published to.
+	Emitter *eventbus.Emitter
+}
+
+// NewSessionState returns a new session state, which publishes events
+// to the provided event emitter. If the emitter is nil, the global event
+// emitter is used. The session's emitter is scoped (see eventbus.Emitter.Scope),
+// so that stopping the session only closes the subscriptions made via the session.
+func NewSessionState(emitter *eventbus.Emitter) *SessionState {
+	if emitter == nil {
+		emitter = eventbus.DefaultEmitter()
+	}
+
+	return &SessionState{
+		Paths:   NewPathConverter(),
+		Decoder: NewVariantDecoder(),
+		Emitter: emitter.Scope(),
+	}
+}
+
+// DecodeVariantMap decodes a map of variants into the provided data
+// using the session's variant decoder.
+func (s *SessionState) DecodeVariantMap(
+	variants map[string]dbus.Variant, data interface{},
+	checkProps ...string,
+) error {
+	return s.Decoder.DecodeVariantMap(variants, data, checkProps...)
+}
@@ -2,7 +2,38 @@
 
 package dbushelper
 
-import "github.com/godbus/dbus/v5"
+import (
+	"errors"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// IsError returns whether the provided error is a DBus error with the provided name,
+// for example, "org.bluez.Error.AlreadyExists".
+func IsError(err error, name string) bool {
+	var dbusErr dbus.Error
+
+	return errors.As(err, &dbusErr) && dbusErr.Name == name
+}
+
+// ErrorMessage returns the message of the provided error, if it is a DBus error
+// with the provided name. For example, Bluez returns the reason of a failed connection
+// attempt as the message of an "org.bluez.Error.Failed" error.
+func ErrorMessage(err error, name string) (string, bool) {
+	var dbusErr dbus.Error
+
+	if !errors.As(err, &dbusErr) || dbusErr.Name != name {
+		return "", false
+	}
+
+	if len(dbusErr.Body) > 0 {
+		if message, ok := dbusErr.Body[0].(string); ok {
+			return message, true
+		}
+	}
+
+	return "", true
+}
 
 // ListActivatableBusNames returns a list of bus names from the provided DBus connection.
 func ListActivatableBusNames(conn *dbus.Conn) ([]string, error) {
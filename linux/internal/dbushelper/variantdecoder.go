@@ -16,8 +16,8 @@ import (
 // variantExt represents a go-codec extension to parse DBus variant values.
 type variantExt struct{}
 
-// resolver holds an encoder and decoder.
-type resolver struct {
+// VariantDecoder holds an encoder and decoder to decode DBus variant values.
+type VariantDecoder struct {
 	check bool
 
 	encoder *codec.Encoder
@@ -27,7 +27,10 @@ type resolver struct {
 	lock sync.Mutex
 }
 
-var variantDecoder resolver
+// NewVariantDecoder returns a new variant decoder.
+func NewVariantDecoder() *VariantDecoder {
+	return &VariantDecoder{}
+}
 
 // ConvertExt converts a variant struct into an encodable value.
 // Note: v is a pointer iff the registered extension type is a struct or array kind.
@@ -44,7 +47,7 @@ func (v variantExt) UpdateExt(dst, src interface{}) {
 // DecodeVariantMap decodes a map of variants into the provided data.
 // Note that, for types "MacAddress" and "uuid.UUID", custom TextMarshaler
 // and TextUnmarshaler interfaces have been defined.
-func DecodeVariantMap(
+func (variantDecoder *VariantDecoder) DecodeVariantMap(
 	variants map[string]dbus.Variant, data interface{},
 	checkProps ...string,
 ) error {
@@ -80,15 +83,55 @@ func DecodeVariantMap(
 }
 
 // DecodeAdapterFunc returns a function to decode and merge adapter data.
-func DecodeAdapterFunc(variants map[string]dbus.Variant) sstore.MergeAdapterDataFunc {
+func (variantDecoder *VariantDecoder) DecodeAdapterFunc(variants map[string]dbus.Variant) sstore.MergeAdapterDataFunc {
 	return func(adapter *bluetooth.AdapterData) error {
-		return DecodeVariantMap(variants, adapter)
+		return variantDecoder.DecodeVariantMap(variants, adapter)
 	}
 }
 
+// HasRadioProperties returns whether the device properties include any properties which
+// are only set when the device is in range, that is, its signal strength or advertising data.
+func HasRadioProperties(variants map[string]dbus.Variant) bool {
+	for _, key := range []string{"RSSI", "ManufacturerData", "ServiceData"} {
+		if _, ok := variants[key]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 // DecodeDeviceFunc returns a function to decode and merge device data.
-func DecodeDeviceFunc(variants map[string]dbus.Variant) sstore.MergeDeviceDataFunc {
+func (variantDecoder *VariantDecoder) DecodeDeviceFunc(variants map[string]dbus.Variant) sstore.MergeDeviceDataFunc {
 	return func(device *bluetooth.DeviceData) error {
-		return DecodeVariantMap(variants, device)
+		// The advertising data maps are always sent as a whole, so any stale keys
+		// should not be merged with the updated data.
+		if _, ok := variants["ManufacturerData"]; ok {
+			device.ManufacturerData = nil
+		}
+
+		if _, ok := variants["ServiceData"]; ok {
+			device.ServiceData = nil
+		}
+
+		if err := variantDecoder.DecodeVariantMap(variants, device); err != nil {
+			return err
+		}
+
+		if _, ok := variants["Class"]; ok {
+			device.ClassInfo = bluetooth.DecodeDeviceClass(device.Class)
+		}
+
+		// The device type is resolved from these properties, so it should be
+		// updated whenever any of them change.
+		for _, key := range []string{"Appearance", "Class", "UUIDs"} {
+			if _, ok := variants[key]; ok {
+				device.Type = bluetooth.ResolveDeviceType(device.Appearance, device.Class, device.UUIDs)
+
+				break
+			}
+		}
+
+		return nil
 	}
 }
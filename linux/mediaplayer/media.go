@@ -19,7 +19,19 @@ import (
 // functions.
 type MediaPlayer struct {
 	SystemBus *dbus.Conn
+	State     *dbh.SessionState
 	Address   bluetooth.MacAddress
+
+	ctx context.Context
+}
+
+// WithContext returns a copy of the media player function call interface, whose
+// method calls are bound to the provided context.
+func (m *MediaPlayer) WithContext(ctx context.Context) bluetooth.MediaPlayer {
+	player := *m
+	player.ctx = ctx
+
+	return &player
 }
 
 // Play starts the media playback.
@@ -243,7 +255,7 @@ func (m *MediaPlayer) ParseMap(values map[string]dbus.Variant) (bluetooth.MediaD
 		Album:  "<Unknown Album>",
 	}
 	if t, ok := values["Track"].Value().(map[string]dbus.Variant); ok {
-		if err := dbh.DecodeVariantMap(t, &track); err != nil {
+		if err := m.State.DecodeVariantMap(t, &track); err != nil {
 			return bluetooth.MediaData{}, err
 		}
 
@@ -256,7 +268,7 @@ func (m *MediaPlayer) ParseMap(values map[string]dbus.Variant) (bluetooth.MediaD
 
 	props.TrackData = track
 
-	if err := dbh.DecodeVariantMap(values, &props); err != nil {
+	if err := m.State.DecodeVariantMap(values, &props); err != nil {
 		return bluetooth.MediaData{}, err
 	}
 
@@ -265,7 +277,7 @@ func (m *MediaPlayer) ParseMap(values map[string]dbus.Variant) (bluetooth.MediaD
 
 // check checks if the device supports media control and playback.
 func (m *MediaPlayer) check() (dbus.ObjectPath, error) {
-	devicePath, ok := dbh.PathConverter.DbusPath(dbh.DbusPathDevice, m.Address)
+	devicePath, ok := m.State.Paths.DbusPath(dbh.DbusPathDevice, m.Address)
 	if !ok {
 		return "", fault.Wrap(errorkinds.ErrDeviceNotFound,
 			fctx.With(context.Background(),
@@ -323,7 +335,7 @@ func (m *MediaPlayer) mediaPlayerProperties(player dbus.ObjectPath) (map[string]
 	result := make(map[string]dbus.Variant)
 
 	if err := m.SystemBus.Object(dbh.BluezBusName, player).
-		Call(dbh.DbusGetAllPropertiesIface, 0, dbh.BluezMediaPlayerIface).
+		CallWithContext(m.callContext(), dbh.DbusGetAllPropertiesIface, 0, dbh.BluezMediaPlayerIface).
 		Store(&result); err != nil {
 		return nil, err
 	}
@@ -336,7 +348,7 @@ func (m *MediaPlayer) mediaPlayerProperty(player dbus.ObjectPath, property strin
 	var result interface{}
 
 	if err := m.SystemBus.Object(dbh.BluezBusName, player).
-		Call(dbh.DbusGetPropertiesIface, 0, dbh.BluezMediaPlayerIface, property).
+		CallWithContext(m.callContext(), dbh.DbusGetPropertiesIface, 0, dbh.BluezMediaPlayerIface, property).
 		Store(&result); err != nil {
 		return nil, err
 	}
@@ -349,7 +361,7 @@ func (m *MediaPlayer) mediaControlProperties(devicePath dbus.ObjectPath) (map[st
 	result := make(map[string]dbus.Variant)
 
 	if err := m.SystemBus.Object(dbh.BluezBusName, devicePath).
-		Call(dbh.DbusGetAllPropertiesIface, 0, dbh.BluezMediaControlIface).
+		CallWithContext(m.callContext(), dbh.DbusGetAllPropertiesIface, 0, dbh.BluezMediaControlIface).
 		Store(&result); err != nil {
 		return nil, err
 	}
@@ -360,6 +372,15 @@ func (m *MediaPlayer) mediaControlProperties(devicePath dbus.ObjectPath) (map[st
 // callMediaPlayer is used to interact with the bluez MediaPlayer interface.
 func (m *MediaPlayer) callMediaPlayer(player dbus.ObjectPath, command string) error {
 	return m.SystemBus.Object(dbh.BluezBusName, player).
-		Call(dbh.BluezMediaPlayerIface+"."+command, 0).
+		CallWithContext(m.callContext(), dbh.BluezMediaPlayerIface+"."+command, 0).
 		Store()
 }
+
+// callContext returns the context which the media player's method calls are bound to.
+func (m *MediaPlayer) callContext() context.Context {
+	if m.ctx == nil {
+		return context.Background()
+	}
+
+	return m.ctx
+}
@@ -24,17 +24,22 @@ import (
 // Network holds the network manager and active connections.
 type Network struct {
 	Address      bluetooth.MacAddress
+	State        *dbh.SessionState
 	DeviceExists func() error
 
 	*NetManager
 	bluetooth.NetworkDunSettings
+
+	ctx context.Context
 }
 
 // NetManager holds the network manager session.
 type NetManager struct {
-	ActiveConnection xsync.MapOf[bluetooth.MacAddress, nm.ActiveConnection]
+	ActiveConnection *xsync.MapOf[bluetooth.MacAddress, nm.ActiveConnection]
 
 	nm.NetworkManager
+
+	closed chan struct{}
 }
 
 // connectionSettings holds a device's network connection settings.
@@ -58,12 +63,36 @@ func Initialize() (*NetManager, ac.Features, *ac.Error) {
 
 	network := &NetManager{
 		NetworkManager:   manager,
-		ActiveConnection: xsync.MapOf[bluetooth.MacAddress, nm.ActiveConnection]{},
+		ActiveConnection: xsync.NewMapOf[bluetooth.MacAddress, nm.ActiveConnection](),
+		closed:           make(chan struct{}),
 	}
 
 	return network, ac.FeatureNetwork, nil
 }
 
+// Close stops all pending connection attempts, and releases their
+// connection state subscriptions.
+func (n *NetManager) Close() {
+	if n == nil {
+		return
+	}
+
+	select {
+	case <-n.closed:
+	default:
+		close(n.closed)
+	}
+}
+
+// WithContext returns a copy of the network function call interface, whose
+// connection attempts are bound to the provided context.
+func (n *Network) WithContext(ctx context.Context) bluetooth.Network {
+	network := *n
+	network.ctx = ctx
+
+	return &network
+}
+
 // Connect connects to the device's network interface.
 func (n *Network) Connect(name string, nt bluetooth.NetworkType) error {
 	if err := n.check(); err != nil {
@@ -278,14 +307,35 @@ func (n *Network) activateConnection(conn nm.Connection, device nm.Device) error
 
 	n.ActiveConnection.Store(n.Address, activeConn)
 
-	for state := range activeState {
-		if state.State == nm.NmActiveConnectionStateActivating {
-			continue
-		}
+	ctx := n.callContext()
+
+WaitState:
+	for {
+		select {
+		case <-ctx.Done():
+			exit <- struct{}{}
 
-		exit <- struct{}{}
+			n.ActiveConnection.Delete(n.Address)
+			_ = n.DeactivateConnection(activeConn)
 
-		break
+			return ctx.Err()
+
+		case <-n.closed:
+			exit <- struct{}{}
+
+			n.ActiveConnection.Delete(n.Address)
+
+			return errorkinds.ErrNetworkEstablishError
+
+		case state = <-activeState:
+			if state.State == nm.NmActiveConnectionStateActivating {
+				continue
+			}
+
+			exit <- struct{}{}
+
+			break WaitState
+		}
 	}
 
 	if state.State != nm.NmActiveConnectionStateActivated {
@@ -345,6 +395,15 @@ func (n *Network) applySettings(conn nm.Connection, nt bluetooth.NetworkType) er
 	return conn.Update(settings)
 }
 
+// callContext returns the context which the network's connection attempts are bound to.
+func (n *Network) callContext() context.Context {
+	if n.ctx == nil {
+		return context.Background()
+	}
+
+	return n.ctx
+}
+
 // check checks whether the network manager was initialized.
 func (n *Network) check() error {
 	if n.NetManager == nil {
@@ -359,7 +418,7 @@ func (n *Network) check() error {
 		)
 	}
 
-	_, ok := dbh.PathConverter.DbusPath(dbh.DbusPathDevice, n.Address)
+	_, ok := n.State.Paths.DbusPath(dbh.DbusPathDevice, n.Address)
 	if !ok {
 		return fault.Wrap(errorkinds.ErrDeviceNotFound,
 			fctx.With(context.Background(),
@@ -5,6 +5,7 @@ package obex
 import (
 	"errors"
 	"path/filepath"
+	"sync"
 	"time"
 
 	bluetooth "github.com/bluetuith-org/api-native/api/bluetooth"
@@ -20,16 +21,15 @@ import (
 type agent struct {
 	authHandler bluetooth.AuthorizeReceiveFile
 
-	ctx         bluetooth.AuthTimeout
+	ctx         *bluetooth.AuthTimeout
 	authTimeout time.Duration
 
 	initialized bool
+	mu          sync.Mutex
 
 	fileTransfer
 }
 
-var obexAgent agent
-
 // AuthorizePush asks for confirmation before receiving a transfer from the host device.
 func (o *agent) AuthorizePush(transferPath dbus.ObjectPath) (string, *dbus.Error) {
 	if !o.initialized {
@@ -40,7 +40,7 @@ func (o *agent) AuthorizePush(transferPath dbus.ObjectPath) (string, *dbus.Error
 
 	sessionProperty, err := o.sessionProperties(sessionPath)
 	if err != nil {
-		dbh.PublishError(err,
+		o.State.PublishError(err,
 			"OBEX agent error: Could not get session properties",
 			"error_at", "authpush-session-properties",
 		)
@@ -50,7 +50,7 @@ func (o *agent) AuthorizePush(transferPath dbus.ObjectPath) (string, *dbus.Error
 
 	transferProperty, err := o.transferProperties(transferPath)
 	if err != nil {
-		dbh.PublishError(err,
+		o.State.PublishError(err,
 			"OBEX agent error: Could not get transfer properties",
 			"error_at", "authpush-transfer-properties",
 		)
@@ -59,7 +59,7 @@ func (o *agent) AuthorizePush(transferPath dbus.ObjectPath) (string, *dbus.Error
 	}
 
 	if sessionProperty.Root == "" {
-		dbh.PublishError(err,
+		o.State.PublishError(err,
 			"OBEX agent error: Session properties are empty",
 			"error_at", "authpush-session-rootdest",
 		)
@@ -68,7 +68,7 @@ func (o *agent) AuthorizePush(transferPath dbus.ObjectPath) (string, *dbus.Error
 	}
 
 	if transferProperty.Status == bluetooth.TransferError {
-		dbh.PublishError(err,
+		o.State.PublishError(err,
 			"OBEX agent error: Transfer property is empty",
 			"error_at", "authpush-transfer-status",
 		)
@@ -79,11 +79,16 @@ func (o *agent) AuthorizePush(transferPath dbus.ObjectPath) (string, *dbus.Error
 	transferProperty.Address = sessionProperty.Destination
 
 	path := filepath.Join(sessionProperty.Root, transferProperty.Name)
-	o.ctx = bluetooth.NewAuthTimeout(o.authTimeout)
+	timeout := bluetooth.NewAuthTimeout(o.authTimeout)
+
+	o.mu.Lock()
+	o.ctx = &timeout
+	o.mu.Unlock()
+
 	defer o.Cancel()
 
-	if err := o.authHandler.AuthorizeTransfer(o.ctx, path, transferProperty); err != nil {
-		dbh.PublishError(err,
+	if err := o.authHandler.AuthorizeTransfer(timeout, path, transferProperty); err != nil {
+		o.State.PublishError(err,
 			"OBEX agent error: Transfer was not authorized",
 			"error_at", "authpush-agent-authorize",
 		)
@@ -96,7 +101,13 @@ func (o *agent) AuthorizePush(transferPath dbus.ObjectPath) (string, *dbus.Error
 
 // Cancel is called when the OBEX agent request was cancelled.
 func (o *agent) Cancel() *dbus.Error {
-	o.Cancel()
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.ctx != nil {
+		o.ctx.Cancel()
+		o.ctx = nil
+	}
 
 	return nil
 }
@@ -107,17 +118,25 @@ func (o *agent) Release() *dbus.Error {
 }
 
 // setupAgent sets up an OBEX agent.
-func setupAgent(sessionBus *dbus.Conn, authHandler bluetooth.AuthorizeReceiveFile, authTimeout time.Duration) error {
+func setupAgent(
+	sessionBus *dbus.Conn, state *dbh.SessionState,
+	authHandler bluetooth.AuthorizeReceiveFile, authTimeout time.Duration,
+) (*agent, error) {
 	if authHandler == nil {
-		return errors.New("No authorization handler interface specified")
+		return nil, errors.New("No authorization handler interface specified")
 	}
 
-	ag := agent{authHandler: authHandler}
+	ag := &agent{
+		authHandler: authHandler,
+		authTimeout: authTimeout,
+		initialized: true,
+	}
 	ag.SessionBus = sessionBus
+	ag.State = state
 
 	err := sessionBus.Export(ag, dbh.ObexAgentPath, dbh.ObexAgentIface)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	node := &introspect.Node{
@@ -135,27 +154,28 @@ func setupAgent(sessionBus *dbus.Conn, authHandler bluetooth.AuthorizeReceiveFil
 		dbh.ObexAgentPath,
 		dbh.DbusIntrospectableIface,
 	); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := ag.callObexAgentManager("RegisterAgent", dbh.ObexAgentPath).Store(); err != nil {
-		return err
+		return nil, err
 	}
 
-	ag.authTimeout = authTimeout
-
-	obexAgent = ag
-
-	return nil
+	return ag, nil
 }
 
-// removeAgent removes the OBEX agent.
-func removeAgent() error {
-	if !obexAgent.initialized {
+// remove removes the OBEX agent.
+func (o *agent) remove() error {
+	if o == nil || !o.initialized {
 		return nil
 	}
 
-	return obexAgent.callObexAgentManager("UnregisterAgent", dbh.ObexAgentPath).Store()
+	defer func() {
+		_ = o.SessionBus.Export(nil, dbh.ObexAgentPath, dbh.ObexAgentIface)
+		_ = o.SessionBus.Export(nil, dbh.ObexAgentPath, dbh.DbusIntrospectableIface)
+	}()
+
+	return o.callObexAgentManager("UnregisterAgent", dbh.ObexAgentPath).Store()
 }
 
 // callObexAgentManager calls the OBEX AgentManager1 interface with the provided arguments.
@@ -79,7 +79,7 @@ func (o *fileTransfer) CreateSession(ctx context.Context) error {
 		}
 	}
 
-	dbh.PathConverter.AddDbusPath(dbh.DbusPathObexSession, sessionPath, o.Address)
+	o.State.Paths.AddDbusPath(dbh.DbusPathObexSession, sessionPath, o.Address)
 
 	return nil
 }
@@ -90,7 +90,7 @@ func (o *fileTransfer) RemoveSession() error {
 		return err
 	}
 
-	sessionPath, ok := dbh.PathConverter.DbusPath(dbh.DbusPathObexSession, o.Address)
+	sessionPath, ok := o.State.Paths.DbusPath(dbh.DbusPathObexSession, o.Address)
 	if !ok {
 		return fault.Wrap(
 			errorkinds.ErrPropertyDataParse,
@@ -128,7 +128,7 @@ func (o *fileTransfer) SendFile(filepath string) (bluetooth.FileTransferData, er
 
 	var fileTransferObject bluetooth.FileTransferData
 
-	sessionPath, ok := dbh.PathConverter.DbusPath(dbh.DbusPathObexSession, o.Address)
+	sessionPath, ok := o.State.Paths.DbusPath(dbh.DbusPathObexSession, o.Address)
 	if !ok {
 		return bluetooth.FileTransferData{},
 			fault.Wrap(
@@ -157,9 +157,9 @@ func (o *fileTransfer) SendFile(filepath string) (bluetooth.FileTransferData, er
 			)
 	}
 
-	dbh.PathConverter.AddDbusPath(dbh.DbusPathObexTransfer, transferPath, o.Address)
+	o.State.Paths.AddDbusPath(dbh.DbusPathObexTransfer, transferPath, o.Address)
 
-	if err := dbh.DecodeVariantMap(transferPropertyMap, &fileTransferObject); err != nil {
+	if err := o.State.DecodeVariantMap(transferPropertyMap, &fileTransferObject); err != nil {
 		return bluetooth.FileTransferData{},
 			fault.Wrap(
 				err,
@@ -181,7 +181,7 @@ func (o *fileTransfer) CancelTransfer() error {
 		return err
 	}
 
-	transferPath, ok := dbh.PathConverter.DbusPath(dbh.DbusPathObexTransfer, o.Address)
+	transferPath, ok := o.State.Paths.DbusPath(dbh.DbusPathObexTransfer, o.Address)
 	if !ok {
 		return fault.Wrap(
 			errorkinds.ErrPropertyDataParse,
@@ -215,7 +215,7 @@ func (o *fileTransfer) SuspendTransfer() error {
 		return err
 	}
 
-	transferPath, ok := dbh.PathConverter.DbusPath(dbh.DbusPathObexTransfer, o.Address)
+	transferPath, ok := o.State.Paths.DbusPath(dbh.DbusPathObexTransfer, o.Address)
 	if !ok {
 		return fault.Wrap(
 			errorkinds.ErrPropertyDataParse,
@@ -249,7 +249,7 @@ func (o *fileTransfer) ResumeTransfer() error {
 		return err
 	}
 
-	transferPath, ok := dbh.PathConverter.DbusPath(dbh.DbusPathObexTransfer, o.Address)
+	transferPath, ok := o.State.Paths.DbusPath(dbh.DbusPathObexTransfer, o.Address)
 	if !ok {
 		return fault.Wrap(
 			errorkinds.ErrPropertyDataParse,
@@ -290,7 +290,7 @@ func (o *fileTransfer) check() error {
 		)
 	}
 
-	_, ok := dbh.PathConverter.DbusPath(dbh.DbusPathDevice, o.Address)
+	_, ok := o.State.Paths.DbusPath(dbh.DbusPathDevice, o.Address)
 	if !ok {
 		return fault.Wrap(errorkinds.ErrDeviceNotFound,
 			fctx.With(context.Background(),
@@ -340,7 +340,7 @@ func (o *fileTransfer) sessionProperties(sessionPath dbus.ObjectPath) (obexSessi
 		return obexSessionProperties{}, err
 	}
 
-	return sessionProperties, dbh.DecodeVariantMap(props, &sessionProperties)
+	return sessionProperties, o.State.DecodeVariantMap(props, &sessionProperties)
 }
 
 // transferProperties converts a map of OBEX transfer properties to FileTransferData.
@@ -354,5 +354,5 @@ func (o *fileTransfer) transferProperties(transferPath dbus.ObjectPath) (bluetoo
 		return bluetooth.FileTransferData{}, err
 	}
 
-	return transferProperties, dbh.DecodeVariantMap(props, &transferProperties)
+	return transferProperties, o.State.DecodeVariantMap(props, &transferProperties)
 }
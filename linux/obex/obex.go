@@ -17,9 +17,20 @@ import (
 // Obex describes a Bluez Obex session.
 type Obex struct {
 	SessionBus *dbus.Conn
+	State      *dbh.SessionState
 
 	Address      bluetooth.MacAddress
 	DeviceExists func() error
+
+	// OnServiceChange is called when the OBEX daemon exits or (re)starts,
+	// with the currently available OBEX features.
+	OnServiceChange func(running bool, features ac.Features, cerr *ac.Error)
+
+	authHandler bluetooth.AuthorizeReceiveFile
+	authTimeout time.Duration
+
+	agent   *agent
+	watcher *dbh.SignalWatcher
 }
 
 // Initialize attempts to initialize the Obex Agent, and returns the capabilities of the
@@ -27,6 +38,8 @@ type Obex struct {
 func (o *Obex) Initialize(auth bluetooth.AuthorizeReceiveFile, authTimeout time.Duration) (ac.Features, *ac.Error) {
 	var capabilities ac.Features
 
+	o.authHandler, o.authTimeout = auth, authTimeout
+
 	serviceNames, err := dbh.ListActivatableBusNames(o.SessionBus)
 	if err != nil {
 		return capabilities,
@@ -46,46 +59,91 @@ func (o *Obex) Initialize(auth bluetooth.AuthorizeReceiveFile, authTimeout time.
 		)
 
 SetupAgent:
-	go o.watchObexSystemBus()
+	o.watchObexSystemBus()
 
 	capabilities = ac.FeatureSendFile
-	if err := setupAgent(o.SessionBus, auth, authTimeout); err != nil {
+
+	agent, err := setupAgent(o.SessionBus, o.State, auth, authTimeout)
+	if err != nil {
 		return capabilities,
 			ac.NewError(ac.FeatureReceiveFile, err)
 	}
 
+	o.agent = agent
+
 	capabilities |= ac.FeatureReceiveFile
 
 	return capabilities, nil
 }
 
-// Remove removes the obex agent and closes the obex session.
+// Remove removes the obex agent, stops watching for OBEX events,
+// and closes the obex session.
 func (o *Obex) Remove() error {
-	return removeAgent()
+	defer o.watcher.Stop()
+
+	return o.agent.remove()
 }
 
 // FileTransfer returns a function call interface to invoke device file transfer
 // related functions.
 func (o *Obex) FileTransfer() bluetooth.ObexFileTransfer {
-	return &fileTransfer{SessionBus: o.SessionBus, Address: o.Address}
+	return &fileTransfer{SessionBus: o.SessionBus, State: o.State, Address: o.Address}
+}
+
+// serviceChanged calls the service change handler, if it is set.
+func (o *Obex) serviceChanged(running bool, features ac.Features, cerr *ac.Error) {
+	if o.OnServiceChange != nil {
+		o.OnServiceChange(running, features, cerr)
+	}
 }
 
 // watchObexSystemBus will register a signal and watch for events from the OBEX DBus interface.
 func (o *Obex) watchObexSystemBus() {
-	signalMatch := "type='signal', sender='org.bluez.obex'"
-	o.SessionBus.BusObject().Call("org.freedesktop.DBus.AddMatch", 0, signalMatch)
-
-	ch := make(chan *dbus.Signal, 1)
-	o.SessionBus.Signal(ch)
-
-	for signal := range ch {
-		o.parseSignalData(signal)
-	}
+	o.watcher = dbh.WatchSignals(o.SessionBus, o.parseSignalData,
+		"type='signal', sender='org.bluez.obex'",
+		"type='signal', sender='org.freedesktop.DBus', member='NameOwnerChanged', arg0='org.bluez.obex'",
+	)
 }
 
 // parseSignalData parses OBEX DBus signal data.
 func (o *Obex) parseSignalData(signal *dbus.Signal) {
 	switch signal.Name {
+	case dbh.DbusSignalNameOwnerChangedIface:
+		if len(signal.Body) != 3 {
+			return
+		}
+
+		name, _ := signal.Body[0].(string)
+		oldOwner, _ := signal.Body[1].(string)
+		newOwner, _ := signal.Body[2].(string)
+
+		if name != dbh.ObexBusName {
+			return
+		}
+
+		if oldOwner != "" {
+			o.State.Paths.Clear(dbh.DbusPathObexSession, dbh.DbusPathObexTransfer)
+			o.serviceChanged(false, ac.FeatureNone,
+				ac.NewError(ac.FeatureSendFile|ac.FeatureReceiveFile, errors.New("OBEX Service exited")),
+			)
+		}
+
+		if newOwner != "" {
+			agent, err := setupAgent(o.SessionBus, o.State, o.authHandler, o.authTimeout)
+			if err != nil {
+				o.State.PublishError(err,
+					"Obex session error: Cannot register agent after daemon restart",
+					"error_at", "restore-obex-agent",
+				)
+				o.serviceChanged(true, ac.FeatureSendFile, ac.NewError(ac.FeatureReceiveFile, err))
+
+				return
+			}
+
+			o.agent = agent
+			o.serviceChanged(true, ac.FeatureSendFile|ac.FeatureReceiveFile, nil)
+		}
+
 	case dbh.DbusSignalPropertyChangedIface:
 		objectInterfaceName, ok := signal.Body[0].(string)
 		if !ok {
@@ -101,9 +159,9 @@ func (o *Obex) parseSignalData(signal *dbus.Signal) {
 		case dbh.ObexTransferIface:
 			sessionPath := dbus.ObjectPath(filepath.Dir(string(signal.Path)))
 
-			address, ok := dbh.PathConverter.Address(dbh.DbusPathObexSession, sessionPath)
+			address, ok := o.State.Paths.Address(dbh.DbusPathObexSession, sessionPath)
 			if !ok {
-				dbh.PublishSignalError(errorkinds.ErrDeviceNotFound, signal,
+				o.State.PublishSignalError(errorkinds.ErrDeviceNotFound, signal,
 					"Obex event handler error",
 					"error_at", "pchanged-obex-address",
 				)
@@ -114,11 +172,11 @@ func (o *Obex) parseSignalData(signal *dbus.Signal) {
 			transferData := bluetooth.FileTransferEventData{
 				Address: address,
 			}
-			if err := dbh.DecodeVariantMap(
+			if err := o.State.DecodeVariantMap(
 				propertyMap, &transferData,
 				"Status", "Transferred",
 			); err != nil {
-				dbh.PublishSignalError(err, signal,
+				o.State.PublishSignalError(err, signal,
 					"Obex event handler error",
 					"error_at", "pchanged-obex-decode",
 				)
@@ -126,7 +184,7 @@ func (o *Obex) parseSignalData(signal *dbus.Signal) {
 				return
 			}
 
-			bluetooth.FileTransferEvent(bluetooth.EventActionUpdated).Publish(transferData)
+			bluetooth.FileTransferEvent(bluetooth.EventActionUpdated).On(o.State.Emitter).Publish(transferData)
 		}
 
 	case dbh.DbusSignalInterfacesRemovedIface:
@@ -143,10 +201,10 @@ func (o *Obex) parseSignalData(signal *dbus.Signal) {
 		for _, ifaceName := range ifaceNames {
 			switch ifaceName {
 			case dbh.ObexSessionIface:
-				dbh.PathConverter.RemoveDbusPath(dbh.DbusPathObexSession, objectPath)
+				o.State.Paths.RemoveDbusPath(dbh.DbusPathObexSession, objectPath)
 
 			case dbh.ObexTransferIface:
-				dbh.PathConverter.RemoveDbusPath(dbh.DbusPathObexTransfer, objectPath)
+				o.State.Paths.RemoveDbusPath(dbh.DbusPathObexTransfer, objectPath)
 			}
 		}
 	}
@@ -0,0 +1,161 @@
+//go:build linux
+
+package linux
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/config"
+	"github.com/bluetuith-org/api-native/linux/bluezmock"
+	dbh "github.com/bluetuith-org/api-native/linux/internal/dbushelper"
+	"github.com/godbus/dbus/v5"
+)
+
+// startReconnectSession starts a session which reconnects the test device automatically,
+// and returns a channel which receives the paths of the devices that are connected to.
+func startReconnectSession(t *testing.T) (*BluezSession, *bluezmock.Bluez, <-chan dbus.ObjectPath) {
+	t.Helper()
+
+	cfg := config.New()
+	cfg.AutoReconnect = config.AutoReconnect{
+		Devices:      []string{testDeviceAddress},
+		MaxAttempts:  1,
+		InitialDelay: time.Millisecond,
+	}
+
+	session, mock := startTestSessionWithConfig(t, nil, cfg)
+
+	connects := make(chan dbus.ObjectPath, 10)
+	mock.HandleMethod(dbh.BluezDeviceIface, "Connect", func(path dbus.ObjectPath, _ ...interface{}) *dbus.Error {
+		connects <- path
+
+		return nil
+	})
+
+	return session, mock, connects
+}
+
+// sleepSignal returns a logind PrepareForSleep signal.
+func sleepSignal(sleeping bool) *dbus.Signal {
+	return &dbus.Signal{
+		Sender: dbh.LogindBusName,
+		Name:   dbh.LogindSignalPrepareForSleep,
+		Body:   []interface{}{sleeping},
+	}
+}
+
+func TestReconnectAfterResume(t *testing.T) {
+	session, _, connects := startReconnectSession(t)
+	devicePath := bluezmock.DevicePath("/org/bluez/hci0", testDeviceAddress)
+
+	session.parseSignalData(sleepSignal(true))
+	session.parseSignalData(sleepSignal(false))
+
+	select {
+	case path := <-connects:
+		if path != devicePath {
+			t.Errorf("Connected to %s after resume, want %s", path, devicePath)
+		}
+
+	case <-time.After(eventTimeout):
+		t.Fatal("Device was not reconnected after resume")
+	}
+
+	select {
+	case path := <-connects:
+		t.Errorf("Connected to %s again, want a single reconnection", path)
+
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestReconnectKeepsDeviceHeld(t *testing.T) {
+	session, _, connects := startReconnectSession(t)
+	address := mustParseMAC(t, testDeviceAddress)
+
+	// Reconnecting on behalf of the manager must not release a held device.
+	session.reconnectManager().Hold(address)
+	if err := session.reconnectDevice(context.Background(), address, bluetooth.ConnectRetryPolicy{MaxAttempts: 1}); err != nil {
+		t.Fatalf("reconnectDevice() returned error: %v", err)
+	}
+	<-connects
+
+	session.parseSignalData(sleepSignal(false))
+
+	select {
+	case path := <-connects:
+		t.Errorf("Held device %s was reconnected after resume", path)
+
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestStopWaitsForReconnect(t *testing.T) {
+	session, _, connects := startReconnectSession(t)
+
+	session.parseSignalData(sleepSignal(false))
+	<-connects
+
+	manager := session.reconnectManager()
+	if err := session.Stop(); err != nil {
+		t.Fatalf("Stop() returned error: %v", err)
+	}
+
+	if session.reconnectManager() != nil {
+		t.Error("The reconnection manager is still set after Stop()")
+	}
+
+	// Resuming after the session is stopped must not start a reconnection.
+	manager.Resumed()
+
+	select {
+	case path := <-connects:
+		t.Errorf("Connected to %s after Stop()", path)
+
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestFailedDisconnectReleasesDevice(t *testing.T) {
+	session, mock, connects := startReconnectSession(t)
+	address := mustParseMAC(t, testDeviceAddress)
+
+	mock.HandleMethod(dbh.BluezDeviceIface, "Disconnect", func(dbus.ObjectPath, ...interface{}) *dbus.Error {
+		return bluezmock.NewError(bluezmock.ErrorFailed, "Not Connected")
+	})
+
+	if err := session.Device(address).Disconnect(); err == nil {
+		t.Fatal("Disconnect() returned no error")
+	}
+
+	// The device could not be disconnected, so it must still be reconnected after resume.
+	session.parseSignalData(sleepSignal(false))
+
+	select {
+	case <-connects:
+
+	case <-time.After(eventTimeout):
+		t.Fatal("Device was not reconnected after a failed disconnection")
+	}
+}
+
+func TestDisconnectHoldsDevice(t *testing.T) {
+	session, _, connects := startReconnectSession(t)
+	address := mustParseMAC(t, testDeviceAddress)
+
+	if err := session.Device(address).Disconnect(); err != nil {
+		t.Fatalf("Disconnect() returned error: %v", err)
+	}
+
+	session.parseSignalData(sleepSignal(false))
+
+	select {
+	case path := <-connects:
+		t.Errorf("Disconnected device %s was reconnected after resume", path)
+
+	case <-time.After(200 * time.Millisecond):
+	}
+}
@@ -0,0 +1,6 @@
+/*
+Package rfkill provides functionality to read and watch the
+soft-blocked and hard-blocked state of the Bluetooth radios
+via the Linux rfkill subsystem (/dev/rfkill), and to unblock them.
+*/
+package rfkill
@@ -0,0 +1,320 @@
+//go:build linux
+
+package rfkill
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The sizes of an rfkill event. EventSize is the size of the original
+// rfkill_event, and EventSizeExt is the size of rfkill_event_ext, which
+// newer kernels use, and which appends a "hard block reasons" field.
+//
+// A read of EventSize bytes from /dev/rfkill always returns a single event,
+// since the kernel truncates the event to the size of the read. A stream of
+// recorded events however has to be read with the size it was recorded with.
+const (
+	EventSize    = 8
+	EventSizeExt = 9
+)
+
+// The paths to the rfkill device and its sysfs class directory.
+const (
+	devicePath = "/dev/rfkill"
+	sysfsPath  = "/sys/class/rfkill"
+)
+
+// Type describes the type of an rfkill device.
+type Type uint8
+
+// The different rfkill device types.
+// Only Bluetooth devices are tracked by the Watcher.
+const (
+	TypeAll Type = iota // The zero value for this type.
+	TypeWLAN
+	TypeBluetooth
+)
+
+// Op describes the operation of an rfkill event.
+type Op uint8
+
+// The different rfkill event operations.
+const (
+	OpAdd Op = iota // The zero value for this type.
+	OpDelete
+	OpChange
+	OpChangeAll
+)
+
+// Event describes an rfkill event.
+type Event struct {
+	Index uint32
+	Type  Type
+	Op    Op
+	Soft  bool
+	Hard  bool
+}
+
+// Device holds the blocked state of a Bluetooth rfkill device.
+type Device struct {
+	// Index holds the rfkill index of the device.
+	Index uint32
+
+	// Name holds the name of the device, for example "hci0".
+	Name string
+
+	// Soft indicates whether the device is soft-blocked, for example via
+	// the "rfkill block" command or the desktop's airplane mode.
+	Soft bool
+
+	// Hard indicates whether the device is hard-blocked, for example via
+	// a hardware airplane mode switch. This cannot be unblocked via software.
+	Hard bool
+}
+
+// Watcher watches the rfkill events of all Bluetooth devices.
+type Watcher struct {
+	file *os.File
+
+	devices map[uint32]Device
+	changed chan struct{}
+	mu      sync.Mutex
+
+	done chan struct{}
+}
+
+// ErrInvalidEvent is returned when an rfkill event cannot be parsed.
+var ErrInvalidEvent = errors.New("invalid rfkill event")
+
+// ParseEvent parses a single rfkill event from the provided bytes.
+func ParseEvent(b []byte) (Event, error) {
+	if len(b) < EventSize {
+		return Event{}, ErrInvalidEvent
+	}
+
+	return Event{
+		Index: binary.NativeEndian.Uint32(b[0:4]),
+		Type:  Type(b[4]),
+		Op:    Op(b[5]),
+		Soft:  b[6] != 0,
+		Hard:  b[7] != 0,
+	}, nil
+}
+
+// ReadEvents reads rfkill events of the provided size from the provided reader, and calls
+// eventfn for each event, until the reader returns an error. The reader can either be the
+// rfkill device, which returns one event per read, or a stream of recorded events, each of
+// which is size bytes long. Any fields after the first EventSize bytes of an event are ignored.
+// If the reader reaches its end, nil is returned.
+func ReadEvents(r io.Reader, size int, eventfn func(Event)) error {
+	if size < EventSize {
+		return ErrInvalidEvent
+	}
+
+	buf := make([]byte, size)
+
+	for {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		event, err := ParseEvent(buf)
+		if err != nil {
+			return err
+		}
+
+		eventfn(event)
+	}
+}
+
+// Watch reads the current state of all Bluetooth rfkill devices, and starts watching
+// for changes to their state. The changefn function is called each time a device
+// is added or its state changes. An error is returned if the rfkill device cannot be opened.
+func Watch(changefn func(Device)) (*Watcher, error) {
+	file, err := os.OpenFile(devicePath, os.O_RDWR, 0)
+	if err != nil {
+		file, err = os.Open(devicePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	w := &Watcher{
+		file:    file,
+		devices: make(map[uint32]Device),
+		changed: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	w.readSysfs()
+
+	go func() {
+		defer close(w.done)
+
+		_ = ReadEvents(file, EventSize, func(event Event) {
+			if device, ok := w.update(event); ok && changefn != nil {
+				changefn(device)
+			}
+		})
+	}()
+
+	return w, nil
+}
+
+// Device returns the state of the Bluetooth rfkill device with the provided name.
+func (w *Watcher) Device(name string) (Device, bool) {
+	if w == nil {
+		return Device{}, false
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, device := range w.devices {
+		if device.Name == name {
+			return device, true
+		}
+	}
+
+	return Device{}, false
+}
+
+// Unblock removes the soft-block on the Bluetooth rfkill device with the provided name,
+// and waits until the device is unblocked or the context is done.
+func (w *Watcher) Unblock(ctx context.Context, name string) error {
+	device, ok := w.Device(name)
+	if !ok {
+		return errors.New("no rfkill device found for " + name)
+	}
+
+	if !device.Soft {
+		return nil
+	}
+
+	event := make([]byte, EventSize)
+	binary.NativeEndian.PutUint32(event[0:4], device.Index)
+	event[4] = byte(TypeBluetooth)
+	event[5] = byte(OpChange)
+
+	if _, err := w.file.Write(event); err != nil {
+		return err
+	}
+
+	for {
+		w.mu.Lock()
+		device, ok := w.devices[device.Index]
+		changed := w.changed
+		w.mu.Unlock()
+
+		if !ok {
+			return errors.New("rfkill device " + name + " was removed")
+		}
+
+		if !device.Soft {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-changed:
+		}
+	}
+}
+
+// Close stops watching for rfkill events.
+func (w *Watcher) Close() error {
+	if w == nil {
+		return nil
+	}
+
+	err := w.file.Close()
+	<-w.done
+
+	return err
+}
+
+// update updates the state of a device from an rfkill event, and returns the
+// updated device if the event is associated with a Bluetooth device.
+func (w *Watcher) update(event Event) (Device, bool) {
+	if event.Type != TypeBluetooth {
+		return Device{}, false
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	defer func() {
+		close(w.changed)
+		w.changed = make(chan struct{})
+	}()
+
+	if event.Op == OpDelete {
+		delete(w.devices, event.Index)
+
+		return Device{}, false
+	}
+
+	device, ok := w.devices[event.Index]
+	if !ok {
+		device = Device{Index: event.Index, Name: readName(event.Index)}
+	}
+
+	device.Soft, device.Hard = event.Soft, event.Hard
+	w.devices[event.Index] = device
+
+	return device, true
+}
+
+// readSysfs reads the initial state of all Bluetooth rfkill devices from sysfs.
+func (w *Watcher) readSysfs() {
+	dirs, err := filepath.Glob(filepath.Join(sysfsPath, "rfkill*"))
+	if err != nil {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, dir := range dirs {
+		index, err := strconv.ParseUint(strings.TrimPrefix(filepath.Base(dir), "rfkill"), 10, 32)
+		if err != nil || readAttribute(dir, "type") != "bluetooth" {
+			continue
+		}
+
+		w.devices[uint32(index)] = Device{
+			Index: uint32(index),
+			Name:  readAttribute(dir, "name"),
+			Soft:  readAttribute(dir, "soft") == "1",
+			Hard:  readAttribute(dir, "hard") == "1",
+		}
+	}
+}
+
+// readName returns the name of the rfkill device with the provided index.
+func readName(index uint32) string {
+	return readAttribute(filepath.Join(sysfsPath, "rfkill"+strconv.FormatUint(uint64(index), 10)), "name")
+}
+
+// readAttribute returns the value of a sysfs attribute of an rfkill device.
+func readAttribute(dir, name string) string {
+	value, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(value))
+}
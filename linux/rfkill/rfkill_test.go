@@ -0,0 +1,123 @@
+//go:build linux
+
+package rfkill
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// record encodes an rfkill event of the provided size, as the kernel would.
+func record(size int, index uint32, typ Type, op Op, soft, hard bool) []byte {
+	b := make([]byte, size)
+	binary.NativeEndian.PutUint32(b[0:4], index)
+	b[4], b[5] = byte(typ), byte(op)
+
+	if soft {
+		b[6] = 1
+	}
+
+	if hard {
+		b[7] = 1
+		if size > EventSize {
+			b[8] = 1 // RFKILL_HARD_BLOCK_SIGNAL
+		}
+	}
+
+	return b
+}
+
+func TestReadEvents(t *testing.T) {
+	want := []Event{
+		{Index: 0, Type: TypeBluetooth, Op: OpAdd},
+		{Index: 1, Type: TypeWLAN, Op: OpAdd, Soft: true},
+		{Index: 0, Type: TypeBluetooth, Op: OpChange, Soft: true},
+		{Index: 0, Type: TypeBluetooth, Op: OpChange, Soft: true, Hard: true},
+		{Index: 0, Type: TypeBluetooth, Op: OpDelete},
+	}
+
+	for _, size := range []int{EventSize, EventSizeExt} {
+		var stream bytes.Buffer
+		for _, event := range want {
+			stream.Write(record(size, event.Index, event.Type, event.Op, event.Soft, event.Hard))
+		}
+
+		var got []Event
+		if err := ReadEvents(&stream, size, func(event Event) { got = append(got, event) }); err != nil {
+			t.Fatalf("ReadEvents(size %d) returned error: %v", size, err)
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadEvents(size %d) = %+v, want %+v", size, got, want)
+		}
+	}
+}
+
+func TestReadEventsInvalid(t *testing.T) {
+	if err := ReadEvents(bytes.NewReader(nil), EventSize-1, func(Event) {}); !errors.Is(err, ErrInvalidEvent) {
+		t.Errorf("ReadEvents with a short event size returned %v, want %v", err, ErrInvalidEvent)
+	}
+
+	truncated := record(EventSize, 0, TypeBluetooth, OpAdd, false, false)[:EventSize-2]
+	if err := ReadEvents(bytes.NewReader(truncated), EventSize, func(Event) {}); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("ReadEvents with a truncated event returned %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestWatcherUpdate(t *testing.T) {
+	w := &Watcher{
+		devices: make(map[uint32]Device),
+		changed: make(chan struct{}),
+	}
+
+	events := []struct {
+		name   string
+		event  Event
+		want   Device
+		wantOk bool
+	}{
+		{
+			name:   "Add",
+			event:  Event{Index: 2, Type: TypeBluetooth, Op: OpAdd},
+			want:   Device{Index: 2},
+			wantOk: true,
+		},
+		{
+			name:   "Change",
+			event:  Event{Index: 2, Type: TypeBluetooth, Op: OpChange, Soft: true},
+			want:   Device{Index: 2, Soft: true},
+			wantOk: true,
+		},
+		{
+			name:  "NonBluetooth",
+			event: Event{Index: 3, Type: TypeWLAN, Op: OpAdd, Hard: true},
+		},
+		{
+			name:  "Delete",
+			event: Event{Index: 2, Type: TypeBluetooth, Op: OpDelete},
+		},
+	}
+
+	for _, test := range events {
+		t.Run(test.name, func(t *testing.T) {
+			test.want.Name = readName(test.event.Index)
+
+			device, ok := w.update(test.event)
+			if ok != test.wantOk || (ok && device != test.want) {
+				t.Errorf("update(%+v) = %+v, %v, want %+v, %v", test.event, device, ok, test.want, test.wantOk)
+			}
+		})
+	}
+
+	if _, ok := w.devices[3]; ok {
+		t.Error("A non-Bluetooth device is tracked")
+	}
+
+	if _, ok := w.devices[2]; ok {
+		t.Error("A deleted device is still tracked")
+	}
+}
@@ -5,6 +5,7 @@ package linux
 import (
 	"context"
 	"path/filepath"
+	"sync"
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fctx"
@@ -14,22 +15,43 @@ import (
 	bluetooth "github.com/bluetuith-org/api-native/api/bluetooth"
 	"github.com/bluetuith-org/api-native/api/config"
 	errorkinds "github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/api/eventbus"
+	"github.com/bluetuith-org/api-native/api/helpers/discovery"
+	"github.com/bluetuith-org/api-native/api/helpers/reconnect"
 	sstore "github.com/bluetuith-org/api-native/api/helpers/sessionstore"
 	dbh "github.com/bluetuith-org/api-native/linux/internal/dbushelper"
 	mp "github.com/bluetuith-org/api-native/linux/mediaplayer"
 	nm "github.com/bluetuith-org/api-native/linux/networkmanager"
 	"github.com/bluetuith-org/api-native/linux/obex"
+	"github.com/bluetuith-org/api-native/linux/rfkill"
 	"github.com/godbus/dbus/v5"
 )
 
+// bluezFeatures holds the features which are provided by the Bluez daemon.
+const bluezFeatures = ac.FeatureConnection | ac.FeaturePairing | ac.FeatureMediaPlayer
+
 // BluezSession describes a Linux Bluez DBus session.
 type BluezSession struct {
 	systemBus  *dbus.Conn
 	sessionBus *dbus.Conn
 
-	netman *nm.NetManager
+	netman  *nm.NetManager
+	agent   *agent
+	obexs   *obex.Obex
+	watcher *dbh.SignalWatcher
+	rfkill  *rfkill.Watcher
 
 	store sstore.SessionStore
+	state *dbh.SessionState
+	scans *discovery.Scans
+
+	reconnect *reconnect.Manager
+
+	authHandler bluetooth.SessionAuthorizer
+	cfg         config.Configuration
+
+	features ac.FeatureSet
+	mu       sync.Mutex
 }
 
 // Start attempts to initialize and start interfacing with the Bluez daemon via DBus.
@@ -44,7 +66,7 @@ func (b *BluezSession) Start(
 		authHandler = &bluetooth.DefaultAuthorizer{}
 	}
 
-	systemBus, err := dbus.SystemBus()
+	systemBus, err := dbus.ConnectSystemBus()
 	if err != nil {
 		return ac.NilFeatureSet(),
 			fault.Wrap(err,
@@ -54,8 +76,10 @@ func (b *BluezSession) Start(
 			)
 	}
 
-	sessionBus, err := dbus.SessionBus()
+	sessionBus, err := dbus.ConnectSessionBus()
 	if err != nil {
+		_ = systemBus.Close()
+
 		return ac.NilFeatureSet(),
 			fault.Wrap(err,
 				fctx.With(context.Background(), "error_at", "start-sessionbus"),
@@ -65,12 +89,29 @@ func (b *BluezSession) Start(
 	}
 
 	*b = BluezSession{
-		systemBus:  systemBus,
-		sessionBus: sessionBus,
-		store:      sstore.NewSessionStore(),
+		systemBus:   systemBus,
+		sessionBus:  sessionBus,
+		store:       sstore.NewSessionStore(),
+		state:       dbh.NewSessionState(cfg.EventEmitter),
+		scans:       discovery.NewScans(),
+		authHandler: authHandler,
+		cfg:         cfg,
 	}
 
+	b.setSessionState(bluetooth.SessionStarting, nil)
+
+	// The rfkill subsystem is optional, and if it cannot be accessed,
+	// the adapters are assumed to be unblocked.
+	if watcher, err := rfkill.Watch(b.rfkillChanged); err == nil {
+		b.rfkill = watcher
+	}
+
+	b.store.WaitInitialize()
+	b.watchBluezSystemBus()
+
 	if err := b.refreshStore(); err != nil {
+		_ = b.Stop()
+
 		return ac.NilFeatureSet(),
 			fault.Wrap(err,
 				fctx.With(context.Background(), "error_at", "refresh-sessionstore"),
@@ -79,7 +120,12 @@ func (b *BluezSession) Start(
 			)
 	}
 
-	if err := setupAgent(systemBus, authHandler, cfg.AuthTimeout); err != nil {
+	b.refreshDefaultAdapter()
+
+	agent, err := setupAgent(systemBus, b.state, authHandler, cfg)
+	if err != nil {
+		_ = b.Stop()
+
 		return ac.NilFeatureSet(),
 			fault.Wrap(err,
 				fctx.With(context.Background(), "error_at", "agent-initialize"),
@@ -88,13 +134,19 @@ func (b *BluezSession) Start(
 			)
 	}
 
-	capabilities.Add(
-		ac.FeatureConnection,
-		ac.FeaturePairing,
-		ac.FeatureMediaPlayer,
-	)
+	b.mu.Lock()
+	b.agent = agent
+	b.mu.Unlock()
 
-	obexcap, cerr := b.obex().Initialize(authHandler, cfg.AuthTimeout)
+	capabilities.Add(bluezFeatures)
+
+	b.obexs = &obex.Obex{
+		SessionBus:      b.sessionBus,
+		State:           b.state,
+		OnServiceChange: b.obexServiceChanged,
+	}
+
+	obexcap, cerr := b.obexs.Initialize(authHandler, cfg.AuthTimeout)
 	if cerr != nil {
 		ce.Append(cerr)
 	}
@@ -108,14 +160,86 @@ func (b *BluezSession) Start(
 
 	capabilities |= obexcap | netcap
 
-	return ac.NewFeatureSet(capabilities, ce), nil
+	features := ac.NewFeatureSet(capabilities, ce)
+
+	state := bluetooth.SessionReady
+	if _, ok := ce.Exists(); ok {
+		state = bluetooth.SessionDegraded
+	}
+
+	b.setSessionState(state, func(fs *ac.FeatureSet) {
+		*fs = features.Clone()
+	})
+
+	manager := reconnect.NewManager(b, cfg.AutoReconnect, b.reconnectDevice)
+
+	b.mu.Lock()
+	b.reconnect = manager
+	b.mu.Unlock()
+
+	manager.Start()
+
+	return features, nil
 }
 
 // Stop attempts to stop interfacing with the Bluez daemon.
+// All agents and signal subscriptions are released, and all subscriptions
+// which were made via the session's event emitter (see Events) are closed.
+// The session can be started again after it is stopped.
 func (b *BluezSession) Stop() error {
-	_ = removeAgent()
+	if b.systemBus == nil {
+		return fault.Wrap(errorkinds.ErrSessionStop,
+			fctx.With(context.Background(), "error_at", "stop-session"),
+			ftag.With(ftag.Internal),
+			fmsg.With("Session is not started"),
+		)
+	}
+
+	b.setSessionState(bluetooth.SessionStopping, nil)
+
+	// The reconnection manager is stopped before the buses are closed, since
+	// its pending reconnections use the system bus until they return.
+	b.mu.Lock()
+	manager := b.reconnect
+	b.reconnect = nil
+	b.mu.Unlock()
+
+	manager.Stop()
+
+	// The signal watcher is stopped before anything is torn down, so that its handlers
+	// cannot register the agent again or repopulate the store while the session stops.
+	b.watcher.Stop()
+
+	// The agent is registered again by the signal watcher if the daemon restarts,
+	// so it is read under the lock.
+	b.mu.Lock()
+	agent := b.agent
+	b.agent = nil
+	b.mu.Unlock()
+
+	_ = agent.remove()
+	if b.obexs != nil {
+		_ = b.obexs.Remove()
+	}
+
+	b.netman.Close()
+	_ = b.rfkill.Close()
+
+	b.store.Clear()
+	b.scans.Reset()
+	b.state.Paths.Clear(
+		dbh.DbusPathAdapter, dbh.DbusPathDevice,
+		dbh.DbusPathObexSession, dbh.DbusPathObexTransfer,
+	)
+	b.state.Emitter.CloseSubscriptions()
+
+	sessionBus, systemBus := b.sessionBus, b.systemBus
+	b.sessionBus, b.systemBus = nil, nil
+	b.obexs, b.netman, b.watcher, b.rfkill = nil, nil, nil, nil
+
+	if err := sessionBus.Close(); err != nil {
+		_ = systemBus.Close()
 
-	if err := b.sessionBus.Close(); err != nil {
 		return fault.Wrap(err,
 			fctx.With(context.Background(), "error_at", "stop-sessionbus"),
 			ftag.With(ftag.Internal),
@@ -123,7 +247,7 @@ func (b *BluezSession) Stop() error {
 		)
 	}
 
-	if err := b.systemBus.Close(); err != nil {
+	if err := systemBus.Close(); err != nil {
 		return fault.Wrap(err,
 			fctx.With(context.Background(), "error_at", "stop-systembus"),
 			ftag.With(ftag.Internal),
@@ -134,11 +258,34 @@ func (b *BluezSession) Stop() error {
 	return nil
 }
 
+// Events returns the event emitter which the session publishes its events to.
+func (b *BluezSession) Events() *eventbus.Emitter {
+	if b.state == nil {
+		return eventbus.DefaultEmitter()
+	}
+
+	return b.state.Emitter
+}
+
 // Adapters returns a list of known adapters.
 func (b *BluezSession) Adapters() []bluetooth.AdapterData {
 	return b.store.Adapters()
 }
 
+// DefaultAdapter returns the default adapter.
+func (b *BluezSession) DefaultAdapter() (bluetooth.AdapterData, error) {
+	adapter, err := b.store.DefaultAdapter(b.cfg.DefaultAdapter)
+	if err != nil {
+		return bluetooth.AdapterData{}, fault.Wrap(err,
+			fctx.With(context.Background(), "error_at", "default-adapter"),
+			ftag.With(ftag.NotFound),
+			fmsg.With("No adapters exist"),
+		)
+	}
+
+	return adapter, nil
+}
+
 // Adapter returns a function call interface to invoke adapter related functions.
 func (b *BluezSession) Adapter(adapterAddress bluetooth.MacAddress) bluetooth.Adapter {
 	return &adapter{b: b, Address: adapterAddress}
@@ -151,17 +298,17 @@ func (b *BluezSession) Device(deviceAddress bluetooth.MacAddress) bluetooth.Devi
 
 // Obex returns a function call interface to invoke obex related functions.
 func (b *BluezSession) Obex(deviceAddress bluetooth.MacAddress) bluetooth.Obex {
-	return &obex.Obex{SessionBus: b.sessionBus, Address: deviceAddress}
+	return &obex.Obex{SessionBus: b.sessionBus, State: b.state, Address: deviceAddress}
 }
 
 // Network returns a function call interface to invoke network related functions.
 func (b *BluezSession) Network(deviceAddress bluetooth.MacAddress) bluetooth.Network {
-	return &nm.Network{NetManager: b.netman, Address: deviceAddress}
+	return &nm.Network{NetManager: b.netman, State: b.state, Address: deviceAddress}
 }
 
 // MediaPlayer returns a function call interface to invoke mediaplayer related functions.
 func (b *BluezSession) MediaPlayer(deviceAddress bluetooth.MacAddress) bluetooth.MediaPlayer {
-	return &mp.MediaPlayer{SystemBus: b.systemBus, Address: deviceAddress}
+	return &mp.MediaPlayer{SystemBus: b.systemBus, State: b.state, Address: deviceAddress}
 }
 
 // adapter returns an adapter-related function call interface for internal use.
@@ -176,23 +323,16 @@ func (b *BluezSession) device(path dbus.ObjectPath) *device {
 	return &device{b: b, path: path}
 }
 
-// obex returns an obex-related function call interface for internal use.
-// This is used primarily to initialize obex objects.
-func (b *BluezSession) obex() *obex.Obex {
-	return &obex.Obex{SessionBus: b.sessionBus}
-}
-
 // mediaPlayer returns an mediaplayer-related function call interface for internal use.
 // This is used primarily to initialize mediaPlayer objects.
 func (b *BluezSession) mediaPlayer() *mp.MediaPlayer {
-	return &mp.MediaPlayer{SystemBus: b.systemBus}
+	return &mp.MediaPlayer{SystemBus: b.systemBus, State: b.state}
 }
 
 // refreshStore refreshes the global session store with adapter and device objects
 // that are retrieved from the Bluez DBus interface (system bus).
 func (b *BluezSession) refreshStore() error {
 	b.store.WaitInitialize()
-	go b.watchBluezSystemBus()
 	defer b.store.DoneInitialize()
 
 	objects := make(map[dbus.ObjectPath]map[string]map[string]dbus.Variant)
@@ -223,17 +363,170 @@ func (b *BluezSession) refreshStore() error {
 	return nil
 }
 
+// clearStore removes all adapter and device objects from the session store,
+// and publishes removal events for each of them. This is called when the
+// Bluez daemon exits, since all of its objects are no longer valid.
+func (b *BluezSession) clearStore() {
+	for _, device := range b.store.Devices() {
+		bluetooth.DeviceEvent(bluetooth.EventActionRemoved).On(b.state.Emitter).Publish(bluetooth.DeviceEventData{
+			Address:           device.Address,
+			AssociatedAdapter: device.AssociatedAdapter,
+		})
+	}
+
+	for _, adapter := range b.store.Adapters() {
+		bluetooth.AdapterEvent(bluetooth.EventActionRemoved).On(b.state.Emitter).
+			Publish(bluetooth.AdapterEventData{Address: adapter.Address})
+	}
+
+	b.store.Clear()
+	b.state.Paths.Clear(dbh.DbusPathAdapter, dbh.DbusPathDevice)
+	b.scans.Reset()
+	b.refreshDefaultAdapter()
+}
+
+// restoreSession repopulates the session store and registers the Bluez agent again.
+// This is called when the Bluez daemon (re)starts, so that the session can resume
+// without being restarted. Note that, the "added" events for the restored objects are
+// published when the daemon signals their addition via the InterfacesAdded signal.
+func (b *BluezSession) restoreSession() {
+	if err := b.refreshStore(); err != nil {
+		b.state.PublishError(err,
+			"Bluez session error: Cannot refresh objects after daemon restart",
+			"error_at", "restore-sessionstore",
+		)
+
+		return
+	}
+
+	b.refreshDefaultAdapter()
+
+	agent, err := setupAgent(b.systemBus, b.state, b.authHandler, b.cfg)
+	if err != nil {
+		b.state.PublishError(err,
+			"Bluez session error: Cannot register agent after daemon restart",
+			"error_at", "restore-agent",
+		)
+	}
+
+	b.mu.Lock()
+	b.agent = agent
+	b.mu.Unlock()
+
+	b.setSessionState(bluetooth.SessionRecovered, func(fs *ac.FeatureSet) {
+		fs.Errors.Remove(bluezFeatures)
+		fs.Supported.Add(bluezFeatures)
+
+		if err != nil {
+			fs.Supported.Remove(ac.FeaturePairing)
+			fs.Errors.Append(ac.NewError(ac.FeaturePairing, err))
+		}
+	})
+}
+
+// obexServiceChanged is called when the OBEX daemon exits or (re)starts, and
+// updates the features of the session with the provided OBEX features.
+func (b *BluezSession) obexServiceChanged(running bool, features ac.Features, cerr *ac.Error) {
+	const obexFeatures = ac.FeatureSendFile | ac.FeatureReceiveFile
+
+	state := bluetooth.SessionDegraded
+	if running {
+		state = bluetooth.SessionRecovered
+	}
+
+	b.setSessionState(state, func(fs *ac.FeatureSet) {
+		fs.Errors.Remove(obexFeatures)
+		fs.Supported.Remove(obexFeatures)
+		fs.Supported.Add(features)
+
+		if cerr != nil {
+			fs.Errors.Append(cerr)
+		}
+	})
+}
+
+// rfkillChanged updates the blocked state of the adapter which is associated with
+// the rfkill device, and publishes an adapter event. This is called each time the
+// state of a Bluetooth rfkill device changes.
+func (b *BluezSession) rfkillChanged(device rfkill.Device) {
+	for _, adapter := range b.store.Adapters() {
+		if adapter.UniqueName != device.Name ||
+			(adapter.SoftBlocked == device.Soft && adapter.HardBlocked == device.Hard) {
+			continue
+		}
+
+		updated, err := b.store.UpdateAdapter(adapter.Address, func(adapter *bluetooth.AdapterData) error {
+			adapter.SoftBlocked, adapter.HardBlocked = device.Soft, device.Hard
+
+			return nil
+		})
+		if err != nil {
+			return
+		}
+
+		bluetooth.AdapterEvent(bluetooth.EventActionUpdated).On(b.state.Emitter).Publish(updated)
+
+		return
+	}
+}
+
+// refreshDefaultAdapter chooses the default adapter again, and publishes
+// a default adapter event if the default adapter has changed.
+func (b *BluezSession) refreshDefaultAdapter() {
+	if data, changed := b.store.RefreshDefaultAdapter(b.cfg.DefaultAdapter); changed {
+		bluetooth.DefaultAdapterEvent().On(b.state.Emitter).Publish(data)
+	}
+}
+
+// setBlockedState sets the blocked state of the adapter from its associated rfkill device.
+func (b *BluezSession) setBlockedState(adapter *bluetooth.AdapterData) {
+	if device, ok := b.rfkill.Device(adapter.UniqueName); ok {
+		adapter.SoftBlocked, adapter.HardBlocked = device.Soft, device.Hard
+	}
+}
+
+// setSessionState updates the features of the session using the provided function,
+// and publishes a session event with the provided state and the updated features.
+func (b *BluezSession) setSessionState(state bluetooth.SessionState, updatefn func(fs *ac.FeatureSet)) {
+	b.mu.Lock()
+	features := b.features.Clone()
+	if updatefn != nil {
+		updatefn(&features)
+	}
+	b.features = features
+	b.mu.Unlock()
+
+	bluetooth.SessionEvent().On(b.state.Emitter).Publish(bluetooth.SessionEventData{
+		State:    state,
+		Features: features,
+	})
+}
+
 // watchBluezSystemBus will register a signal to receive events from the bluez dbus interface.
 func (b *BluezSession) watchBluezSystemBus() {
-	signalMatch := "type='signal', sender='org.bluez'"
-	b.systemBus.BusObject().Call(dbh.DbusSignalAddMatchIface, 0, signalMatch)
+	b.watcher = dbh.WatchSignals(b.systemBus, b.parseSignalData,
+		"type='signal', sender='org.bluez'",
+		"type='signal', sender='org.freedesktop.DBus', member='NameOwnerChanged', arg0='org.bluez'",
+		"type='signal', sender='org.freedesktop.login1', member='PrepareForSleep'",
+	)
+}
 
-	ch := make(chan *dbus.Signal, 1)
-	b.systemBus.Signal(ch)
+// reconnectManager returns the reconnection manager of the session,
+// which is nil if the session is not started.
+func (b *BluezSession) reconnectManager() *reconnect.Manager {
+	b.mu.Lock()
+	defer b.mu.Unlock()
 
-	for signal := range ch {
-		b.parseSignalData(signal)
-	}
+	return b.reconnect
+}
+
+// reconnectDevice reconnects to a device on behalf of the reconnection manager.
+func (b *BluezSession) reconnectDevice(
+	ctx context.Context, deviceAddress bluetooth.MacAddress, policy bluetooth.ConnectRetryPolicy,
+) error {
+	d := &device{b: b, Address: deviceAddress, ctx: ctx}
+
+	return d.connectWithRetry(policy)
 }
 
 // parseSignalData parses bluez DBus signal data.
@@ -241,6 +534,42 @@ func (b *BluezSession) watchBluezSystemBus() {
 //gocyclo:ignore
 func (b *BluezSession) parseSignalData(signal *dbus.Signal) {
 	switch signal.Name {
+	case dbh.DbusSignalNameOwnerChangedIface:
+		if len(signal.Body) != 3 {
+			return
+		}
+
+		name, _ := signal.Body[0].(string)
+		oldOwner, _ := signal.Body[1].(string)
+		newOwner, _ := signal.Body[2].(string)
+
+		if name != dbh.BluezBusName {
+			return
+		}
+
+		if oldOwner != "" {
+			b.clearStore()
+
+			b.setSessionState(bluetooth.SessionDaemonLost, func(fs *ac.FeatureSet) {
+				fs.Supported.Remove(bluezFeatures)
+				fs.Errors.Append(ac.NewError(bluezFeatures, errorkinds.ErrDaemonLost))
+			})
+		}
+
+		if newOwner != "" {
+			b.restoreSession()
+		}
+
+	case dbh.LogindSignalPrepareForSleep:
+		if len(signal.Body) != 1 {
+			return
+		}
+
+		// The signal is sent with "false" when the system resumes from sleep.
+		if sleeping, ok := signal.Body[0].(bool); ok && !sleeping {
+			b.reconnectManager().Resumed()
+		}
+
 	case dbh.DbusSignalPropertyChangedIface:
 		objectInterfaceName, ok := signal.Body[0].(string)
 		if !ok {
@@ -254,17 +583,24 @@ func (b *BluezSession) parseSignalData(signal *dbus.Signal) {
 
 		switch objectInterfaceName {
 		case dbh.BluezAdapterIface:
-			dbh.PublishAdapterUpdateEvent(&b.store, signal, propertyMap)
+			// The default adapter depends on the powered state of the adapters, so it is
+			// chosen again once the store has been updated with the new powered state.
+			var updated func()
+			if _, ok := propertyMap["Powered"]; ok {
+				updated = b.refreshDefaultAdapter
+			}
+
+			b.state.PublishAdapterUpdateEvent(&b.store, signal, propertyMap, updated)
 
 		case dbh.BluezDeviceIface:
-			dbh.PublishDeviceUpdateEvent(&b.store, signal, propertyMap)
+			b.state.PublishDeviceUpdateEvent(&b.store, signal, propertyMap)
 
 		case dbh.BluezMediaPlayerIface:
 			devicePath := dbus.ObjectPath(filepath.Dir(string(signal.Path)))
 
-			address, ok := dbh.PathConverter.Address(dbh.DbusPathDevice, devicePath)
+			address, ok := b.state.Paths.Address(dbh.DbusPathDevice, devicePath)
 			if !ok {
-				dbh.PublishSignalError(errorkinds.ErrDeviceNotFound, signal,
+				b.state.PublishSignalError(errorkinds.ErrDeviceNotFound, signal,
 					"Bluez event handler error",
 					"error_at", "pchanged-mediaplayer-address",
 				)
@@ -274,7 +610,7 @@ func (b *BluezSession) parseSignalData(signal *dbus.Signal) {
 
 			properties, err := b.mediaPlayer().ParseMap(propertyMap)
 			if err != nil {
-				dbh.PublishSignalError(err, signal,
+				b.state.PublishSignalError(err, signal,
 					"Bluez event handler error",
 					"error_at", "pchanged-mediaplayer-address",
 				)
@@ -282,7 +618,7 @@ func (b *BluezSession) parseSignalData(signal *dbus.Signal) {
 				return
 			}
 
-			bluetooth.MediaEvent(bluetooth.EventActionUpdated).Publish(bluetooth.MediaEventData{
+			bluetooth.MediaEvent(bluetooth.EventActionUpdated).On(b.state.Emitter).Publish(bluetooth.MediaEventData{
 				Address:   address,
 				MediaData: properties,
 			})
@@ -297,7 +633,7 @@ func (b *BluezSession) parseSignalData(signal *dbus.Signal) {
 			}
 
 			if percentage < 0 {
-				dbh.PublishSignalError(errorkinds.ErrEventDataParse, signal,
+				b.state.PublishSignalError(errorkinds.ErrEventDataParse, signal,
 					"Bluez event handler error",
 					"error_at", "pchanged-batterypct-decode",
 				)
@@ -305,7 +641,7 @@ func (b *BluezSession) parseSignalData(signal *dbus.Signal) {
 				return
 			}
 
-			dbh.PublishDeviceUpdateEvent(&b.store, signal, propertyMap)
+			b.state.PublishDeviceUpdateEvent(&b.store, signal, propertyMap)
 		}
 
 	case dbh.DbusSignalInterfacesAddedIface:
@@ -343,8 +679,8 @@ func (b *BluezSession) parseSignalData(signal *dbus.Signal) {
 			case dbh.BluezAdapterIface:
 				var adapter bluetooth.AdapterData
 
-				if err := dbh.DecodeVariantMap(mergedPropertyMap, &adapter); err != nil {
-					dbh.PublishSignalError(err, signal,
+				if err := b.state.DecodeVariantMap(mergedPropertyMap, &adapter); err != nil {
+					b.state.PublishSignalError(err, signal,
 						"Bluez event handler error",
 						"error_at", "padded-adapter-decode",
 					)
@@ -352,17 +688,25 @@ func (b *BluezSession) parseSignalData(signal *dbus.Signal) {
 					continue
 				}
 
+				adapter.UniqueName = filepath.Base(string(objectPath))
+				b.setBlockedState(&adapter)
+
 				b.store.AddAdapter(adapter)
-				dbh.PathConverter.AddDbusPath(dbh.DbusPathAdapter, objectPath, adapter.Address)
+				b.state.Paths.AddDbusPath(dbh.DbusPathAdapter, objectPath, adapter.Address)
 
-				bluetooth.AdapterEvent(bluetooth.EventActionAdded).
+				bluetooth.AdapterEvent(bluetooth.EventActionAdded).On(b.state.Emitter).
 					Publish(adapter.AdapterEventData)
 
+				b.refreshDefaultAdapter()
+
 			case dbh.BluezDeviceIface:
-				var device bluetooth.DeviceData
+				device := struct {
+					Adapter dbus.ObjectPath
+					bluetooth.DeviceData
+				}{}
 
-				if err := dbh.DecodeVariantMap(mergedPropertyMap, &device); err != nil {
-					dbh.PublishSignalError(err, signal,
+				if err := b.state.DecodeVariantMap(mergedPropertyMap, &device); err != nil {
+					b.state.PublishSignalError(err, signal,
 						"Bluez event handler error",
 						"error_at", "padded-device-decode",
 					)
@@ -370,10 +714,19 @@ func (b *BluezSession) parseSignalData(signal *dbus.Signal) {
 					continue
 				}
 
-				b.store.AddDevice(device)
-				dbh.PathConverter.AddDbusPath(dbh.DbusPathDevice, objectPath, device.Address)
+				if adapterAddress, ok := b.state.Paths.Address(dbh.DbusPathAdapter, device.Adapter); ok {
+					device.AssociatedAdapter = adapterAddress
+				}
+				device.Type = bluetooth.ResolveDeviceType(device.Appearance, device.Class, device.UUIDs)
+				device.ClassInfo = bluetooth.DecodeDeviceClass(device.Class)
+
+				b.store.AddDevice(device.DeviceData)
+				if dbh.HasRadioProperties(mergedPropertyMap) {
+					b.store.MarkDeviceSeen(device.Address)
+				}
+				b.state.Paths.AddDbusPath(dbh.DbusPathDevice, objectPath, device.Address)
 
-				bluetooth.DeviceEvent(bluetooth.EventActionAdded).
+				bluetooth.DeviceEvent(bluetooth.EventActionAdded).On(b.state.Emitter).
 					Publish(device.DeviceEventData)
 
 			case dbh.BluezBatteryIface:
@@ -388,7 +741,7 @@ func (b *BluezSession) parseSignalData(signal *dbus.Signal) {
 				}
 
 				if percentage < 0 {
-					dbh.PublishSignalError(errorkinds.ErrEventDataParse, signal,
+					b.state.PublishSignalError(errorkinds.ErrEventDataParse, signal,
 						"Bluez event handler error",
 						"error_at", "padded-batterypct-decode",
 					)
@@ -396,7 +749,7 @@ func (b *BluezSession) parseSignalData(signal *dbus.Signal) {
 					return
 				}
 
-				dbh.PublishDeviceUpdateEvent(&b.store, signal, propertyMap)
+				b.state.PublishDeviceUpdateEvent(&b.store, signal, propertyMap)
 			}
 		}
 
@@ -414,9 +767,9 @@ func (b *BluezSession) parseSignalData(signal *dbus.Signal) {
 		for _, ifaceName := range ifaceNames {
 			switch ifaceName {
 			case dbh.BluezAdapterIface:
-				address, ok := dbh.PathConverter.Address(dbh.DbusPathAdapter, objectPath)
+				address, ok := b.state.Paths.Address(dbh.DbusPathAdapter, objectPath)
 				if !ok {
-					dbh.PublishSignalError(errorkinds.ErrAdapterNotFound, signal,
+					b.state.PublishSignalError(errorkinds.ErrAdapterNotFound, signal,
 						"Bluez event handler error",
 						"error_at", "premoved-adapter-address",
 					)
@@ -425,15 +778,17 @@ func (b *BluezSession) parseSignalData(signal *dbus.Signal) {
 				}
 
 				adapter := bluetooth.AdapterEventData{Address: address}
-				bluetooth.AdapterEvent(bluetooth.EventActionRemoved).Publish(adapter)
+				bluetooth.AdapterEvent(bluetooth.EventActionRemoved).On(b.state.Emitter).Publish(adapter)
 
 				b.store.RemoveAdapter(adapter.Address)
-				dbh.PathConverter.RemoveDbusPath(dbh.DbusPathAdapter, objectPath)
+				b.state.Paths.RemoveDbusPath(dbh.DbusPathAdapter, objectPath)
+				b.scans.Reset(adapter.Address)
+				b.refreshDefaultAdapter()
 
 			case dbh.BluezDeviceIface:
-				address, ok := dbh.PathConverter.Address(dbh.DbusPathDevice, objectPath)
+				address, ok := b.state.Paths.Address(dbh.DbusPathDevice, objectPath)
 				if !ok {
-					dbh.PublishSignalError(errorkinds.ErrDeviceNotFound, signal,
+					b.state.PublishSignalError(errorkinds.ErrDeviceNotFound, signal,
 						"Bluez event handler error",
 						"error_at", "premoved-device-address",
 					)
@@ -441,11 +796,11 @@ func (b *BluezSession) parseSignalData(signal *dbus.Signal) {
 					return
 				}
 
-				adapterPath := dbus.ObjectPath(filepath.Dir(string(signal.Path)))
+				adapterPath := dbus.ObjectPath(filepath.Dir(string(objectPath)))
 
-				adapterAddress, ok := dbh.PathConverter.Address(dbh.DbusPathAdapter, adapterPath)
+				adapterAddress, ok := b.state.Paths.Address(dbh.DbusPathAdapter, adapterPath)
 				if !ok {
-					dbh.PublishSignalError(errorkinds.ErrAdapterNotFound, signal,
+					b.state.PublishSignalError(errorkinds.ErrAdapterNotFound, signal,
 						"Bluez event handler error",
 						"error_at", "premoved-device-adapter",
 					)
@@ -457,10 +812,10 @@ func (b *BluezSession) parseSignalData(signal *dbus.Signal) {
 					Address:           address,
 					AssociatedAdapter: adapterAddress,
 				}
-				bluetooth.DeviceEvent(bluetooth.EventActionRemoved).Publish(device)
+				bluetooth.DeviceEvent(bluetooth.EventActionRemoved).On(b.state.Emitter).Publish(device)
 
 				b.store.RemoveDevice(device.Address)
-				dbh.PathConverter.RemoveDbusPath(dbh.DbusPathDevice, objectPath)
+				b.state.Paths.RemoveDbusPath(dbh.DbusPathDevice, objectPath)
 			}
 		}
 	}
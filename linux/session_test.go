@@ -0,0 +1,317 @@
+//go:build linux
+
+package linux
+
+import (
+	"testing"
+	"time"
+
+	ac "github.com/bluetuith-org/api-native/api/appfeatures"
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/config"
+	"github.com/bluetuith-org/api-native/api/eventbus"
+	"github.com/bluetuith-org/api-native/linux/bluezmock"
+	dbh "github.com/bluetuith-org/api-native/linux/internal/dbushelper"
+	"github.com/godbus/dbus/v5"
+)
+
+// The addresses of the objects which are added to the fake Bluez service.
+const (
+	testAdapterAddress = "00:11:22:33:44:55"
+	testDeviceAddress  = "AA:BB:CC:DD:EE:01"
+)
+
+// eventTimeout is the maximum duration to wait for an event.
+const eventTimeout = 5 * time.Second
+
+// startTestSession starts a private DBus daemon with a fake Bluez service, which
+// has a powered adapter and a paired device, and starts a session with the provided
+// authorizer. The session, the service and the daemon are stopped when the test ends.
+func startTestSession(t *testing.T, authHandler bluetooth.SessionAuthorizer) (*BluezSession, *bluezmock.Bluez) {
+	t.Helper()
+
+	return startTestSessionWithConfig(t, authHandler, config.New())
+}
+
+// startTestSessionWithConfig is like startTestSession, but starts the session with the
+// provided configuration. The event emitter of the configuration is always replaced.
+func startTestSessionWithConfig(
+	t *testing.T, authHandler bluetooth.SessionAuthorizer, cfg config.Configuration,
+) (*BluezSession, *bluezmock.Bluez) {
+	t.Helper()
+
+	bus, err := bluezmock.StartBus()
+	if err != nil {
+		t.Skipf("Cannot start private DBus daemon: %v", err)
+	}
+	t.Cleanup(func() { _ = bus.Close() })
+	t.Cleanup(bus.SetEnv())
+
+	mock, err := bluezmock.New(bus.Address)
+	if err != nil {
+		t.Fatalf("Cannot start fake Bluez service: %v", err)
+	}
+	t.Cleanup(func() { _ = mock.Close() })
+
+	adapterPath, err := mock.AddAdapter("hci0", testAdapterAddress, map[string]interface{}{"Powered": true})
+	if err != nil {
+		t.Fatalf("Cannot add adapter: %v", err)
+	}
+
+	if _, err := mock.AddDevice(adapterPath, testDeviceAddress, map[string]interface{}{
+		"Name":   "Headset",
+		"Paired": true,
+		"Class":  uint32(0x240404),
+	}); err != nil {
+		t.Fatalf("Cannot add device: %v", err)
+	}
+
+	cfg.EventEmitter = eventbus.NewEmitter(nil)
+
+	session := &BluezSession{}
+	if _, err := session.Start(authHandler, cfg); err != nil {
+		t.Fatalf("Cannot start session: %v", err)
+	}
+	t.Cleanup(func() { _ = session.Stop() })
+
+	return session, mock
+}
+
+// mustParseMAC parses a Bluetooth address, and fails the test if it is invalid.
+func mustParseMAC(t *testing.T, address string) bluetooth.MacAddress {
+	t.Helper()
+
+	mac, err := bluetooth.ParseMAC(address)
+	if err != nil {
+		t.Fatalf("Cannot parse address %q: %v", address, err)
+	}
+
+	return mac
+}
+
+// waitEvent waits for an event which matches the provided function.
+func waitEvent[T bluetooth.Events](t *testing.T, sub bluetooth.Subscriber[T], match func(bluetooth.Event[T]) bool) bluetooth.Event[T] {
+	t.Helper()
+
+	timeout := time.After(eventTimeout)
+
+	for {
+		select {
+		case ev, ok := <-sub.C:
+			if !ok {
+				t.Fatal("Event subscription was closed")
+			}
+
+			if match(ev) {
+				return ev
+			}
+
+		case <-timeout:
+			t.Fatal("Timed out waiting for event")
+		}
+	}
+}
+
+func TestStart(t *testing.T) {
+	session, mock := startTestSession(t, nil)
+
+	adapters := session.Adapters()
+	if len(adapters) != 1 {
+		t.Fatalf("Adapters() returned %d adapters, want 1", len(adapters))
+	}
+
+	adapter := adapters[0]
+	if adapter.Address != mustParseMAC(t, testAdapterAddress) || adapter.UniqueName != "hci0" || !adapter.Powered {
+		t.Errorf("Adapters()[0] = %+v, want powered adapter hci0 (%s)", adapter.AdapterEventData, testAdapterAddress)
+	}
+
+	devices, err := session.Adapter(adapter.Address).Devices()
+	if err != nil {
+		t.Fatalf("Devices() returned error: %v", err)
+	}
+
+	if len(devices) != 1 {
+		t.Fatalf("Devices() returned %d devices, want 1", len(devices))
+	}
+
+	device := devices[0]
+	if device.Address != mustParseMAC(t, testDeviceAddress) || device.Name != "Headset" || !device.Paired {
+		t.Errorf("Devices()[0] = %+v, want paired device Headset (%s)", device.DeviceEventData, testDeviceAddress)
+	}
+
+	if device.Type != "Headset" {
+		t.Errorf("Devices()[0].Type = %q, want %q", device.Type, "Headset")
+	}
+
+	if !session.features.Has(bluezFeatures) {
+		t.Errorf("Session features %v do not include the Bluez features", session.features.Supported)
+	}
+
+	agent, ok := mock.Agent()
+	if !ok {
+		t.Fatal("No agent is registered")
+	}
+
+	if agent.Path != dbh.BluezAgentPath || agent.Capability != string(config.AgentKeyboardDisplay) || !agent.Default {
+		t.Errorf("Agent() = %+v, want default agent %s with capability %s",
+			agent, dbh.BluezAgentPath, config.AgentKeyboardDisplay,
+		)
+	}
+}
+
+func TestStopRemovesAgent(t *testing.T) {
+	session, mock := startTestSession(t, nil)
+
+	sub := bluetooth.SessionEvent().On(session.Events()).Subscribe()
+
+	if err := session.Stop(); err != nil {
+		t.Fatalf("Stop() returned error: %v", err)
+	}
+
+	waitEvent(t, sub, func(ev bluetooth.Event[bluetooth.SessionEventData]) bool {
+		return ev.Data.State == bluetooth.SessionStopping
+	})
+
+	if agent, ok := mock.Agent(); ok {
+		t.Errorf("Agent %+v is still registered after Stop()", agent)
+	}
+
+	if err := session.Stop(); err == nil {
+		t.Error("Stop() on a stopped session returned no error")
+	}
+}
+
+func TestRefreshStore(t *testing.T) {
+	session, mock := startTestSession(t, nil)
+
+	adapterPath := dbus.ObjectPath("/org/bluez/hci0")
+	devicePath, err := mock.AddDevice(adapterPath, "AA:BB:CC:DD:EE:02", nil)
+	if err != nil {
+		t.Fatalf("Cannot add device: %v", err)
+	}
+
+	session.clearStore()
+
+	if adapters := session.Adapters(); len(adapters) != 0 {
+		t.Fatalf("Adapters() returned %d adapters after clearing the store, want 0", len(adapters))
+	}
+
+	if err := session.refreshStore(); err != nil {
+		t.Fatalf("refreshStore() returned error: %v", err)
+	}
+
+	devices, err := session.Adapter(mustParseMAC(t, testAdapterAddress)).Devices()
+	if err != nil {
+		t.Fatalf("Devices() returned error: %v", err)
+	}
+
+	if len(devices) != 2 {
+		t.Fatalf("Devices() returned %d devices, want 2", len(devices))
+	}
+
+	address, ok := session.state.Paths.Address(dbh.DbusPathDevice, devicePath)
+	if !ok || address != mustParseMAC(t, "AA:BB:CC:DD:EE:02") {
+		t.Errorf("Path %s is mapped to %s (%v), want AA:BB:CC:DD:EE:02", devicePath, address, ok)
+	}
+
+	for _, device := range devices {
+		if lastSeen, ok := session.store.DeviceLastSeen(device.Address); ok {
+			t.Errorf("Device %s was marked as seen at %s by refreshing the store", device.Address, lastSeen)
+		}
+	}
+}
+
+func TestParseSignalData(t *testing.T) {
+	session, mock := startTestSession(t, nil)
+
+	adapterPath := dbus.ObjectPath("/org/bluez/hci0")
+	devicePath := bluezmock.DevicePath(adapterPath, testDeviceAddress)
+	deviceAddress := mustParseMAC(t, testDeviceAddress)
+
+	sub := bluetooth.DeviceEvent().On(session.Events()).Subscribe()
+
+	t.Run("PropertiesChanged", func(t *testing.T) {
+		if err := mock.SetProperty(devicePath, dbh.BluezDeviceIface, "Connected", true); err != nil {
+			t.Fatalf("Cannot set property: %v", err)
+		}
+
+		waitEvent(t, sub, func(ev bluetooth.Event[bluetooth.DeviceEventData]) bool {
+			return ev.Action == bluetooth.EventActionUpdated && ev.Data.Address == deviceAddress && ev.Data.Connected
+		})
+
+		device, err := session.Device(deviceAddress).Properties()
+		if err != nil || !device.Connected {
+			t.Errorf("Properties() = %+v, %v, want connected device", device.DeviceEventData, err)
+		}
+
+		if _, ok := session.store.DeviceLastSeen(deviceAddress); ok {
+			t.Error("Device was marked as seen by a connection state update")
+		}
+
+		if err := mock.SetProperty(devicePath, dbh.BluezDeviceIface, "RSSI", int16(-50)); err != nil {
+			t.Fatalf("Cannot set property: %v", err)
+		}
+
+		waitEvent(t, sub, func(ev bluetooth.Event[bluetooth.DeviceEventData]) bool {
+			return ev.Action == bluetooth.EventActionUpdated && ev.Data.Address == deviceAddress && ev.Data.RSSI == -50
+		})
+
+		if _, ok := session.store.DeviceLastSeen(deviceAddress); !ok {
+			t.Error("Device was not marked as seen by a signal strength update")
+		}
+	})
+
+	t.Run("InterfacesAdded", func(t *testing.T) {
+		if _, err := mock.AddDevice(adapterPath, "AA:BB:CC:DD:EE:03", map[string]interface{}{"RSSI": int16(-60)}); err != nil {
+			t.Fatalf("Cannot add device: %v", err)
+		}
+
+		ev := waitEvent(t, sub, func(ev bluetooth.Event[bluetooth.DeviceEventData]) bool {
+			return ev.Action == bluetooth.EventActionAdded
+		})
+
+		if ev.Data.Address != mustParseMAC(t, "AA:BB:CC:DD:EE:03") || ev.Data.RSSI != -60 {
+			t.Errorf("Added device = %+v, want AA:BB:CC:DD:EE:03 with RSSI -60", ev.Data)
+		}
+
+		if _, ok := session.store.DeviceLastSeen(ev.Data.Address); !ok {
+			t.Error("Found device was not marked as seen")
+		}
+	})
+
+	t.Run("InterfacesRemoved", func(t *testing.T) {
+		if err := mock.RemoveObject(devicePath); err != nil {
+			t.Fatalf("Cannot remove device: %v", err)
+		}
+
+		waitEvent(t, sub, func(ev bluetooth.Event[bluetooth.DeviceEventData]) bool {
+			return ev.Action == bluetooth.EventActionRemoved && ev.Data.Address == deviceAddress
+		})
+
+		if _, err := session.Device(deviceAddress).Properties(); err == nil {
+			t.Error("Properties() of a removed device returned no error")
+		}
+	})
+
+	t.Run("NameOwnerChanged", func(t *testing.T) {
+		sessionSub := bluetooth.SessionEvent().On(session.Events()).Subscribe()
+		defer sessionSub.Unsubscribe()
+
+		session.parseSignalData(&dbus.Signal{
+			Name: dbh.DbusSignalNameOwnerChangedIface,
+			Body: []interface{}{dbh.BluezBusName, ":1.1", ""},
+		})
+
+		ev := waitEvent(t, sessionSub, func(ev bluetooth.Event[bluetooth.SessionEventData]) bool {
+			return ev.Data.State == bluetooth.SessionDaemonLost
+		})
+
+		if ev.Data.Features.Has(ac.FeatureConnection) {
+			t.Errorf("Features %v still include the Bluez features after the daemon was lost", ev.Data.Features.Supported)
+		}
+
+		if adapters := session.Adapters(); len(adapters) != 0 {
+			t.Errorf("Adapters() returned %d adapters after the daemon was lost, want 0", len(adapters))
+		}
+	})
+}
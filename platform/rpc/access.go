@@ -0,0 +1,102 @@
+package rpc
+
+import (
+	"os"
+	"os/user"
+	"strconv"
+)
+
+// DefaultSocketMode is the default access permissions of the socket file,
+// which allows the owner and the group of the socket file to connect.
+const DefaultSocketMode os.FileMode = 0o660
+
+// ListenOptions describes the options of the Unix domain socket which the server listens on.
+type ListenOptions struct {
+	// Mode holds the access permissions of the socket file.
+	// If it is zero, DefaultSocketMode is used.
+	Mode os.FileMode
+
+	// Group holds the name or the ID of the group which owns the socket file.
+	// If it is empty, the socket file is owned by the group of the server process.
+	Group string
+}
+
+// Credentials describes the credentials of a client process, as read from its socket connection.
+type Credentials struct {
+	PID int32
+	UID uint32
+	GID uint32
+}
+
+// AccessPolicy describes which clients can use the server. If a check is nil, the
+// default check is used, which allows the superuser, the user which runs the server,
+// and the members of the socket file's group (see ListenOptions.Group).
+type AccessPolicy struct {
+	// AllowClient returns whether a client is allowed to connect to the server.
+	AllowClient func(Credentials) bool
+
+	// AllowAuthorizer returns whether a connected client is allowed
+	// to register itself as the authorizer of the server's session.
+	AllowAuthorizer func(Credentials) bool
+}
+
+// allowClient returns whether the client is allowed to connect to the server.
+func (s *Server) allowClient(c Credentials) bool {
+	if s.policy.AllowClient != nil {
+		return s.policy.AllowClient(c)
+	}
+
+	return s.allowDefault(c)
+}
+
+// allowAuthorizer returns whether the client is allowed to register itself as the authorizer.
+func (s *Server) allowAuthorizer(c Credentials) bool {
+	if s.policy.AllowAuthorizer != nil {
+		return s.policy.AllowAuthorizer(c)
+	}
+
+	return s.allowDefault(c)
+}
+
+// allowDefault returns whether the client is allowed by the default access policy.
+func (s *Server) allowDefault(c Credentials) bool {
+	if c.UID == 0 || c.UID == uint32(os.Geteuid()) {
+		return true
+	}
+
+	s.mu.Lock()
+	gid, ok := s.socketGroup, s.hasSocketGroup
+	s.mu.Unlock()
+
+	return ok && c.inGroup(gid)
+}
+
+// checkedPath returns the resolved path of a file which the client has requested to send,
+// if the client can read the file itself. Clients which run as the superuser or as the user
+// which runs the server are not checked, since they can already read the same files.
+func (c Credentials) checkedPath(path string) (string, error) {
+	if c.UID == 0 || c.UID == uint32(os.Geteuid()) {
+		return path, nil
+	}
+
+	return c.readablePath(path)
+}
+
+// lookupGroup returns the ID of the group with the provided name or ID.
+func lookupGroup(group string) (uint32, error) {
+	if id, err := strconv.ParseUint(group, 10, 32); err == nil {
+		return uint32(id), nil
+	}
+
+	g, err := user.LookupGroup(group)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := strconv.ParseUint(g.Gid, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(id), nil
+}
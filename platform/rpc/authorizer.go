@@ -0,0 +1,97 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/platform/rpc/internal/jsonrpc"
+	"github.com/google/uuid"
+)
+
+// authorizer describes an authorization handler, which forwards
+// authorization requests to the client that is registered as the authorizer.
+type authorizer struct {
+	s *Server
+}
+
+// AuthorizeTransfer forwards a file transfer authorization request.
+func (a *authorizer) AuthorizeTransfer(timeout bluetooth.AuthTimeout, path string, props bluetooth.FileTransferData) error {
+	return a.call(timeout, jsonrpc.MethodAuthTransfer, jsonrpc.AuthParams{
+		Address:  props.Address,
+		Path:     path,
+		Transfer: &props,
+	})
+}
+
+// DisplayPinCode forwards a display pincode request.
+func (a *authorizer) DisplayPinCode(timeout bluetooth.AuthTimeout, address bluetooth.MacAddress, pincode string) error {
+	return a.call(timeout, jsonrpc.MethodAuthDisplayPinCode, jsonrpc.AuthParams{
+		Address: address,
+		PinCode: pincode,
+	})
+}
+
+// DisplayPasskey forwards a display passkey request.
+func (a *authorizer) DisplayPasskey(timeout bluetooth.AuthTimeout, address bluetooth.MacAddress, passkey uint32, entered uint16) error {
+	return a.call(timeout, jsonrpc.MethodAuthDisplayPasskey, jsonrpc.AuthParams{
+		Address: address,
+		Passkey: passkey,
+		Entered: entered,
+	})
+}
+
+// ConfirmPasskey forwards a passkey confirmation request.
+func (a *authorizer) ConfirmPasskey(timeout bluetooth.AuthTimeout, address bluetooth.MacAddress, passkey uint32) error {
+	return a.call(timeout, jsonrpc.MethodAuthConfirmPasskey, jsonrpc.AuthParams{
+		Address: address,
+		Passkey: passkey,
+	})
+}
+
+// AuthorizePairing forwards a pairing authorization request.
+func (a *authorizer) AuthorizePairing(timeout bluetooth.AuthTimeout, address bluetooth.MacAddress) error {
+	return a.call(timeout, jsonrpc.MethodAuthPairing, jsonrpc.AuthParams{
+		Address: address,
+	})
+}
+
+// AuthorizeService forwards a service (Bluetooth profile) authorization request.
+func (a *authorizer) AuthorizeService(timeout bluetooth.AuthTimeout, address bluetooth.MacAddress, uuid uuid.UUID) error {
+	return a.call(timeout, jsonrpc.MethodAuthService, jsonrpc.AuthParams{
+		Address: address,
+		UUID:    uuid,
+	})
+}
+
+// call sends the authorization request to the registered client, and waits for its reply.
+// The request is cancelled on the client if the authorization times out, or is cancelled.
+func (a *authorizer) call(timeout bluetooth.AuthTimeout, method string, params jsonrpc.AuthParams) error {
+	a.s.mu.Lock()
+	conn := a.s.authConn
+	a.s.mu.Unlock()
+
+	if conn == nil {
+		return noAuthorizer()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-timeout.Done():
+			cancel()
+
+		case <-ctx.Done():
+		}
+	}()
+
+	if err := conn.Call(ctx, method, params, nil); err != nil {
+		return wrapError(err,
+			"rpc-server-authorize", params.Address,
+			"Authorization request was rejected",
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,9 @@
+/*
+Package client provides a Bluetooth session, which uses a session that is
+exposed by an RPC server (see the rpc package) over a Unix domain socket.
+
+The session implements the bluetooth.Session interface, so that it can be used
+in place of a native session. Events that are streamed by the server are published
+to the session's event emitter.
+*/
+package client
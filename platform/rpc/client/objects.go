@@ -0,0 +1,444 @@
+package client
+
+import (
+	"context"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/platform/rpc/internal/jsonrpc"
+	"github.com/google/uuid"
+)
+
+// adapter describes a function call interface to invoke adapter related functions.
+type adapter struct {
+	s   *Session
+	ctx context.Context
+
+	Address bluetooth.MacAddress
+}
+
+// device describes a function call interface to invoke device related functions.
+type device struct {
+	s   *Session
+	ctx context.Context
+
+	Address bluetooth.MacAddress
+}
+
+// obex describes a function call interface to invoke obex related functions.
+type obex struct {
+	s *Session
+
+	Address bluetooth.MacAddress
+}
+
+// fileTransfer describes a function call interface to invoke file transfer related functions.
+type fileTransfer obex
+
+// network describes a function call interface to invoke network related functions.
+type network struct {
+	s   *Session
+	ctx context.Context
+
+	Address bluetooth.MacAddress
+}
+
+// mediaPlayer describes a function call interface to invoke media player related functions.
+type mediaPlayer struct {
+	s   *Session
+	ctx context.Context
+
+	Address bluetooth.MacAddress
+}
+
+// WithContext returns a copy of the adapter function call interface, whose
+// method calls are bound to the provided context.
+func (a *adapter) WithContext(ctx context.Context) bluetooth.Adapter {
+	adapter := *a
+	adapter.ctx = ctx
+
+	return &adapter
+}
+
+// StartDiscovery will put the adapter into "discovering" mode.
+func (a *adapter) StartDiscovery() error {
+	return a.call(jsonrpc.MethodAdapterStartDiscovery, jsonrpc.AddressParams{Address: a.Address}, nil)
+}
+
+// StopDiscovery will stop the "discovering" mode.
+func (a *adapter) StopDiscovery() error {
+	return a.call(jsonrpc.MethodAdapterStopDiscovery, jsonrpc.AddressParams{Address: a.Address}, nil)
+}
+
+// Discover starts a scoped discovery session on the server, and returns a channel which
+// receives the devices that are found or updated during the discovery.
+func (a *adapter) Discover(ctx context.Context, opts bluetooth.DiscoveryOptions) (<-chan bluetooth.DiscoveryEvent, error) {
+	return a.s.discover(ctx, a.Address, opts)
+}
+
+// SetDiscoveryFilter sets the filter which is applied to the devices that are found during discovery.
+func (a *adapter) SetDiscoveryFilter(filter bluetooth.DiscoveryFilter) error {
+	return a.call(jsonrpc.MethodAdapterSetDiscoveryFilter, jsonrpc.FilterParams{Address: a.Address, Filter: filter}, nil)
+}
+
+// DiscoveryFilters returns the keys of the discovery filter parameters that are supported by the server.
+func (a *adapter) DiscoveryFilters() ([]string, error) {
+	var filters []string
+
+	err := a.call(jsonrpc.MethodAdapterDiscoveryFilters, jsonrpc.AddressParams{Address: a.Address}, &filters)
+
+	return filters, err
+}
+
+// SetPoweredState sets the powered state of the adapter.
+func (a *adapter) SetPoweredState(enable bool) error {
+	return a.call(jsonrpc.MethodAdapterSetPoweredState, jsonrpc.StateParams{Address: a.Address, Enable: enable}, nil)
+}
+
+// SetDiscoverableState sets the discoverable state of the adapter.
+func (a *adapter) SetDiscoverableState(enable bool) error {
+	return a.call(jsonrpc.MethodAdapterSetDiscoverableState, jsonrpc.StateParams{Address: a.Address, Enable: enable}, nil)
+}
+
+// SetPairableState sets the pairable state of the adapter.
+func (a *adapter) SetPairableState(enable bool) error {
+	return a.call(jsonrpc.MethodAdapterSetPairableState, jsonrpc.StateParams{Address: a.Address, Enable: enable}, nil)
+}
+
+// PruneDevices removes the stale devices of the adapter, which are devices that are
+// not paired, trusted or connected, and which have not been seen for the provided duration.
+func (a *adapter) PruneDevices(opts bluetooth.PruneOptions) (bluetooth.PruneResult, error) {
+	result := bluetooth.PruneResult{Address: a.Address, DryRun: opts.DryRun}
+
+	err := a.call(jsonrpc.MethodAdapterPruneDevices, jsonrpc.PruneParams{Address: a.Address, Options: opts}, &result)
+
+	return result, err
+}
+
+// ConnectDevice connects to a device with the provided address, without discovering the device first.
+func (a *adapter) ConnectDevice(address bluetooth.MacAddress, addressType bluetooth.AddressType) error {
+	return a.call(jsonrpc.MethodAdapterConnectDevice, jsonrpc.ConnectDeviceParams{
+		Address:     a.Address,
+		Device:      address,
+		AddressType: addressType,
+	}, nil)
+}
+
+// UnblockAndPowerOn removes the soft-block on the adapter if it is soft-blocked,
+// and then powers on the adapter.
+func (a *adapter) UnblockAndPowerOn() error {
+	return a.call(jsonrpc.MethodAdapterUnblockAndPowerOn, jsonrpc.AddressParams{Address: a.Address}, nil)
+}
+
+// SetAlias sets the user-assigned name of the adapter.
+func (a *adapter) SetAlias(alias string) error {
+	return a.call(jsonrpc.MethodAdapterSetAlias, jsonrpc.AliasParams{Address: a.Address, Alias: alias}, nil)
+}
+
+// SetDiscoverableTimeout sets the timeout (in seconds) after which the adapter
+// is no longer discoverable.
+func (a *adapter) SetDiscoverableTimeout(timeout uint32) error {
+	return a.call(jsonrpc.MethodAdapterSetDiscoverableTimeout, jsonrpc.TimeoutParams{Address: a.Address, Timeout: timeout}, nil)
+}
+
+// SetPairableTimeout sets the timeout (in seconds) after which the adapter
+// is no longer pairable.
+func (a *adapter) SetPairableTimeout(timeout uint32) error {
+	return a.call(jsonrpc.MethodAdapterSetPairableTimeout, jsonrpc.TimeoutParams{Address: a.Address, Timeout: timeout}, nil)
+}
+
+// Properties returns all the properties of the adapter.
+func (a *adapter) Properties() (bluetooth.AdapterData, error) {
+	var properties bluetooth.AdapterData
+
+	err := a.call(jsonrpc.MethodAdapterProperties, jsonrpc.AddressParams{Address: a.Address}, &properties)
+
+	return properties, err
+}
+
+// Devices returns all the devices associated with the adapter.
+func (a *adapter) Devices() ([]bluetooth.DeviceData, error) {
+	var devices []bluetooth.DeviceData
+
+	err := a.call(jsonrpc.MethodAdapterDevices, jsonrpc.AddressParams{Address: a.Address}, &devices)
+
+	return devices, err
+}
+
+// call calls an adapter method on the server.
+func (a *adapter) call(method string, params, result any) error {
+	return a.s.call(callContext(a.ctx), method, a.Address, params, result)
+}
+
+// WithContext returns a copy of the device function call interface, whose
+// method calls are bound to the provided context.
+func (d *device) WithContext(ctx context.Context) bluetooth.Device {
+	device := *d
+	device.ctx = ctx
+
+	return &device
+}
+
+// Pair will attempt to pair a device.
+func (d *device) Pair() error {
+	return d.call(jsonrpc.MethodDevicePair, nil)
+}
+
+// CancelPairing will cancel a pairing attempt.
+func (d *device) CancelPairing() error {
+	return d.call(jsonrpc.MethodDeviceCancelPairing, nil)
+}
+
+// Connect will attempt to connect an already paired device.
+func (d *device) Connect() error {
+	return d.call(jsonrpc.MethodDeviceConnect, nil)
+}
+
+// ConnectWithRetry will attempt to connect to the device, and retries the connection
+// attempt according to the provided policy.
+func (d *device) ConnectWithRetry(policy bluetooth.ConnectRetryPolicy) error {
+	return d.s.call(callContext(d.ctx), jsonrpc.MethodDeviceConnectWithRetry, d.Address,
+		jsonrpc.ConnectRetryParams{Address: d.Address, Policy: policy}, nil,
+	)
+}
+
+// Disconnect will disconnect the device.
+func (d *device) Disconnect() error {
+	return d.call(jsonrpc.MethodDeviceDisconnect, nil)
+}
+
+// ConnectProfile will attempt to connect a specific profile of the device.
+func (d *device) ConnectProfile(profileUUID uuid.UUID) error {
+	return d.s.call(callContext(d.ctx), jsonrpc.MethodDeviceConnectProfile, d.Address,
+		jsonrpc.ProfileParams{Address: d.Address, UUID: profileUUID}, nil,
+	)
+}
+
+// DisconnectProfile will disconnect a specific profile of the device.
+func (d *device) DisconnectProfile(profileUUID uuid.UUID) error {
+	return d.s.call(callContext(d.ctx), jsonrpc.MethodDeviceDisconnectProfile, d.Address,
+		jsonrpc.ProfileParams{Address: d.Address, UUID: profileUUID}, nil,
+	)
+}
+
+// Remove removes the device from its associated adapter.
+func (d *device) Remove() error {
+	return d.call(jsonrpc.MethodDeviceRemove, nil)
+}
+
+// SetTrusted sets the trusted state of the device.
+func (d *device) SetTrusted(enable bool) error {
+	return d.s.call(callContext(d.ctx), jsonrpc.MethodDeviceSetTrusted, d.Address,
+		jsonrpc.StateParams{Address: d.Address, Enable: enable}, nil,
+	)
+}
+
+// SetBlocked sets the blocked state of the device.
+func (d *device) SetBlocked(enable bool) error {
+	return d.s.call(callContext(d.ctx), jsonrpc.MethodDeviceSetBlocked, d.Address,
+		jsonrpc.StateParams{Address: d.Address, Enable: enable}, nil,
+	)
+}
+
+// SetAlias sets the alias of the device.
+func (d *device) SetAlias(alias string) error {
+	return d.s.call(callContext(d.ctx), jsonrpc.MethodDeviceSetAlias, d.Address,
+		jsonrpc.AliasParams{Address: d.Address, Alias: alias}, nil,
+	)
+}
+
+// SetWakeAllowed sets whether the device is allowed to wake up the host from system suspend.
+func (d *device) SetWakeAllowed(enable bool) error {
+	return d.s.call(callContext(d.ctx), jsonrpc.MethodDeviceSetWakeAllowed, d.Address,
+		jsonrpc.StateParams{Address: d.Address, Enable: enable}, nil,
+	)
+}
+
+// SetAutoReconnect sets whether the device is reconnected automatically by the server.
+func (d *device) SetAutoReconnect(enable bool) error {
+	return d.s.call(callContext(d.ctx), jsonrpc.MethodDeviceSetAutoReconnect, d.Address,
+		jsonrpc.StateParams{Address: d.Address, Enable: enable}, nil,
+	)
+}
+
+// Setup runs the setup workflow of the device on the server. If a stage of the
+// workflow fails, the result holds the stage which failed, along with the error message.
+func (d *device) Setup(ctx context.Context, opts bluetooth.SetupOptions) (bluetooth.SetupResult, error) {
+	result := bluetooth.SetupResult{Address: d.Address}
+
+	err := d.s.call(ctx, jsonrpc.MethodDeviceSetup, d.Address,
+		jsonrpc.SetupParams{Address: d.Address, Options: opts}, &result,
+	)
+	if err != nil || !result.Failed() {
+		return result, err
+	}
+
+	err = errorkinds.ErrMethodCall
+	if ctx.Err() != nil {
+		err = errorkinds.ErrMethodCanceled
+	}
+
+	return result, wrapError(err,
+		"rpc-"+jsonrpc.MethodDeviceSetup, d.Address,
+		result.Error,
+	)
+}
+
+// Properties returns all the properties of the device.
+func (d *device) Properties() (bluetooth.DeviceData, error) {
+	var properties bluetooth.DeviceData
+
+	err := d.call(jsonrpc.MethodDeviceProperties, &properties)
+
+	return properties, err
+}
+
+// call calls a device method on the server.
+func (d *device) call(method string, result any) error {
+	return d.s.call(callContext(d.ctx), method, d.Address, jsonrpc.AddressParams{Address: d.Address}, result)
+}
+
+// FileTransfer returns a function call interface to invoke device file transfer
+// related functions.
+func (o *obex) FileTransfer() bluetooth.ObexFileTransfer {
+	return &fileTransfer{s: o.s, Address: o.Address}
+}
+
+// CreateSession creates a new Obex session with a device.
+func (o *fileTransfer) CreateSession(ctx context.Context) error {
+	return o.s.call(callContext(ctx), jsonrpc.MethodObexCreateSession, o.Address,
+		jsonrpc.AddressParams{Address: o.Address}, nil,
+	)
+}
+
+// RemoveSession removes a created Obex session.
+func (o *fileTransfer) RemoveSession() error {
+	return o.call(jsonrpc.MethodObexRemoveSession)
+}
+
+// SendFile sends a file to the device. The 'filepath' must be a full path to the file.
+// The path is resolved on the server's filesystem.
+func (o *fileTransfer) SendFile(filepath string) (bluetooth.FileTransferData, error) {
+	var transfer bluetooth.FileTransferData
+
+	err := o.s.call(context.Background(), jsonrpc.MethodObexSendFile, o.Address,
+		jsonrpc.FileParams{Address: o.Address, Path: filepath}, &transfer,
+	)
+
+	return transfer, err
+}
+
+// CancelTransfer cancels the transfer.
+func (o *fileTransfer) CancelTransfer() error {
+	return o.call(jsonrpc.MethodObexCancelTransfer)
+}
+
+// SuspendTransfer suspends the transfer.
+func (o *fileTransfer) SuspendTransfer() error {
+	return o.call(jsonrpc.MethodObexSuspendTransfer)
+}
+
+// ResumeTransfer resumes the transfer.
+func (o *fileTransfer) ResumeTransfer() error {
+	return o.call(jsonrpc.MethodObexResumeTransfer)
+}
+
+// call calls a file transfer method on the server.
+func (o *fileTransfer) call(method string) error {
+	return o.s.call(context.Background(), method, o.Address, jsonrpc.AddressParams{Address: o.Address}, nil)
+}
+
+// WithContext returns a copy of the network function call interface, whose
+// connection attempts are bound to the provided context.
+func (n *network) WithContext(ctx context.Context) bluetooth.Network {
+	network := *n
+	network.ctx = ctx
+
+	return &network
+}
+
+// Connect connects to the device's network interface.
+func (n *network) Connect(name string, nt bluetooth.NetworkType) error {
+	return n.s.call(callContext(n.ctx), jsonrpc.MethodNetworkConnect, n.Address,
+		jsonrpc.NetworkParams{Address: n.Address, Name: name, Type: nt}, nil,
+	)
+}
+
+// Disconnect deactivates the connection.
+func (n *network) Disconnect() error {
+	return n.s.call(callContext(n.ctx), jsonrpc.MethodNetworkDisconnect, n.Address,
+		jsonrpc.AddressParams{Address: n.Address}, nil,
+	)
+}
+
+// WithContext returns a copy of the media player function call interface, whose
+// method calls are bound to the provided context.
+func (m *mediaPlayer) WithContext(ctx context.Context) bluetooth.MediaPlayer {
+	mediaPlayer := *m
+	mediaPlayer.ctx = ctx
+
+	return &mediaPlayer
+}
+
+// Properties returns the media player properties of the device.
+func (m *mediaPlayer) Properties() (bluetooth.MediaData, error) {
+	var properties bluetooth.MediaData
+
+	err := m.call(jsonrpc.MethodMediaProperties, &properties)
+
+	return properties, err
+}
+
+// Play starts the playback.
+func (m *mediaPlayer) Play() error {
+	return m.call(jsonrpc.MethodMediaPlay, nil)
+}
+
+// Pause pauses the playback.
+func (m *mediaPlayer) Pause() error {
+	return m.call(jsonrpc.MethodMediaPause, nil)
+}
+
+// TogglePlayPause toggles between the playing and paused states.
+func (m *mediaPlayer) TogglePlayPause() error {
+	return m.call(jsonrpc.MethodMediaTogglePlayPause, nil)
+}
+
+// Next switches to the next track.
+func (m *mediaPlayer) Next() error {
+	return m.call(jsonrpc.MethodMediaNext, nil)
+}
+
+// Previous switches to the previous track.
+func (m *mediaPlayer) Previous() error {
+	return m.call(jsonrpc.MethodMediaPrevious, nil)
+}
+
+// FastForward fast-forwards the current track.
+func (m *mediaPlayer) FastForward() error {
+	return m.call(jsonrpc.MethodMediaFastForward, nil)
+}
+
+// Rewind rewinds the current track.
+func (m *mediaPlayer) Rewind() error {
+	return m.call(jsonrpc.MethodMediaRewind, nil)
+}
+
+// Stop stops the playback.
+func (m *mediaPlayer) Stop() error {
+	return m.call(jsonrpc.MethodMediaStop, nil)
+}
+
+// call calls a media player method on the server.
+func (m *mediaPlayer) call(method string, result any) error {
+	return m.s.call(callContext(m.ctx), method, m.Address, jsonrpc.AddressParams{Address: m.Address}, result)
+}
+
+// callContext returns the provided context, or the background context if it is nil.
+func callContext(ctx context.Context) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+
+	return ctx
+}
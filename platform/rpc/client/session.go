@@ -0,0 +1,459 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	ac "github.com/bluetuith-org/api-native/api/appfeatures"
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/config"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/api/eventbus"
+	"github.com/bluetuith-org/api-native/platform/rpc/internal/jsonrpc"
+)
+
+// Session describes a Bluetooth session, which is exposed by an RPC server.
+type Session struct {
+	socketPath string
+
+	conn        *jsonrpc.Conn
+	authHandler bluetooth.SessionAuthorizer
+	authTimeout time.Duration
+	emitter     *eventbus.Emitter
+
+	scans    map[uint64]chan bluetooth.DiscoveryEvent
+	nextScan uint64
+
+	mu sync.Mutex
+}
+
+// NewSession returns a new session, which connects to the RPC server
+// listening on the Unix domain socket at the provided path.
+func NewSession(socketPath string) *Session {
+	return &Session{socketPath: socketPath}
+}
+
+// Start connects to the RPC server, and returns the features of the server's session.
+// If an authorization handler is provided, the session registers itself as the authorizer
+// of the server's session, and authorization requests are forwarded to the handler. The session
+// cannot be started if the server does not allow the client to register as the authorizer, or if
+// another client is already registered as the authorizer.
+func (s *Session) Start(authHandler bluetooth.SessionAuthorizer, cfg config.Configuration) (ac.FeatureSet, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn != nil {
+		return ac.NilFeatureSet(), wrapError(errorkinds.ErrSessionStart,
+			"rpc-session-start", bluetooth.MacAddress{},
+			"Session is already started",
+		)
+	}
+
+	if cfg.AuthTimeout == 0 {
+		cfg.AuthTimeout = config.DefaultAuthTimeout
+	}
+
+	s.authHandler = authHandler
+	s.authTimeout = cfg.AuthTimeout
+	s.scans = make(map[uint64]chan bluetooth.DiscoveryEvent)
+
+	s.emitter = cfg.EventEmitter
+	if s.emitter == nil {
+		s.emitter = eventbus.DefaultEmitter()
+	}
+	s.emitter = s.emitter.Scope()
+
+	s.publishState(bluetooth.SessionStarting, ac.NilFeatureSet())
+
+	c, err := net.Dial("unix", s.socketPath)
+	if err != nil {
+		return ac.NilFeatureSet(), wrapError(fmt.Errorf("%w: %w", errorkinds.ErrSessionStart, err),
+			"rpc-session-start-dial", bluetooth.MacAddress{},
+			"Cannot connect to the server",
+		)
+	}
+
+	conn := jsonrpc.NewConn(c, s.handle)
+
+	var features ac.FeatureSet
+	if err := conn.Call(context.Background(), jsonrpc.MethodSessionFeatures, nil, &features); err != nil {
+		conn.Close()
+
+		return ac.NilFeatureSet(), wrapError(err,
+			"rpc-session-start-features", bluetooth.MacAddress{},
+			"Cannot fetch the session features",
+		)
+	}
+
+	if authHandler != nil {
+		if err := conn.Call(context.Background(), jsonrpc.MethodSessionAuthorize, nil, nil); err != nil {
+			conn.Close()
+
+			return ac.NilFeatureSet(), wrapError(err,
+				"rpc-session-start-authorize", bluetooth.MacAddress{},
+				"Cannot register as the authorizer",
+			)
+		}
+	}
+
+	s.conn = conn
+	go s.watch(conn, features)
+
+	s.publishState(bluetooth.SessionReady, features)
+
+	return features, nil
+}
+
+// Stop disconnects from the RPC server, and closes all subscriptions
+// which were made via the session's event emitter (see Events).
+// The server's session is not stopped.
+func (s *Session) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		return wrapError(errorkinds.ErrSessionStop,
+			"rpc-session-stop", bluetooth.MacAddress{},
+			"Session is not started",
+		)
+	}
+
+	s.publishState(bluetooth.SessionStopping, ac.NilFeatureSet())
+
+	conn := s.conn
+	s.conn = nil
+
+	err := conn.Close()
+	s.closeScans()
+	s.emitter.CloseSubscriptions()
+
+	if err != nil {
+		return wrapError(err,
+			"rpc-session-stop", bluetooth.MacAddress{},
+			"Cannot close the server connection",
+		)
+	}
+
+	return nil
+}
+
+// Events returns the event emitter which the session publishes its events to.
+func (s *Session) Events() *eventbus.Emitter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.emitter == nil {
+		return eventbus.DefaultEmitter()
+	}
+
+	return s.emitter
+}
+
+// Adapters returns a list of known adapters.
+func (s *Session) Adapters() []bluetooth.AdapterData {
+	var adapters []bluetooth.AdapterData
+
+	if err := s.call(context.Background(), jsonrpc.MethodSessionAdapters, bluetooth.MacAddress{}, nil, &adapters); err != nil {
+		return nil
+	}
+
+	return adapters
+}
+
+// DefaultAdapter returns the default adapter of the server's session.
+func (s *Session) DefaultAdapter() (bluetooth.AdapterData, error) {
+	var adapter bluetooth.AdapterData
+
+	err := s.call(context.Background(), jsonrpc.MethodSessionDefaultAdapter, bluetooth.MacAddress{}, nil, &adapter)
+
+	return adapter, err
+}
+
+// Adapter returns a function call interface to invoke adapter related functions.
+func (s *Session) Adapter(adapterAddress bluetooth.MacAddress) bluetooth.Adapter {
+	return &adapter{s: s, Address: adapterAddress}
+}
+
+// Device returns a function call interface to invoke device related functions.
+func (s *Session) Device(deviceAddress bluetooth.MacAddress) bluetooth.Device {
+	return &device{s: s, Address: deviceAddress}
+}
+
+// Obex returns a function call interface to invoke obex related functions.
+func (s *Session) Obex(deviceAddress bluetooth.MacAddress) bluetooth.Obex {
+	return &obex{s: s, Address: deviceAddress}
+}
+
+// Network returns a function call interface to invoke network related functions.
+func (s *Session) Network(deviceAddress bluetooth.MacAddress) bluetooth.Network {
+	return &network{s: s, Address: deviceAddress}
+}
+
+// MediaPlayer returns a function call interface to invoke media player/control
+// related functions on a device.
+func (s *Session) MediaPlayer(deviceAddress bluetooth.MacAddress) bluetooth.MediaPlayer {
+	return &mediaPlayer{s: s, Address: deviceAddress}
+}
+
+// call calls a method on the server.
+func (s *Session) call(ctx context.Context, method string, address bluetooth.MacAddress, params, result any) error {
+	s.mu.Lock()
+	conn := s.conn
+	s.mu.Unlock()
+
+	if conn == nil {
+		return wrapError(errorkinds.ErrMethodCall,
+			"rpc-"+method, address,
+			"Session is not started",
+		)
+	}
+
+	err := conn.Call(ctx, method, params, result)
+	if err == nil {
+		return nil
+	}
+
+	if ctx.Err() != nil {
+		err = errorkinds.ErrMethodCanceled
+	}
+
+	return wrapError(err,
+		"rpc-"+method, address,
+		"Cannot call method on the server",
+	)
+}
+
+// watch waits for the connection to the server to be closed, and publishes
+// a session event if the connection was not closed by the session.
+func (s *Session) watch(conn *jsonrpc.Conn, features ac.FeatureSet) {
+	<-conn.Done()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn != conn {
+		return
+	}
+
+	s.conn = nil
+	s.closeScans()
+
+	var ce ac.Errors
+	ce.Append(ac.NewError(features.Supported, errorkinds.ErrDaemonLost))
+	s.publishState(bluetooth.SessionDaemonLost, ac.NewFeatureSet(ac.FeatureNone, ce))
+}
+
+// handle handles the event notifications and authorization requests from the server.
+func (s *Session) handle(ctx context.Context, _ *jsonrpc.Conn, method string, params json.RawMessage) (any, error) {
+	switch method {
+	case jsonrpc.MethodEvent:
+		return nil, s.publishEvent(params)
+
+	case jsonrpc.MethodDiscovery:
+		return nil, s.sendDiscoveryEvent(params)
+	}
+
+	p, err := jsonrpc.DecodeParams[jsonrpc.AuthParams](params)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.authHandler == nil {
+		return nil, jsonrpc.ErrMethodNotFound
+	}
+
+	timeout := bluetooth.NewAuthTimeout(s.authTimeout)
+	defer timeout.Cancel()
+
+	stop := context.AfterFunc(ctx, timeout.Cancel)
+	defer stop()
+
+	switch method {
+	case jsonrpc.MethodAuthTransfer:
+		var props bluetooth.FileTransferData
+		if p.Transfer != nil {
+			props = *p.Transfer
+		}
+
+		return nil, s.authHandler.AuthorizeTransfer(timeout, p.Path, props)
+
+	case jsonrpc.MethodAuthDisplayPinCode:
+		return nil, s.authHandler.DisplayPinCode(timeout, p.Address, p.PinCode)
+
+	case jsonrpc.MethodAuthDisplayPasskey:
+		return nil, s.authHandler.DisplayPasskey(timeout, p.Address, p.Passkey, p.Entered)
+
+	case jsonrpc.MethodAuthConfirmPasskey:
+		return nil, s.authHandler.ConfirmPasskey(timeout, p.Address, p.Passkey)
+
+	case jsonrpc.MethodAuthPairing:
+		return nil, s.authHandler.AuthorizePairing(timeout, p.Address)
+
+	case jsonrpc.MethodAuthService:
+		return nil, s.authHandler.AuthorizeService(timeout, p.Address, p.UUID)
+	}
+
+	return nil, jsonrpc.ErrMethodNotFound
+}
+
+// publishEvent decodes and publishes an event, which was streamed by the server.
+func (s *Session) publishEvent(params json.RawMessage) error {
+	p, err := jsonrpc.DecodeParams[jsonrpc.EventParams](params)
+	if err != nil {
+		return err
+	}
+
+	switch p.ID {
+	case bluetooth.EventError:
+		return publish(s.emitter, bluetooth.ErrorEvent(), p.Data)
+
+	case bluetooth.EventAdapter:
+		return publish(s.emitter, bluetooth.AdapterEvent(p.Action), p.Data)
+
+	case bluetooth.EventDevice:
+		return publish(s.emitter, bluetooth.DeviceEvent(p.Action), p.Data)
+
+	case bluetooth.EventFileTransfer:
+		return publish(s.emitter, bluetooth.FileTransferEvent(p.Action), p.Data)
+
+	case bluetooth.EventMediaPlayer:
+		return publish(s.emitter, bluetooth.MediaEvent(p.Action), p.Data)
+
+	case bluetooth.EventSession:
+		return publish(s.emitter, bluetooth.SessionEvent(), p.Data)
+
+	case bluetooth.EventPrune:
+		return publish(s.emitter, bluetooth.PruneEvent(), p.Data)
+
+	case bluetooth.EventDefaultAdapter:
+		return publish(s.emitter, bluetooth.DefaultAdapterEvent(), p.Data)
+
+	case bluetooth.EventConnectAttempt:
+		return publish(s.emitter, bluetooth.ConnectAttemptEvent(), p.Data)
+
+	case bluetooth.EventSetup:
+		return publish(s.emitter, bluetooth.SetupEvent(), p.Data)
+	}
+
+	return nil
+}
+
+// discover starts a scoped discovery session on the server, and returns a channel
+// which receives the discovery events that are sent by the server for the session.
+func (s *Session) discover(
+	ctx context.Context, address bluetooth.MacAddress, opts bluetooth.DiscoveryOptions,
+) (<-chan bluetooth.DiscoveryEvent, error) {
+	events := make(chan bluetooth.DiscoveryEvent, 10)
+
+	s.mu.Lock()
+	if s.scans == nil {
+		s.mu.Unlock()
+
+		return nil, wrapError(errorkinds.ErrMethodCall,
+			"rpc-"+jsonrpc.MethodAdapterDiscover, address,
+			"Session is not started",
+		)
+	}
+
+	s.nextScan++
+	id := s.nextScan
+	s.scans[id] = events
+	s.mu.Unlock()
+
+	params := jsonrpc.DiscoverParams{ID: id, Address: address, Options: opts}
+	if err := s.call(ctx, jsonrpc.MethodAdapterDiscover, address, params, nil); err != nil {
+		s.mu.Lock()
+		delete(s.scans, id)
+		s.mu.Unlock()
+
+		return nil, err
+	}
+
+	context.AfterFunc(ctx, func() {
+		_ = s.call(context.Background(), jsonrpc.MethodAdapterStopDiscover, address, jsonrpc.ScanParams{ID: id}, nil)
+	})
+
+	return events, nil
+}
+
+// sendDiscoveryEvent sends a discovery event from the server to the channel of its discovery session.
+// Events are dropped if the channel is full.
+func (s *Session) sendDiscoveryEvent(params json.RawMessage) error {
+	p, err := jsonrpc.DecodeParams[jsonrpc.DiscoveryParams](params)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	events, ok := s.scans[p.ID]
+	if !ok {
+		return nil
+	}
+
+	if p.Done {
+		delete(s.scans, p.ID)
+		close(events)
+
+		return nil
+	}
+
+	if p.Event != nil {
+		select {
+		case events <- *p.Event:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// closeScans closes the channels of all discovery sessions.
+func (s *Session) closeScans() {
+	for id, events := range s.scans {
+		delete(s.scans, id)
+		close(events)
+	}
+}
+
+// publishState publishes a session event with the provided state and features.
+func (s *Session) publishState(state bluetooth.SessionState, features ac.FeatureSet) {
+	bluetooth.SessionEvent().On(s.emitter).Publish(bluetooth.SessionEventData{
+		State:    state,
+		Features: features,
+	})
+}
+
+// publish decodes the event data, and publishes it to the provided emitter.
+func publish[T bluetooth.Events](emitter *eventbus.Emitter, event bluetooth.Event[T], data json.RawMessage) error {
+	var decoded T
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return errorkinds.ErrEventDataParse
+	}
+
+	event.On(emitter).Publish(decoded)
+
+	return nil
+}
+
+// wrapError wraps an error with the call site and address metadata.
+func wrapError(err error, errorAt string, address bluetooth.MacAddress, message string) error {
+	return fault.Wrap(err,
+		fctx.With(context.Background(),
+			"error_at", errorAt,
+			"address", address.String(),
+		),
+		ftag.With(ftag.Internal),
+		fmsg.With(message),
+	)
+}
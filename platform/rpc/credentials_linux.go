@@ -0,0 +1,168 @@
+//go:build linux
+
+package rpc
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// The permission bits which are checked for files and directories.
+const (
+	permRead    = 0o4
+	permExecute = 0o1
+)
+
+// peerCredentials returns the credentials of the client process which is connected
+// to the other end of the Unix domain socket (SO_PEERCRED).
+func peerCredentials(conn net.Conn) (Credentials, error) {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return Credentials{}, errors.New("connection is not a Unix domain socket")
+	}
+
+	raw, err := unixConn.SyscallConn()
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	var ucred *syscall.Ucred
+
+	cerr := raw.Control(func(fd uintptr) {
+		ucred, err = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	})
+	if cerr != nil {
+		return Credentials{}, cerr
+	}
+
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	return Credentials{PID: ucred.Pid, UID: ucred.Uid, GID: ucred.Gid}, nil
+}
+
+// inGroup returns whether the client process is a member of the group, either
+// via its primary group, or via its supplementary groups (read from /proc/<pid>/status).
+func (c Credentials) inGroup(gid uint32) bool {
+	if c.GID == gid {
+		return true
+	}
+
+	file, err := os.Open(filepath.Join("/proc", strconv.Itoa(int(c.PID)), "status"))
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		groups, ok := strings.CutPrefix(scanner.Text(), "Groups:")
+		if !ok {
+			continue
+		}
+
+		for _, group := range strings.Fields(groups) {
+			if id, err := strconv.ParseUint(group, 10, 32); err == nil && uint32(id) == gid {
+				return true
+			}
+		}
+
+		break
+	}
+
+	return false
+}
+
+// readablePath resolves the path of a file, and checks whether the client process can read
+// the file, and search all the directories leading to it. The resolved path is returned, so
+// that the file which was checked is the one that is used.
+func (c Credentials) readablePath(path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("path %q is not absolute", path)
+	}
+
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+
+	info, err := os.Stat(resolved)
+	if err != nil {
+		return "", err
+	}
+
+	if !info.Mode().IsRegular() || !c.permitted(info, permRead) {
+		return "", fmt.Errorf("file %q cannot be read by the client", path)
+	}
+
+	for dir := filepath.Dir(resolved); ; dir = filepath.Dir(dir) {
+		info, err := os.Stat(dir)
+		if err != nil {
+			return "", err
+		}
+
+		if !c.permitted(info, permExecute) {
+			return "", fmt.Errorf("directory %q cannot be searched by the client", dir)
+		}
+
+		if dir == filepath.Dir(dir) {
+			break
+		}
+	}
+
+	return resolved, nil
+}
+
+// permitted returns whether the client process has the provided permission
+// on the file, according to the file's owner, group and mode.
+func (c Credentials) permitted(info os.FileInfo, perm os.FileMode) bool {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return false
+	}
+
+	mode := info.Mode().Perm()
+
+	switch {
+	case c.UID == 0:
+		return true
+
+	case stat.Uid == c.UID:
+		return mode&(perm<<6) != 0
+
+	case c.inGroup(stat.Gid):
+		return mode&(perm<<3) != 0
+	}
+
+	return mode&perm != 0
+}
+
+// lockSocket acquires an exclusive lock on the lock file of the socket, which is held
+// while the server is listening on the socket. If another server holds the lock, an error
+// is returned. The lock file is kept after the lock is released, so that it can be reused.
+func lockSocket(socketPath string) (*os.File, error) {
+	file, err := os.OpenFile(socketPath+".lock", os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		file.Close()
+
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return nil, errors.New("address already in use")
+		}
+
+		return nil, err
+	}
+
+	return file, nil
+}
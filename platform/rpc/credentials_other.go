@@ -0,0 +1,33 @@
+//go:build !linux
+
+package rpc
+
+import (
+	"errors"
+	"net"
+	"os"
+)
+
+// errCredentialsUnsupported is returned when the credentials of clients cannot be checked.
+var errCredentialsUnsupported = errors.New("peer credentials are not supported on this platform")
+
+// peerCredentials returns an error, since the credentials of the client
+// process cannot be read on this platform.
+func peerCredentials(net.Conn) (Credentials, error) {
+	return Credentials{}, errCredentialsUnsupported
+}
+
+// inGroup returns whether the client process is a member of the group.
+func (c Credentials) inGroup(gid uint32) bool {
+	return c.GID == gid
+}
+
+// readablePath returns an error, since file permissions cannot be checked on this platform.
+func (Credentials) readablePath(string) (string, error) {
+	return "", errCredentialsUnsupported
+}
+
+// lockSocket returns an error, since the socket cannot be locked on this platform.
+func lockSocket(string) (*os.File, error) {
+	return nil, errCredentialsUnsupported
+}
@@ -0,0 +1,14 @@
+/*
+Package rpc provides a server which exposes a Bluetooth session over a Unix domain socket,
+using JSON-RPC 2.0 with newline-delimited messages.
+
+This allows a single privileged process to own the session, while several unprivileged
+applications use it via the client package (rpc/client), which implements the bluetooth.Session
+interface. All session events are streamed to every connected client as "event" notifications,
+and authorization requests (for example, pairing confirmations) are forwarded to the client
+which registered itself as the authorizer.
+
+Clients are checked against the server's access policy (see AccessPolicy) using the credentials
+of their socket connections, and a client can only send files which it can read itself.
+*/
+package rpc
@@ -0,0 +1,88 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/platform/rpc/internal/jsonrpc"
+)
+
+// addressHandler describes a function which handles a request that only has an address parameter.
+type addressHandler func(ctx context.Context, session bluetooth.Session, address bluetooth.MacAddress) (any, error)
+
+// addressHandlers holds the handlers of the requests which only have an address parameter.
+var addressHandlers = map[string]addressHandler{
+	jsonrpc.MethodAdapterStartDiscovery:    adapterCall(bluetooth.Adapter.StartDiscovery),
+	jsonrpc.MethodAdapterStopDiscovery:     adapterCall(bluetooth.Adapter.StopDiscovery),
+	jsonrpc.MethodAdapterUnblockAndPowerOn: adapterCall(bluetooth.Adapter.UnblockAndPowerOn),
+	jsonrpc.MethodAdapterDiscoveryFilters: func(ctx context.Context, session bluetooth.Session, address bluetooth.MacAddress) (any, error) {
+		return session.Adapter(address).WithContext(ctx).DiscoveryFilters()
+	},
+	jsonrpc.MethodAdapterProperties: func(ctx context.Context, session bluetooth.Session, address bluetooth.MacAddress) (any, error) {
+		return session.Adapter(address).WithContext(ctx).Properties()
+	},
+	jsonrpc.MethodAdapterDevices: func(ctx context.Context, session bluetooth.Session, address bluetooth.MacAddress) (any, error) {
+		return session.Adapter(address).WithContext(ctx).Devices()
+	},
+
+	jsonrpc.MethodDevicePair:          deviceCall(bluetooth.Device.Pair),
+	jsonrpc.MethodDeviceCancelPairing: deviceCall(bluetooth.Device.CancelPairing),
+	jsonrpc.MethodDeviceConnect:       deviceCall(bluetooth.Device.Connect),
+	jsonrpc.MethodDeviceDisconnect:    deviceCall(bluetooth.Device.Disconnect),
+	jsonrpc.MethodDeviceRemove:        deviceCall(bluetooth.Device.Remove),
+	jsonrpc.MethodDeviceProperties: func(ctx context.Context, session bluetooth.Session, address bluetooth.MacAddress) (any, error) {
+		return session.Device(address).WithContext(ctx).Properties()
+	},
+
+	jsonrpc.MethodObexCreateSession: func(ctx context.Context, session bluetooth.Session, address bluetooth.MacAddress) (any, error) {
+		return nil, session.Obex(address).FileTransfer().CreateSession(ctx)
+	},
+	jsonrpc.MethodObexRemoveSession:   transferCall(bluetooth.ObexFileTransfer.RemoveSession),
+	jsonrpc.MethodObexCancelTransfer:  transferCall(bluetooth.ObexFileTransfer.CancelTransfer),
+	jsonrpc.MethodObexSuspendTransfer: transferCall(bluetooth.ObexFileTransfer.SuspendTransfer),
+	jsonrpc.MethodObexResumeTransfer:  transferCall(bluetooth.ObexFileTransfer.ResumeTransfer),
+
+	jsonrpc.MethodNetworkDisconnect: func(ctx context.Context, session bluetooth.Session, address bluetooth.MacAddress) (any, error) {
+		return nil, session.Network(address).WithContext(ctx).Disconnect()
+	},
+
+	jsonrpc.MethodMediaProperties: func(ctx context.Context, session bluetooth.Session, address bluetooth.MacAddress) (any, error) {
+		return session.MediaPlayer(address).WithContext(ctx).Properties()
+	},
+	jsonrpc.MethodMediaPlay:            mediaCall(bluetooth.MediaPlayer.Play),
+	jsonrpc.MethodMediaPause:           mediaCall(bluetooth.MediaPlayer.Pause),
+	jsonrpc.MethodMediaTogglePlayPause: mediaCall(bluetooth.MediaPlayer.TogglePlayPause),
+	jsonrpc.MethodMediaNext:            mediaCall(bluetooth.MediaPlayer.Next),
+	jsonrpc.MethodMediaPrevious:        mediaCall(bluetooth.MediaPlayer.Previous),
+	jsonrpc.MethodMediaFastForward:     mediaCall(bluetooth.MediaPlayer.FastForward),
+	jsonrpc.MethodMediaRewind:          mediaCall(bluetooth.MediaPlayer.Rewind),
+	jsonrpc.MethodMediaStop:            mediaCall(bluetooth.MediaPlayer.Stop),
+}
+
+// adapterCall returns a handler which calls an adapter method.
+func adapterCall(call func(bluetooth.Adapter) error) addressHandler {
+	return func(ctx context.Context, session bluetooth.Session, address bluetooth.MacAddress) (any, error) {
+		return nil, call(session.Adapter(address).WithContext(ctx))
+	}
+}
+
+// deviceCall returns a handler which calls a device method.
+func deviceCall(call func(bluetooth.Device) error) addressHandler {
+	return func(ctx context.Context, session bluetooth.Session, address bluetooth.MacAddress) (any, error) {
+		return nil, call(session.Device(address).WithContext(ctx))
+	}
+}
+
+// transferCall returns a handler which calls a file transfer method.
+func transferCall(call func(bluetooth.ObexFileTransfer) error) addressHandler {
+	return func(_ context.Context, session bluetooth.Session, address bluetooth.MacAddress) (any, error) {
+		return nil, call(session.Obex(address).FileTransfer())
+	}
+}
+
+// mediaCall returns a handler which calls a media player method.
+func mediaCall(call func(bluetooth.MediaPlayer) error) addressHandler {
+	return func(ctx context.Context, session bluetooth.Session, address bluetooth.MacAddress) (any, error) {
+		return nil, call(session.MediaPlayer(address).WithContext(ctx))
+	}
+}
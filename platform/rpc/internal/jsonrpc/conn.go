@@ -0,0 +1,314 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Version is the JSON-RPC protocol version.
+const Version = "2.0"
+
+// The limits of the outgoing messages of a connection.
+const (
+	// QueueSize is the maximum number of messages which are queued to be sent to the peer.
+	// If a notification is sent while the queue is full, the peer is considered to be
+	// too slow to keep up, and the connection is closed.
+	QueueSize = 1024
+
+	// WriteTimeout is the maximum duration to write a message to the peer,
+	// after which the connection is closed.
+	WriteTimeout = 10 * time.Second
+)
+
+// Handler describes a function which handles incoming requests and notifications.
+// The context is cancelled if the peer cancels the request, or if the connection is closed.
+// Notifications are handled in the order they are received, so the handler must not block
+// while handling them. Requests are handled concurrently.
+type Handler func(ctx context.Context, conn *Conn, method string, params json.RawMessage) (any, error)
+
+// Conn describes a JSON-RPC connection with a peer.
+type Conn struct {
+	conn    net.Conn
+	handler Handler
+	queue   chan message
+
+	nextID   atomic.Uint64
+	pending  map[string]chan message
+	incoming map[string]context.CancelFunc
+	mu       sync.Mutex
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// message describes a JSON-RPC request, notification or response.
+type message struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+// NewConn returns a new JSON-RPC connection, and starts reading messages from the peer.
+// Incoming requests and notifications are handled by the provided handler.
+// Outgoing messages are queued, and written to the peer in the order they are sent.
+func NewConn(conn net.Conn, handler Handler) *Conn {
+	c := &Conn{
+		conn:     conn,
+		handler:  handler,
+		queue:    make(chan message, QueueSize),
+		pending:  make(map[string]chan message),
+		incoming: make(map[string]context.CancelFunc),
+	}
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+
+	go c.read()
+	go c.writeQueued()
+
+	return c
+}
+
+// Call sends a request to the peer and waits for its response, which is decoded
+// into the result (if it is not nil). If the context is cancelled before a response
+// is received, the request is cancelled on the peer.
+func (c *Conn) Call(ctx context.Context, method string, params, result any) error {
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	id := json.RawMessage(strconv.FormatUint(c.nextID.Add(1), 10))
+	reply := make(chan message, 1)
+
+	c.mu.Lock()
+	if c.ctx.Err() != nil {
+		c.mu.Unlock()
+		return ErrConnectionClosed
+	}
+	c.pending[string(id)] = reply
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, string(id))
+		c.mu.Unlock()
+	}()
+
+	if err := c.write(message{Version: Version, ID: id, Method: method, Params: encoded}); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		_ = c.Notify(MethodCancel, CancelParams{ID: id})
+		return ctx.Err()
+
+	case <-c.ctx.Done():
+		return ErrConnectionClosed
+
+	case response := <-reply:
+		if response.Error != nil {
+			return response.Error
+		}
+
+		if result != nil && len(response.Result) > 0 {
+			if err := json.Unmarshal(response.Result, result); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Notify sends a notification to the peer, without waiting for it to be written.
+// If the queue of outgoing messages is full, the connection is closed.
+func (c *Conn) Notify(method string, params any) error {
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-c.ctx.Done():
+		return ErrConnectionClosed
+
+	case c.queue <- message{Version: Version, Method: method, Params: encoded}:
+		return nil
+
+	default:
+		c.Close()
+
+		return ErrConnectionClosed
+	}
+}
+
+// Done returns a channel which is closed when the connection is closed.
+func (c *Conn) Done() <-chan struct{} {
+	return c.ctx.Done()
+}
+
+// Close closes the connection, and cancels all pending requests.
+func (c *Conn) Close() error {
+	c.cancel()
+
+	err := c.conn.Close()
+	if errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+
+	return err
+}
+
+// read reads and dispatches messages from the peer, until the connection is closed.
+func (c *Conn) read() {
+	defer c.Close()
+
+	decoder := json.NewDecoder(c.conn)
+
+	for {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) && c.ctx.Err() == nil {
+				_ = c.write(message{Version: Version, ID: json.RawMessage("null"), Error: NewError(CodeParseError, err)})
+			}
+
+			return
+		}
+
+		var msg message
+		if err := json.Unmarshal(raw, &msg); err != nil || msg.Version != Version {
+			if err == nil {
+				err = errors.New("invalid protocol version")
+			}
+
+			_ = c.write(message{Version: Version, ID: json.RawMessage("null"), Error: NewError(CodeInvalidRequest, err)})
+
+			continue
+		}
+
+		switch {
+		case msg.Method == MethodCancel:
+			c.cancelIncoming(msg.Params)
+
+		case msg.Method != "" && !isID(msg.ID):
+			c.handle(msg)
+
+		case msg.Method != "":
+			go c.handle(msg)
+
+		case isID(msg.ID):
+			c.mu.Lock()
+			reply, ok := c.pending[string(msg.ID)]
+			c.mu.Unlock()
+
+			if ok {
+				select {
+				case reply <- msg:
+				default:
+				}
+			}
+		}
+	}
+}
+
+// handle calls the handler with an incoming request or notification,
+// and sends the response to the peer if the message is a request.
+func (c *Conn) handle(msg message) {
+	ctx, cancel := context.WithCancel(c.ctx)
+	defer cancel()
+
+	isRequest := isID(msg.ID)
+	if isRequest {
+		c.mu.Lock()
+		c.incoming[string(msg.ID)] = cancel
+		c.mu.Unlock()
+
+		defer func() {
+			c.mu.Lock()
+			delete(c.incoming, string(msg.ID))
+			c.mu.Unlock()
+		}()
+	}
+
+	result, err := c.handler(ctx, c, msg.Method, msg.Params)
+	if !isRequest {
+		return
+	}
+
+	response := message{Version: Version, ID: msg.ID}
+
+	if err != nil {
+		response.Error = ToError(err)
+	} else if response.Result, err = json.Marshal(result); err != nil {
+		response.Result, response.Error = nil, NewError(CodeInternalError, err)
+	}
+
+	_ = c.write(response)
+}
+
+// cancelIncoming cancels an incoming request.
+func (c *Conn) cancelIncoming(params json.RawMessage) {
+	var p CancelParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	cancel, ok := c.incoming[string(p.ID)]
+	c.mu.Unlock()
+
+	if ok {
+		cancel()
+	}
+}
+
+// write queues a message to be sent to the peer, and waits if the queue is full.
+func (c *Conn) write(msg message) error {
+	select {
+	case <-c.ctx.Done():
+		return ErrConnectionClosed
+
+	case c.queue <- msg:
+		return nil
+	}
+}
+
+// writeQueued encodes and sends the queued messages to the peer, until the connection
+// is closed. If a message cannot be written within the write timeout, the connection is closed.
+func (c *Conn) writeQueued() {
+	defer c.Close()
+
+	encoder := json.NewEncoder(c.conn)
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+
+		case msg := <-c.queue:
+			if err := c.conn.SetWriteDeadline(time.Now().Add(WriteTimeout)); err != nil {
+				return
+			}
+
+			if err := encoder.Encode(msg); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// isID returns whether the message ID is set.
+func isID(id json.RawMessage) bool {
+	return len(id) > 0 && !bytes.Equal(id, []byte("null"))
+}
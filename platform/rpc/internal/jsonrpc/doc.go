@@ -0,0 +1,9 @@
+/*
+Package jsonrpc provides a minimal JSON-RPC 2.0 peer, which sends and receives
+newline-delimited requests, responses and notifications over a stream connection.
+Both ends of a connection can issue requests to each other.
+
+It also defines the methods and parameters which are used by the RPC server
+and client to expose a Bluetooth session.
+*/
+package jsonrpc
@@ -0,0 +1,125 @@
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+)
+
+// The different JSON-RPC error codes.
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+	CodeServerError    = -32000
+)
+
+// The different protocol errors.
+var (
+	ErrConnectionClosed = errors.New("connection is closed")
+	ErrMethodNotFound   = errors.New("method not found")
+	ErrInvalidParams    = errors.New("invalid parameters")
+)
+
+// Error describes a JSON-RPC error object.
+type Error struct {
+	Code    int        `json:"code"`
+	Message string     `json:"message"`
+	Data    *ErrorData `json:"data,omitempty"`
+}
+
+// ErrorData holds additional information about an error.
+type ErrorData struct {
+	// Kind holds the text of the error kind (from the errorkinds package)
+	// which the error wraps, so that it can be matched by the peer.
+	Kind string `json:"kind,omitempty"`
+}
+
+// errorKinds holds the errors which are matched across a connection.
+var errorKinds = []error{
+	errorkinds.ErrSessionStart,
+	errorkinds.ErrSessionStop,
+	errorkinds.ErrDaemonLost,
+	errorkinds.ErrSessionReadOnly,
+	errorkinds.ErrMethodCall,
+	errorkinds.ErrMethodCanceled,
+	errorkinds.ErrPermissionDenied,
+	errorkinds.ErrInvalidAddress,
+	errorkinds.ErrAdapterNotFound,
+	errorkinds.ErrDeviceNotFound,
+	errorkinds.ErrDeviceUnreachable,
+	errorkinds.ErrDeviceServicesNotResolved,
+	errorkinds.ErrAdapterSoftBlocked,
+	errorkinds.ErrAdapterHardBlocked,
+	errorkinds.ErrObexInitSession,
+	errorkinds.ErrNetworkInitSession,
+	errorkinds.ErrNetworkAlreadyActive,
+	errorkinds.ErrNetworkEstablishError,
+	errorkinds.ErrMediaPlayerNotConnected,
+	errorkinds.ErrPropertyDataParse,
+	errorkinds.ErrEventDataParse,
+	context.Canceled,
+	context.DeadlineExceeded,
+}
+
+// NewError returns a JSON-RPC error with the provided code.
+func NewError(code int, err error) *Error {
+	return &Error{Code: code, Message: err.Error()}
+}
+
+// ToError converts an error returned by a handler to a JSON-RPC error.
+func ToError(err error) *Error {
+	var rpcErr *Error
+	if errors.As(err, &rpcErr) {
+		return rpcErr
+	}
+
+	code := CodeServerError
+
+	switch {
+	case errors.Is(err, ErrMethodNotFound):
+		code = CodeMethodNotFound
+
+	case errors.Is(err, ErrInvalidParams):
+		code = CodeInvalidParams
+	}
+
+	e := NewError(code, err)
+
+	for _, kind := range errorKinds {
+		if errors.Is(err, kind) {
+			e.Data = &ErrorData{Kind: kind.Error()}
+			break
+		}
+	}
+
+	return e
+}
+
+// Error returns the error message.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is returns whether the error matches the provided error kind.
+func (e *Error) Is(target error) bool {
+	switch {
+	case e.Data != nil && e.Data.Kind != "":
+		for _, kind := range errorKinds {
+			if kind == target {
+				return kind.Error() == e.Data.Kind
+			}
+		}
+
+	case e.Code == CodeMethodNotFound:
+		return target == ErrMethodNotFound
+
+	case e.Code == CodeInvalidParams:
+		return target == ErrInvalidParams
+	}
+
+	return false
+}
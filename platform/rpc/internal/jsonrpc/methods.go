@@ -0,0 +1,223 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/google/uuid"
+)
+
+// The internal protocol methods.
+const (
+	// MethodCancel is sent as a notification to cancel a pending request.
+	MethodCancel = "rpc.cancel"
+
+	// MethodEvent is sent as a notification from the server to stream session events.
+	MethodEvent = "event"
+
+	// MethodDiscovery is sent as a notification from the server to stream
+	// the devices that are found during a scoped discovery session.
+	MethodDiscovery = "discovery"
+)
+
+// The session methods, which are called by the client.
+const (
+	MethodSessionFeatures       = "session.features"
+	MethodSessionAdapters       = "session.adapters"
+	MethodSessionDefaultAdapter = "session.default_adapter"
+	MethodSessionAuthorize      = "session.authorize"
+
+	MethodAdapterStartDiscovery         = "adapter.start_discovery"
+	MethodAdapterStopDiscovery          = "adapter.stop_discovery"
+	MethodAdapterDiscover               = "adapter.discover"
+	MethodAdapterStopDiscover           = "adapter.stop_discover"
+	MethodAdapterSetDiscoveryFilter     = "adapter.set_discovery_filter"
+	MethodAdapterDiscoveryFilters       = "adapter.discovery_filters"
+	MethodAdapterSetPoweredState        = "adapter.set_powered_state"
+	MethodAdapterSetDiscoverableState   = "adapter.set_discoverable_state"
+	MethodAdapterSetPairableState       = "adapter.set_pairable_state"
+	MethodAdapterPruneDevices           = "adapter.prune_devices"
+	MethodAdapterConnectDevice          = "adapter.connect_device"
+	MethodAdapterUnblockAndPowerOn      = "adapter.unblock_and_power_on"
+	MethodAdapterSetAlias               = "adapter.set_alias"
+	MethodAdapterSetDiscoverableTimeout = "adapter.set_discoverable_timeout"
+	MethodAdapterSetPairableTimeout     = "adapter.set_pairable_timeout"
+	MethodAdapterProperties             = "adapter.properties"
+	MethodAdapterDevices                = "adapter.devices"
+
+	MethodDevicePair              = "device.pair"
+	MethodDeviceCancelPairing     = "device.cancel_pairing"
+	MethodDeviceConnect           = "device.connect"
+	MethodDeviceConnectWithRetry  = "device.connect_with_retry"
+	MethodDeviceDisconnect        = "device.disconnect"
+	MethodDeviceConnectProfile    = "device.connect_profile"
+	MethodDeviceDisconnectProfile = "device.disconnect_profile"
+	MethodDeviceRemove            = "device.remove"
+	MethodDeviceSetTrusted        = "device.set_trusted"
+	MethodDeviceSetBlocked        = "device.set_blocked"
+	MethodDeviceSetAlias          = "device.set_alias"
+	MethodDeviceSetWakeAllowed    = "device.set_wake_allowed"
+	MethodDeviceSetAutoReconnect  = "device.set_auto_reconnect"
+	MethodDeviceSetup             = "device.setup"
+	MethodDeviceProperties        = "device.properties"
+
+	MethodObexCreateSession   = "obex.create_session"
+	MethodObexRemoveSession   = "obex.remove_session"
+	MethodObexSendFile        = "obex.send_file"
+	MethodObexCancelTransfer  = "obex.cancel_transfer"
+	MethodObexSuspendTransfer = "obex.suspend_transfer"
+	MethodObexResumeTransfer  = "obex.resume_transfer"
+
+	MethodNetworkConnect    = "network.connect"
+	MethodNetworkDisconnect = "network.disconnect"
+
+	MethodMediaProperties      = "media.properties"
+	MethodMediaPlay            = "media.play"
+	MethodMediaPause           = "media.pause"
+	MethodMediaTogglePlayPause = "media.toggle_play_pause"
+	MethodMediaNext            = "media.next"
+	MethodMediaPrevious        = "media.previous"
+	MethodMediaFastForward     = "media.fast_forward"
+	MethodMediaRewind          = "media.rewind"
+	MethodMediaStop            = "media.stop"
+)
+
+// The authorization methods, which are called by the server on the client
+// that registered itself as the session's authorizer.
+const (
+	MethodAuthTransfer       = "auth.transfer"
+	MethodAuthDisplayPinCode = "auth.display_pincode"
+	MethodAuthDisplayPasskey = "auth.display_passkey"
+	MethodAuthConfirmPasskey = "auth.confirm_passkey"
+	MethodAuthPairing        = "auth.pairing"
+	MethodAuthService        = "auth.service"
+)
+
+// CancelParams holds the parameters of a cancellation notification.
+type CancelParams struct {
+	ID json.RawMessage `json:"id"`
+}
+
+// AddressParams holds the address of an adapter or a device.
+type AddressParams struct {
+	Address bluetooth.MacAddress `json:"address"`
+}
+
+// StateParams holds the parameters to toggle the state of an adapter or a device.
+type StateParams struct {
+	Address bluetooth.MacAddress `json:"address"`
+	Enable  bool                 `json:"enable"`
+}
+
+// AliasParams holds the parameters to set the alias of an adapter or a device.
+type AliasParams struct {
+	Address bluetooth.MacAddress `json:"address"`
+	Alias   string               `json:"alias"`
+}
+
+// TimeoutParams holds the parameters to set a timeout (in seconds) of an adapter.
+type TimeoutParams struct {
+	Address bluetooth.MacAddress `json:"address"`
+	Timeout uint32               `json:"timeout"`
+}
+
+// PruneParams holds the parameters to remove the stale devices of an adapter.
+type PruneParams struct {
+	Address bluetooth.MacAddress   `json:"address"`
+	Options bluetooth.PruneOptions `json:"options"`
+}
+
+// ConnectDeviceParams holds the parameters to connect to a device by its address.
+type ConnectDeviceParams struct {
+	Address     bluetooth.MacAddress  `json:"address"`
+	Device      bluetooth.MacAddress  `json:"device"`
+	AddressType bluetooth.AddressType `json:"address_type"`
+}
+
+// ConnectRetryParams holds the parameters to connect to a device using a retry policy.
+type ConnectRetryParams struct {
+	Address bluetooth.MacAddress         `json:"address"`
+	Policy  bluetooth.ConnectRetryPolicy `json:"policy"`
+}
+
+// SetupParams holds the parameters to run the setup workflow of a device.
+type SetupParams struct {
+	Address bluetooth.MacAddress   `json:"address"`
+	Options bluetooth.SetupOptions `json:"options"`
+}
+
+// DiscoverParams holds the parameters to start a scoped discovery session.
+// The ID is chosen by the client, and must be unique within the connection.
+type DiscoverParams struct {
+	ID      uint64                     `json:"id"`
+	Address bluetooth.MacAddress       `json:"address"`
+	Options bluetooth.DiscoveryOptions `json:"options"`
+}
+
+// ScanParams holds the ID of a scoped discovery session.
+type ScanParams struct {
+	ID uint64 `json:"id"`
+}
+
+// DiscoveryParams holds the parameters of a discovery notification.
+// If Done is set, the discovery session has ended.
+type DiscoveryParams struct {
+	ID    uint64                    `json:"id"`
+	Event *bluetooth.DiscoveryEvent `json:"event,omitempty"`
+	Done  bool                      `json:"done,omitempty"`
+}
+
+// FilterParams holds the parameters to set the discovery filter of an adapter.
+type FilterParams struct {
+	Address bluetooth.MacAddress      `json:"address"`
+	Filter  bluetooth.DiscoveryFilter `json:"filter"`
+}
+
+// ProfileParams holds the parameters to (dis)connect a device profile.
+type ProfileParams struct {
+	Address bluetooth.MacAddress `json:"address"`
+	UUID    uuid.UUID            `json:"uuid"`
+}
+
+// FileParams holds the parameters to send a file to a device.
+type FileParams struct {
+	Address bluetooth.MacAddress `json:"address"`
+	Path    string               `json:"path"`
+}
+
+// NetworkParams holds the parameters to connect to a device's network.
+type NetworkParams struct {
+	Address bluetooth.MacAddress  `json:"address"`
+	Name    string                `json:"name"`
+	Type    bluetooth.NetworkType `json:"type"`
+}
+
+// AuthParams holds the parameters of an authorization request.
+type AuthParams struct {
+	Address  bluetooth.MacAddress        `json:"address,omitempty"`
+	PinCode  string                      `json:"pincode,omitempty"`
+	Passkey  uint32                      `json:"passkey,omitempty"`
+	Entered  uint16                      `json:"entered,omitempty"`
+	UUID     uuid.UUID                   `json:"uuid,omitempty"`
+	Path     string                      `json:"path,omitempty"`
+	Transfer *bluetooth.FileTransferData `json:"transfer,omitempty"`
+}
+
+// EventParams holds the parameters of an event notification.
+type EventParams struct {
+	ID     bluetooth.EventID     `json:"event_id"`
+	Action bluetooth.EventAction `json:"event_action"`
+	Data   json.RawMessage       `json:"event_data"`
+}
+
+// DecodeParams decodes the parameters of a request.
+func DecodeParams[T any](params json.RawMessage) (T, error) {
+	var p T
+
+	if err := json.Unmarshal(params, &p); err != nil {
+		return p, fmt.Errorf("%w: %w", ErrInvalidParams, err)
+	}
+
+	return p, nil
+}
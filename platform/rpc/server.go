@@ -0,0 +1,626 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	ac "github.com/bluetuith-org/api-native/api/appfeatures"
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/platform/rpc/internal/jsonrpc"
+)
+
+// Server describes a JSON-RPC server, which exposes a Bluetooth session.
+type Server struct {
+	session  bluetooth.Session
+	features ac.FeatureSet
+	policy   AccessPolicy
+
+	listener net.Listener
+	lock     *os.File
+	conns    map[*jsonrpc.Conn]Credentials
+	authConn *jsonrpc.Conn
+	scans    map[scanKey]context.CancelFunc
+	unsubs   []func()
+
+	socketGroup    uint32
+	hasSocketGroup bool
+
+	serving bool
+	closed  bool
+	mu      sync.Mutex
+}
+
+// scanKey identifies a scoped discovery session of a client.
+type scanKey struct {
+	conn *jsonrpc.Conn
+	id   uint64
+}
+
+// NewServer returns a new server, which exposes the provided session to the clients
+// allowed by the provided access policy. To forward authorization requests to the clients,
+// the session must be started with the server's authorizer (see Server.Authorizer()).
+func NewServer(session bluetooth.Session, policy AccessPolicy) *Server {
+	return &Server{
+		session: session,
+		policy:  policy,
+		conns:   make(map[*jsonrpc.Conn]Credentials),
+		scans:   make(map[scanKey]context.CancelFunc),
+	}
+}
+
+// Authorizer returns an authorization handler, which forwards authorization requests
+// to the client that registered itself as the authorizer. Only one client can be registered
+// as the authorizer at a time. If no such client is connected, all authorization requests
+// are rejected.
+func (s *Server) Authorizer() bluetooth.SessionAuthorizer {
+	return &authorizer{s: s}
+}
+
+// ListenAndServe listens on the Unix domain socket at the provided path, and serves
+// clients until the server is closed. The features are the features which were returned
+// when the session was started.
+// A lock file (the socket path with a ".lock" suffix) is held while the server is listening,
+// so that a stale socket file can be removed safely. The access permissions and the group
+// of the socket file are set according to the provided options.
+func (s *Server) ListenAndServe(socketPath string, features ac.FeatureSet, opts ListenOptions) error {
+	if opts.Mode == 0 {
+		opts.Mode = DefaultSocketMode
+	}
+
+	var (
+		gid    uint32
+		hasGID bool
+	)
+
+	if opts.Group != "" {
+		id, err := lookupGroup(opts.Group)
+		if err != nil {
+			return wrapError(err,
+				"rpc-server-listen-group", bluetooth.MacAddress{},
+				"Cannot find the group of the socket",
+			)
+		}
+
+		gid, hasGID = id, true
+	}
+
+	lock, err := lockSocket(socketPath)
+	if err != nil {
+		return wrapError(err,
+			"rpc-server-listen-lock", bluetooth.MacAddress{},
+			"Another server is listening on the socket",
+		)
+	}
+
+	listener, err := s.listen(socketPath, opts.Mode, gid, hasGID)
+	if err != nil {
+		lock.Close()
+		return err
+	}
+
+	s.mu.Lock()
+	s.lock = lock
+	s.socketGroup, s.hasSocketGroup = gid, hasGID
+	s.mu.Unlock()
+
+	return s.Serve(listener, features)
+}
+
+// listen removes the stale socket file at the path, listens on a new socket, and sets its
+// access permissions and group. The socket lock must be held while calling this function.
+func (s *Server) listen(socketPath string, mode os.FileMode, gid uint32, hasGID bool) (net.Listener, error) {
+	if err := os.Remove(socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, wrapError(err,
+			"rpc-server-listen-remove", bluetooth.MacAddress{},
+			"Cannot remove stale socket",
+		)
+	}
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, wrapError(err,
+			"rpc-server-listen", bluetooth.MacAddress{},
+			"Cannot listen on socket",
+		)
+	}
+
+	if hasGID {
+		if err := os.Chown(socketPath, -1, int(gid)); err != nil {
+			listener.Close()
+
+			return nil, wrapError(err,
+				"rpc-server-listen-chown", bluetooth.MacAddress{},
+				"Cannot set the group of the socket",
+			)
+		}
+	}
+
+	if err := os.Chmod(socketPath, mode); err != nil {
+		listener.Close()
+
+		return nil, wrapError(err,
+			"rpc-server-listen-chmod", bluetooth.MacAddress{},
+			"Cannot set the access permissions of the socket",
+		)
+	}
+
+	return listener, nil
+}
+
+// Serve accepts and serves clients on the provided listener, until the server is closed.
+// The credentials of each client are checked against the server's access policy when it
+// connects, and clients whose credentials cannot be read are rejected.
+func (s *Server) Serve(listener net.Listener, features ac.FeatureSet) error {
+	s.mu.Lock()
+	if s.serving || s.closed {
+		s.mu.Unlock()
+		listener.Close()
+
+		return wrapError(errors.New("server is already serving or closed"),
+			"rpc-server-serve", bluetooth.MacAddress{},
+			"Cannot serve clients",
+		)
+	}
+
+	s.serving = true
+	s.listener = listener
+	s.features = features.Clone()
+	s.mu.Unlock()
+
+	s.forwardEvents()
+
+	for {
+		c, err := listener.Accept()
+		if err != nil {
+			s.mu.Lock()
+			closed := s.closed
+			s.mu.Unlock()
+
+			if closed {
+				return nil
+			}
+
+			return wrapError(err,
+				"rpc-server-accept", bluetooth.MacAddress{},
+				"Cannot accept client connection",
+			)
+		}
+
+		creds, err := peerCredentials(c)
+		if err != nil || !s.allowClient(creds) {
+			c.Close()
+			continue
+		}
+
+		conn := jsonrpc.NewConn(c, s.handle)
+
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			conn.Close()
+
+			return nil
+		}
+		s.conns[conn] = creds
+		s.mu.Unlock()
+
+		go func() {
+			<-conn.Done()
+
+			s.mu.Lock()
+			delete(s.conns, conn)
+			if s.authConn == conn {
+				s.authConn = nil
+			}
+			s.mu.Unlock()
+		}()
+	}
+}
+
+// Close stops serving clients, and closes all client connections.
+// The exposed session is not stopped.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+
+	s.closed = true
+
+	listener, lock, conns, unsubs := s.listener, s.lock, s.conns, s.unsubs
+	s.conns, s.unsubs, s.authConn, s.lock = make(map[*jsonrpc.Conn]Credentials), nil, nil, nil
+	s.mu.Unlock()
+
+	if lock != nil {
+		defer lock.Close()
+	}
+
+	for _, unsub := range unsubs {
+		unsub()
+	}
+
+	for conn := range conns {
+		conn.Close()
+	}
+
+	if listener != nil {
+		return listener.Close()
+	}
+
+	return nil
+}
+
+// forwardEvents subscribes to all events of the session, and streams them to all clients.
+func (s *Server) forwardEvents() {
+	emitter := s.session.Events()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.unsubs = append(s.unsubs,
+		forward(s, bluetooth.ErrorEvent().On(emitter)),
+		forward(s, bluetooth.AdapterEvent().On(emitter)),
+		forward(s, bluetooth.DeviceEvent().On(emitter)),
+		forward(s, bluetooth.FileTransferEvent().On(emitter)),
+		forward(s, bluetooth.MediaEvent().On(emitter)),
+		forward(s, bluetooth.SessionEvent().On(emitter)),
+		forward(s, bluetooth.PruneEvent().On(emitter)),
+		forward(s, bluetooth.DefaultAdapterEvent().On(emitter)),
+		forward(s, bluetooth.ConnectAttemptEvent().On(emitter)),
+		forward(s, bluetooth.SetupEvent().On(emitter)),
+	)
+}
+
+// forward streams the events of the provided event type to all clients,
+// and returns a function to stop streaming the events. The events are queued
+// on each connection without blocking, so that a client which stops reading
+// is disconnected, instead of blocking the session's event publishing.
+func forward[T bluetooth.Events](s *Server, event bluetooth.Event[T]) func() {
+	sub := event.Subscribe()
+
+	go func() {
+		for ev := range sub.C {
+			if data, ok := any(ev.Data).(bluetooth.SessionEventData); ok {
+				s.mu.Lock()
+				s.features = data.Features.Clone()
+				s.mu.Unlock()
+			}
+
+			s.mu.Lock()
+			conns := make([]*jsonrpc.Conn, 0, len(s.conns))
+			for conn := range s.conns {
+				conns = append(conns, conn)
+			}
+			s.mu.Unlock()
+
+			for _, conn := range conns {
+				_ = conn.Notify(jsonrpc.MethodEvent, ev)
+			}
+		}
+	}()
+
+	return sub.Unsubscribe
+}
+
+// handle handles a request from a client.
+func (s *Server) handle(ctx context.Context, conn *jsonrpc.Conn, method string, params json.RawMessage) (any, error) {
+	switch method {
+	case jsonrpc.MethodSessionFeatures:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		return s.features, nil
+
+	case jsonrpc.MethodSessionAdapters:
+		return s.session.Adapters(), nil
+
+	case jsonrpc.MethodSessionDefaultAdapter:
+		return s.session.DefaultAdapter()
+
+	case jsonrpc.MethodSessionAuthorize:
+		return nil, s.authorize(conn)
+	}
+
+	if handler, ok := addressHandlers[method]; ok {
+		p, err := jsonrpc.DecodeParams[jsonrpc.AddressParams](params)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, s.session, p.Address)
+	}
+
+	switch method {
+	case jsonrpc.MethodAdapterSetPoweredState,
+		jsonrpc.MethodAdapterSetDiscoverableState,
+		jsonrpc.MethodAdapterSetPairableState:
+		p, err := jsonrpc.DecodeParams[jsonrpc.StateParams](params)
+		if err != nil {
+			return nil, err
+		}
+
+		adapter := s.session.Adapter(p.Address).WithContext(ctx)
+
+		switch method {
+		case jsonrpc.MethodAdapterSetPoweredState:
+			return nil, adapter.SetPoweredState(p.Enable)
+
+		case jsonrpc.MethodAdapterSetDiscoverableState:
+			return nil, adapter.SetDiscoverableState(p.Enable)
+		}
+
+		return nil, adapter.SetPairableState(p.Enable)
+
+	case jsonrpc.MethodAdapterPruneDevices:
+		p, err := jsonrpc.DecodeParams[jsonrpc.PruneParams](params)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.session.Adapter(p.Address).WithContext(ctx).PruneDevices(p.Options)
+
+	case jsonrpc.MethodAdapterConnectDevice:
+		p, err := jsonrpc.DecodeParams[jsonrpc.ConnectDeviceParams](params)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, s.session.Adapter(p.Address).WithContext(ctx).ConnectDevice(p.Device, p.AddressType)
+
+	case jsonrpc.MethodAdapterSetAlias:
+		p, err := jsonrpc.DecodeParams[jsonrpc.AliasParams](params)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, s.session.Adapter(p.Address).WithContext(ctx).SetAlias(p.Alias)
+
+	case jsonrpc.MethodAdapterSetDiscoverableTimeout,
+		jsonrpc.MethodAdapterSetPairableTimeout:
+		p, err := jsonrpc.DecodeParams[jsonrpc.TimeoutParams](params)
+		if err != nil {
+			return nil, err
+		}
+
+		adapter := s.session.Adapter(p.Address).WithContext(ctx)
+
+		if method == jsonrpc.MethodAdapterSetDiscoverableTimeout {
+			return nil, adapter.SetDiscoverableTimeout(p.Timeout)
+		}
+
+		return nil, adapter.SetPairableTimeout(p.Timeout)
+
+	case jsonrpc.MethodAdapterDiscover:
+		p, err := jsonrpc.DecodeParams[jsonrpc.DiscoverParams](params)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, s.discover(conn, p)
+
+	case jsonrpc.MethodAdapterStopDiscover:
+		p, err := jsonrpc.DecodeParams[jsonrpc.ScanParams](params)
+		if err != nil {
+			return nil, err
+		}
+
+		s.mu.Lock()
+		cancel, ok := s.scans[scanKey{conn, p.ID}]
+		s.mu.Unlock()
+
+		if ok {
+			cancel()
+		}
+
+		return nil, nil
+
+	case jsonrpc.MethodAdapterSetDiscoveryFilter:
+		p, err := jsonrpc.DecodeParams[jsonrpc.FilterParams](params)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, s.session.Adapter(p.Address).WithContext(ctx).SetDiscoveryFilter(p.Filter)
+
+	case jsonrpc.MethodDeviceSetTrusted,
+		jsonrpc.MethodDeviceSetBlocked,
+		jsonrpc.MethodDeviceSetWakeAllowed,
+		jsonrpc.MethodDeviceSetAutoReconnect:
+		p, err := jsonrpc.DecodeParams[jsonrpc.StateParams](params)
+		if err != nil {
+			return nil, err
+		}
+
+		device := s.session.Device(p.Address).WithContext(ctx)
+
+		switch method {
+		case jsonrpc.MethodDeviceSetTrusted:
+			return nil, device.SetTrusted(p.Enable)
+
+		case jsonrpc.MethodDeviceSetBlocked:
+			return nil, device.SetBlocked(p.Enable)
+
+		case jsonrpc.MethodDeviceSetAutoReconnect:
+			return nil, device.SetAutoReconnect(p.Enable)
+		}
+
+		return nil, device.SetWakeAllowed(p.Enable)
+
+	case jsonrpc.MethodDeviceConnectWithRetry:
+		p, err := jsonrpc.DecodeParams[jsonrpc.ConnectRetryParams](params)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, s.session.Device(p.Address).WithContext(ctx).ConnectWithRetry(p.Policy)
+
+	case jsonrpc.MethodDeviceSetup:
+		p, err := jsonrpc.DecodeParams[jsonrpc.SetupParams](params)
+		if err != nil {
+			return nil, err
+		}
+
+		// If a stage of the setup fails, the result (which holds the stage and the
+		// error message) is returned instead, so that the client can inspect it.
+		result, err := s.session.Device(p.Address).Setup(ctx, p.Options)
+		if err != nil && !result.Failed() {
+			return nil, err
+		}
+
+		return result, nil
+
+	case jsonrpc.MethodDeviceSetAlias:
+		p, err := jsonrpc.DecodeParams[jsonrpc.AliasParams](params)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, s.session.Device(p.Address).WithContext(ctx).SetAlias(p.Alias)
+
+	case jsonrpc.MethodDeviceConnectProfile, jsonrpc.MethodDeviceDisconnectProfile:
+		p, err := jsonrpc.DecodeParams[jsonrpc.ProfileParams](params)
+		if err != nil {
+			return nil, err
+		}
+
+		device := s.session.Device(p.Address).WithContext(ctx)
+		if method == jsonrpc.MethodDeviceConnectProfile {
+			return nil, device.ConnectProfile(p.UUID)
+		}
+
+		return nil, device.DisconnectProfile(p.UUID)
+
+	case jsonrpc.MethodObexSendFile:
+		p, err := jsonrpc.DecodeParams[jsonrpc.FileParams](params)
+		if err != nil {
+			return nil, err
+		}
+
+		s.mu.Lock()
+		creds := s.conns[conn]
+		s.mu.Unlock()
+
+		path, err := creds.checkedPath(p.Path)
+		if err != nil {
+			return nil, wrapError(fmt.Errorf("%w: %w", errorkinds.ErrPermissionDenied, err),
+				"rpc-server-sendfile", p.Address,
+				"The client cannot read the file",
+			)
+		}
+
+		return s.session.Obex(p.Address).FileTransfer().SendFile(path)
+
+	case jsonrpc.MethodNetworkConnect:
+		p, err := jsonrpc.DecodeParams[jsonrpc.NetworkParams](params)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, s.session.Network(p.Address).WithContext(ctx).Connect(p.Name, p.Type)
+	}
+
+	return nil, jsonrpc.ErrMethodNotFound
+}
+
+// authorize registers the client as the authorizer, if it is allowed to, and if no other
+// client is registered as the authorizer.
+func (s *Server) authorize(conn *jsonrpc.Conn) error {
+	s.mu.Lock()
+	creds := s.conns[conn]
+	s.mu.Unlock()
+
+	if !s.allowAuthorizer(creds) {
+		return wrapError(errorkinds.ErrPermissionDenied,
+			"rpc-server-authorize", bluetooth.MacAddress{},
+			"The client is not allowed to register as the authorizer",
+		)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.authConn != nil && s.authConn != conn {
+		return wrapError(errorkinds.ErrMethodCall,
+			"rpc-server-authorize", bluetooth.MacAddress{},
+			"Another client is registered as the authorizer",
+		)
+	}
+
+	s.authConn = conn
+
+	return nil
+}
+
+// discover starts a scoped discovery session for a client, and streams the found devices
+// to the client until the discovery session ends, or the client disconnects.
+func (s *Server) discover(conn *jsonrpc.Conn, p jsonrpc.DiscoverParams) error {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	events, err := s.session.Adapter(p.Address).Discover(ctx, p.Options)
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	key := scanKey{conn, p.ID}
+
+	s.mu.Lock()
+	s.scans[key] = cancel
+	s.mu.Unlock()
+
+	go func() {
+		select {
+		case <-conn.Done():
+			cancel()
+
+		case <-ctx.Done():
+		}
+	}()
+
+	go func() {
+		defer cancel()
+
+		for ev := range events {
+			_ = conn.Notify(jsonrpc.MethodDiscovery, jsonrpc.DiscoveryParams{ID: p.ID, Event: &ev})
+		}
+
+		s.mu.Lock()
+		delete(s.scans, key)
+		s.mu.Unlock()
+
+		_ = conn.Notify(jsonrpc.MethodDiscovery, jsonrpc.DiscoveryParams{ID: p.ID, Done: true})
+	}()
+
+	return nil
+}
+
+// wrapError wraps an error with the call site and address metadata.
+func wrapError(err error, errorAt string, address bluetooth.MacAddress, message string) error {
+	return fault.Wrap(err,
+		fctx.With(context.Background(),
+			"error_at", errorAt,
+			"address", address.String(),
+		),
+		ftag.With(ftag.Internal),
+		fmsg.With(message),
+	)
+}
+
+// noAuthorizer returns an error which indicates that no client is registered as the authorizer.
+func noAuthorizer() error {
+	return wrapError(errorkinds.ErrMethodCall,
+		"rpc-server-authorize", bluetooth.MacAddress{},
+		"No client is registered as the authorizer",
+	)
+}
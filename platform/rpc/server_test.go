@@ -0,0 +1,251 @@
+//go:build linux
+
+package rpc
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/config"
+	"github.com/bluetuith-org/api-native/api/eventbus"
+	"github.com/bluetuith-org/api-native/platform/rpc/client"
+	"github.com/bluetuith-org/api-native/platform/simulated"
+)
+
+// eventTimeout is the maximum duration to wait for an event.
+const eventTimeout = 5 * time.Second
+
+// startTestServer starts a simulated session, and a server which exposes it on a
+// temporary Unix domain socket with the provided access policy. The server and the
+// session are stopped when the test ends.
+func startTestServer(t *testing.T, policy AccessPolicy) (*Server, *simulated.Session, string) {
+	t.Helper()
+
+	cfg := simulated.DefaultConfig()
+	cfg.OperationDelay = 10 * time.Millisecond
+
+	sessionCfg := config.New()
+	sessionCfg.EventEmitter = eventbus.NewEmitter(nil)
+
+	session := simulated.NewSession(cfg)
+	server := NewServer(session, policy)
+
+	features, err := session.Start(server.Authorizer(), sessionCfg)
+	if err != nil {
+		t.Fatalf("Cannot start session: %v", err)
+	}
+
+	socketPath := filepath.Join(t.TempDir(), "rpc.sock")
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Fatalf("Cannot listen on socket: %v", err)
+	}
+
+	served := make(chan error, 1)
+	go func() { served <- server.Serve(listener, features) }()
+
+	t.Cleanup(func() {
+		if err := server.Close(); err != nil {
+			t.Errorf("Close() returned error: %v", err)
+		}
+
+		if err := <-served; err != nil {
+			t.Errorf("Serve() returned error: %v", err)
+		}
+
+		_ = session.Stop()
+	})
+
+	return server, session, socketPath
+}
+
+// startTestClient starts a client session which is connected to the server
+// at the provided socket path. The session is stopped when the test ends.
+func startTestClient(t *testing.T, socketPath string, authHandler bluetooth.SessionAuthorizer) *client.Session {
+	t.Helper()
+
+	cfg := config.New()
+	cfg.EventEmitter = eventbus.NewEmitter(nil)
+
+	session := client.NewSession(socketPath)
+	if _, err := session.Start(authHandler, cfg); err != nil {
+		t.Fatalf("Cannot start client session: %v", err)
+	}
+	t.Cleanup(func() { _ = session.Stop() })
+
+	return session
+}
+
+// connCount returns the number of clients which are connected to the server.
+func connCount(s *Server) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.conns)
+}
+
+func TestCall(t *testing.T) {
+	_, session, socketPath := startTestServer(t, AccessPolicy{})
+	remote := startTestClient(t, socketPath, nil)
+
+	want := session.Adapters()
+	got := remote.Adapters()
+
+	if len(got) != len(want) {
+		t.Fatalf("Adapters() returned %d adapters, want %d", len(got), len(want))
+	}
+
+	for i := range want {
+		if got[i].Address != want[i].Address || got[i].Name != want[i].Name || got[i].Powered != want[i].Powered {
+			t.Errorf("Adapters()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	headphones := simulated.DefaultConfig().Adapters[0].Devices[0].Address
+
+	properties, err := remote.Device(headphones).Properties()
+	if err != nil {
+		t.Fatalf("Properties() returned error: %v", err)
+	}
+
+	wantProperties, err := session.Device(headphones).Properties()
+	if err != nil {
+		t.Fatalf("Properties() of the served session returned error: %v", err)
+	}
+
+	if properties.Address != wantProperties.Address || properties.Name != wantProperties.Name ||
+		properties.Paired != wantProperties.Paired {
+		t.Errorf("Properties() = %+v, want %+v", properties.DeviceEventData, wantProperties.DeviceEventData)
+	}
+
+	unknown, _ := bluetooth.ParseMAC("AA:BB:CC:DD:EE:FF")
+	if _, err := remote.Device(unknown).Properties(); err == nil {
+		t.Error("Properties() of an unknown device returned no error")
+	}
+}
+
+func TestEventForwarding(t *testing.T) {
+	_, _, socketPath := startTestServer(t, AccessPolicy{})
+	remote := startTestClient(t, socketPath, nil)
+
+	sub := bluetooth.DeviceEvent(bluetooth.EventActionUpdated).On(remote.Events()).Subscribe()
+	defer sub.Unsubscribe()
+
+	headphones := simulated.DefaultConfig().Adapters[0].Devices[0].Address
+	if err := remote.Device(headphones).Connect(); err != nil {
+		t.Fatalf("Connect() returned error: %v", err)
+	}
+
+	timeout := time.After(eventTimeout)
+
+	for {
+		select {
+		case ev, ok := <-sub.C:
+			if !ok {
+				t.Fatal("Event subscription was closed")
+			}
+
+			if ev.Data.Address == headphones && ev.Data.Connected {
+				return
+			}
+
+		case <-timeout:
+			t.Fatal("Timed out waiting for the forwarded device event")
+		}
+	}
+}
+
+func TestSlowClientDropped(t *testing.T) {
+	server, session, socketPath := startTestServer(t, AccessPolicy{})
+
+	// The client connects, but never reads the events which are sent to it.
+	c, err := net.Dial("unix", socketPath)
+	if err != nil {
+		t.Fatalf("Cannot connect to the server: %v", err)
+	}
+	defer c.Close()
+
+	deadline := time.Now().Add(eventTimeout)
+	for connCount(server) != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for the client to be accepted")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	event := bluetooth.DeviceEvent(bluetooth.EventActionUpdated).On(session.Events())
+	data := bluetooth.DeviceEventData{Alias: strings.Repeat("a", 4096)}
+
+	deadline = time.Now().Add(eventTimeout)
+	for connCount(server) != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for the slow client to be disconnected")
+		}
+
+		event.Publish(data)
+		time.Sleep(10 * time.Microsecond)
+	}
+}
+
+func TestRejectedCredentials(t *testing.T) {
+	var (
+		rejected []Credentials
+		mu       sync.Mutex
+	)
+
+	policy := AccessPolicy{
+		AllowClient: func(c Credentials) bool {
+			mu.Lock()
+			defer mu.Unlock()
+
+			rejected = append(rejected, c)
+
+			return false
+		},
+	}
+
+	_, _, socketPath := startTestServer(t, policy)
+
+	session := client.NewSession(socketPath)
+	if _, err := session.Start(nil, config.New()); err == nil {
+		_ = session.Stop()
+		t.Fatal("Start() of a rejected client returned no error")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(rejected) != 1 {
+		t.Fatalf("AllowClient was called %d times, want 1", len(rejected))
+	}
+
+	want := Credentials{PID: int32(os.Getpid()), UID: uint32(os.Getuid()), GID: uint32(os.Getgid())}
+	if rejected[0] != want {
+		t.Errorf("AllowClient was called with %+v, want %+v", rejected[0], want)
+	}
+}
+
+func TestRejectedAuthorizer(t *testing.T) {
+	policy := AccessPolicy{
+		AllowAuthorizer: func(Credentials) bool { return false },
+	}
+
+	_, _, socketPath := startTestServer(t, policy)
+
+	session := client.NewSession(socketPath)
+	if _, err := session.Start(bluetooth.DefaultAuthorizer{}, config.New()); err == nil {
+		_ = session.Stop()
+		t.Fatal("Start() of a client which is not allowed to be the authorizer returned no error")
+	}
+
+	// The client can still use the session, without registering as the authorizer.
+	startTestClient(t, socketPath, nil)
+}
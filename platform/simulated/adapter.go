@@ -0,0 +1,666 @@
+package simulated
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/api/helpers/discovery"
+)
+
+// adapter describes a function call interface to invoke adapter related functions.
+type adapter struct {
+	s   *Session
+	ctx context.Context
+
+	Address bluetooth.MacAddress
+}
+
+// WithContext returns a copy of the adapter function call interface, whose
+// method calls are bound to the provided context.
+func (a *adapter) WithContext(ctx context.Context) bluetooth.Adapter {
+	copied := *a
+	copied.ctx = ctx
+
+	return &copied
+}
+
+// StartDiscovery will put the adapter into "discovering" mode, and the
+// configured discoverable devices will be found over time.
+func (a *adapter) StartDiscovery() error {
+	if err := a.checkPowered("adapter-start-discovery"); err != nil {
+		return err
+	}
+
+	return a.s.scans.Hold(a.Address, a.startDiscovery)
+}
+
+// StopDiscovery will stop the "discovering" mode. If scoped discovery sessions
+// are active, the adapter keeps discovering until they have ended.
+func (a *adapter) StopDiscovery() error {
+	if _, err := a.check(); err != nil {
+		return err
+	}
+
+	return a.s.scans.Unhold(a.Address, a.stopDiscovery)
+}
+
+// Discover starts a scoped discovery session, and returns a channel which receives
+// the devices that are found or updated during the discovery.
+func (a *adapter) Discover(ctx context.Context, opts bluetooth.DiscoveryOptions) (<-chan bluetooth.DiscoveryEvent, error) {
+	if err := a.checkPowered("adapter-discover"); err != nil {
+		return nil, err
+	}
+
+	if opts.Filter != nil {
+		if err := a.SetDiscoveryFilter(*opts.Filter); err != nil {
+			return nil, err
+		}
+	}
+
+	var cancel context.CancelFunc
+	if opts.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	events := discovery.Watch(ctx, a.s.emitter, a.Address, a.s.store.Device, a.s.store.DeviceLastSeen)
+
+	if err := a.s.scans.Acquire(a.Address, a.startDiscovery); err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	context.AfterFunc(ctx, func() {
+		defer cancel()
+
+		_ = a.s.scans.Release(a.Address, a.stopDiscovery)
+	})
+
+	return events, nil
+}
+
+// SetDiscoveryFilter sets the filter which is applied to the devices that are found
+// during discovery. An empty filter clears the existing filter.
+func (a *adapter) SetDiscoveryFilter(filter bluetooth.DiscoveryFilter) error {
+	if _, err := a.check(); err != nil {
+		return err
+	}
+
+	switch {
+	case filter.RSSI != nil && filter.Pathloss != nil:
+		return wrapError(errors.New("RSSI and Pathloss cannot be set together"),
+			"adapter-set-discovery-filter", a.Address,
+			"An error occurred while setting the discovery filter",
+		)
+
+	case filter.Transport != "" && !slices.Contains(
+		[]bluetooth.DiscoveryTransport{
+			bluetooth.DiscoveryTransportAuto,
+			bluetooth.DiscoveryTransportBREDR,
+			bluetooth.DiscoveryTransportLE,
+		}, filter.Transport):
+		return wrapError(errors.New("invalid transport "+string(filter.Transport)),
+			"adapter-set-discovery-filter", a.Address,
+			"An error occurred while setting the discovery filter",
+		)
+	}
+
+	a.s.mu.Lock()
+	a.s.filters[a.Address] = filter
+	a.s.mu.Unlock()
+
+	return nil
+}
+
+// DiscoveryFilters returns the keys of the discovery filter parameters
+// that are supported by the simulated session. The "Pathloss", "DuplicateData"
+// and "Discoverable" parameters are accepted, but are not applied to the filter.
+func (a *adapter) DiscoveryFilters() ([]string, error) {
+	if _, err := a.check(); err != nil {
+		return nil, err
+	}
+
+	return []string{
+		"UUIDs", "RSSI", "Pathloss", "Transport",
+		"DuplicateData", "Discoverable", "Pattern",
+	}, nil
+}
+
+// SetPoweredState sets the powered state of the adapter.
+func (a *adapter) SetPoweredState(enable bool) error {
+	adapter, err := a.check()
+	if err != nil {
+		return err
+	}
+
+	if enable {
+		if err := checkBlocked(adapter, "adapter-setpowered-state"); err != nil {
+			return err
+		}
+	}
+
+	if !enable {
+		a.s.scans.Reset(a.Address)
+		_ = a.stopDiscovery()
+		a.setTimeout("Discoverable", 0, nil)
+	}
+
+	a.setProperty(func(adapter *bluetooth.AdapterData) {
+		adapter.Powered = enable
+		if !enable {
+			adapter.Discoverable = false
+		}
+	})
+	a.s.refreshDefaultAdapter()
+
+	return nil
+}
+
+// UnblockAndPowerOn removes the soft-block on the adapter if it is soft-blocked,
+// and then powers on the adapter.
+func (a *adapter) UnblockAndPowerOn() error {
+	adapter, err := a.check()
+	if err != nil {
+		return err
+	}
+
+	if adapter.HardBlocked {
+		return checkBlocked(adapter, "adapter-unblock")
+	}
+
+	a.setProperty(func(adapter *bluetooth.AdapterData) {
+		adapter.SoftBlocked = false
+		adapter.Powered = true
+	})
+	a.s.refreshDefaultAdapter()
+
+	return nil
+}
+
+// SetDiscoverableState sets the discoverable state of the adapter.
+func (a *adapter) SetDiscoverableState(enable bool) error {
+	if err := a.checkPowered("adapter-setdiscoverable-state"); err != nil {
+		return err
+	}
+
+	updated := a.setProperty(func(adapter *bluetooth.AdapterData) {
+		adapter.Discoverable = enable
+	})
+
+	timeout := updated.DiscoverableTimeout
+	if !enable {
+		timeout = 0
+	}
+
+	a.setTimeout("Discoverable", timeout, func(adapter *bluetooth.AdapterData) {
+		adapter.Discoverable = false
+	})
+
+	return nil
+}
+
+// SetPairableState sets the pairable state of the adapter.
+func (a *adapter) SetPairableState(enable bool) error {
+	if _, err := a.check(); err != nil {
+		return err
+	}
+
+	updated := a.setProperty(func(adapter *bluetooth.AdapterData) {
+		adapter.Pairable = enable
+	})
+
+	timeout := updated.PairableTimeout
+	if !enable {
+		timeout = 0
+	}
+
+	a.setTimeout("Pairable", timeout, func(adapter *bluetooth.AdapterData) {
+		adapter.Pairable = false
+	})
+
+	return nil
+}
+
+// SetAlias sets the user-assigned name of the adapter. If an empty alias
+// is provided, the alias is reset to the name of the adapter.
+func (a *adapter) SetAlias(alias string) error {
+	if _, err := a.check(); err != nil {
+		return err
+	}
+
+	a.setProperty(func(adapter *bluetooth.AdapterData) {
+		adapter.Alias = alias
+		if alias == "" {
+			adapter.Alias = adapter.Name
+		}
+	})
+
+	return nil
+}
+
+// SetDiscoverableTimeout sets the timeout (in seconds) after which the adapter
+// is no longer discoverable. If the adapter is discoverable, the timeout is restarted.
+func (a *adapter) SetDiscoverableTimeout(timeout uint32) error {
+	if _, err := a.check(); err != nil {
+		return err
+	}
+
+	updated := a.setProperty(func(adapter *bluetooth.AdapterData) {
+		adapter.DiscoverableTimeout = timeout
+	})
+
+	if updated.Discoverable {
+		a.setTimeout("Discoverable", timeout, func(adapter *bluetooth.AdapterData) {
+			adapter.Discoverable = false
+		})
+	}
+
+	return nil
+}
+
+// SetPairableTimeout sets the timeout (in seconds) after which the adapter
+// is no longer pairable. If the adapter is pairable, the timeout is restarted.
+func (a *adapter) SetPairableTimeout(timeout uint32) error {
+	if _, err := a.check(); err != nil {
+		return err
+	}
+
+	updated := a.setProperty(func(adapter *bluetooth.AdapterData) {
+		adapter.PairableTimeout = timeout
+	})
+
+	if updated.Pairable {
+		a.setTimeout("Pairable", timeout, func(adapter *bluetooth.AdapterData) {
+			adapter.Pairable = false
+		})
+	}
+
+	return nil
+}
+
+// Properties returns all the properties of the adapter.
+func (a *adapter) Properties() (bluetooth.AdapterData, error) {
+	return a.check()
+}
+
+// Devices returns all the devices associated with the adapter.
+func (a *adapter) Devices() ([]bluetooth.DeviceData, error) {
+	if _, err := a.check(); err != nil {
+		return nil, err
+	}
+
+	devices, err := a.s.store.AdapterDevices(a.Address)
+	if err != nil {
+		return nil, wrapError(err,
+			"adapter-fetch-devices", a.Address,
+			"Error while fetching adapter devices",
+		)
+	}
+
+	return devices, nil
+}
+
+// ConnectDevice connects to a device with the provided address, without discovering
+// the device first. The device must be in range of the adapter, that is, it must be
+// either a known or a discoverable device of the adapter.
+func (a *adapter) ConnectDevice(address bluetooth.MacAddress, _ bluetooth.AddressType) error {
+	if err := a.checkPowered("adapter-connect-device"); err != nil {
+		return err
+	}
+
+	a.s.mu.Lock()
+	deviceConfig, ok := a.s.devices[address]
+	a.s.mu.Unlock()
+
+	if !ok || deviceConfig.AssociatedAdapter != a.Address {
+		return wrapError(errorkinds.ErrDeviceNotFound,
+			"adapter-connect-device", a.Address,
+			"Device is not in range of the adapter",
+		)
+	}
+
+	d := &device{s: a.s, ctx: a.ctx, Address: address}
+	if _, err := a.s.store.Device(address); err == nil {
+		return d.Connect()
+	}
+
+	device := newDeviceData(deviceConfig)
+	a.s.store.AddDevice(device)
+
+	bluetooth.DeviceEvent(bluetooth.EventActionAdded).On(a.s.emitter).Publish(device.DeviceEventData)
+
+	return d.connect()
+}
+
+// PruneDevices removes the stale devices of the adapter, which are devices that are
+// not paired, trusted or connected, and which have not been seen for the provided duration.
+func (a *adapter) PruneDevices(opts bluetooth.PruneOptions) (bluetooth.PruneResult, error) {
+	result := bluetooth.PruneResult{Address: a.Address, DryRun: opts.DryRun}
+
+	if _, err := a.check(); err != nil {
+		return result, err
+	}
+
+	devices, err := a.s.store.StaleDevices(a.Address, opts.OlderThan)
+	if err != nil {
+		return result, wrapError(err,
+			"adapter-prune-devices", a.Address,
+			"Error while fetching stale adapter devices",
+		)
+	}
+
+	result.Devices = devices
+	if opts.DryRun {
+		return result, nil
+	}
+
+	for _, device := range devices {
+		a.s.store.RemoveDevice(device.Address)
+
+		bluetooth.DeviceEvent(bluetooth.EventActionRemoved).On(a.s.emitter).Publish(bluetooth.DeviceEventData{
+			Address:           device.Address,
+			AssociatedAdapter: device.AssociatedAdapter,
+		})
+	}
+
+	bluetooth.PruneEvent().On(a.s.emitter).Publish(result)
+
+	return result, nil
+}
+
+// discover adds the discoverable devices of the adapter to the session
+// at each discovery interval, and updates the signal strength of the
+// found devices until the discovery is stopped.
+func (a *adapter) discover(ctx context.Context) {
+	var pending []DeviceConfig
+
+	a.s.mu.Lock()
+	filter := a.s.filters[a.Address]
+
+	for _, adapterConfig := range a.s.cfg.Adapters {
+		if adapterConfig.Address != a.Address {
+			continue
+		}
+
+		for _, deviceConfig := range adapterConfig.Discoverable {
+			if _, err := a.s.store.Device(deviceConfig.Address); err != nil &&
+				matchesFilter(filter, a.s.devices[deviceConfig.Address]) {
+				pending = append(pending, a.s.devices[deviceConfig.Address])
+			}
+		}
+	}
+	a.s.mu.Unlock()
+
+	interval := a.s.cfg.DiscoveryInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	found := make([]bluetooth.MacAddress, 0, len(pending))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+		}
+
+		if len(pending) > 0 {
+			device := newDeviceData(pending[0])
+			pending = pending[1:]
+
+			a.s.store.AddDevice(device)
+			a.s.store.MarkDeviceSeen(device.Address)
+			found = append(found, device.Address)
+
+			bluetooth.DeviceEvent(bluetooth.EventActionAdded).On(a.s.emitter).Publish(device.DeviceEventData)
+
+			continue
+		}
+
+		for _, address := range found {
+			updated, err := a.s.store.UpdateDevice(address, func(device *bluetooth.DeviceData) error {
+				device.RSSI += int16(rand.Intn(7) - 3)
+
+				return nil
+			})
+			if err != nil {
+				continue
+			}
+
+			bluetooth.DeviceEvent(bluetooth.EventActionUpdated).On(a.s.emitter).Publish(updated)
+		}
+	}
+}
+
+// matchesFilter returns whether a discoverable device matches the discovery filter.
+func matchesFilter(filter bluetooth.DiscoveryFilter, deviceConfig DeviceConfig) bool {
+	transport := deviceConfig.Transport
+	if transport == "" {
+		transport = bluetooth.DiscoveryTransportLE
+		if deviceConfig.Class != 0 {
+			transport = bluetooth.DiscoveryTransportBREDR
+		}
+	}
+
+	switch {
+	case filter.Transport != "" && filter.Transport != bluetooth.DiscoveryTransportAuto &&
+		transport != bluetooth.DiscoveryTransportAuto && filter.Transport != transport:
+		return false
+
+	case filter.RSSI != nil && deviceConfig.RSSI < *filter.RSSI:
+		return false
+
+	case filter.Pattern != "" &&
+		!strings.HasPrefix(deviceConfig.Address.String(), strings.ToUpper(filter.Pattern)) &&
+		!strings.HasPrefix(deviceConfig.Name, filter.Pattern):
+		return false
+	}
+
+	if len(filter.UUIDs) == 0 {
+		return true
+	}
+
+	for _, id := range filter.UUIDs {
+		if slices.ContainsFunc(deviceConfig.UUIDs, func(deviceUUID string) bool {
+			return strings.EqualFold(deviceUUID, id.String())
+		}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// startDiscovery starts discovering the discoverable devices of the adapter.
+func (a *adapter) startDiscovery() error {
+	a.s.mu.Lock()
+	if _, ok := a.s.discovery[a.Address]; ok {
+		a.s.mu.Unlock()
+
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(a.s.ctx)
+	a.s.discovery[a.Address] = cancel
+	a.s.mu.Unlock()
+
+	a.setProperty(func(adapter *bluetooth.AdapterData) {
+		adapter.Discovering = true
+	})
+
+	go a.discover(ctx)
+
+	return nil
+}
+
+// stopDiscovery stops an ongoing discovery.
+func (a *adapter) stopDiscovery() error {
+	a.s.mu.Lock()
+	cancel, ok := a.s.discovery[a.Address]
+	delete(a.s.discovery, a.Address)
+	a.s.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	cancel()
+
+	a.setProperty(func(adapter *bluetooth.AdapterData) {
+		adapter.Discovering = false
+	})
+
+	return nil
+}
+
+// setProperty updates the adapter properties in the store, publishes an adapter event,
+// and returns the updated adapter properties.
+func (a *adapter) setProperty(setfn func(adapter *bluetooth.AdapterData)) bluetooth.AdapterEventData {
+	updated, err := a.s.store.UpdateAdapter(a.Address, func(adapter *bluetooth.AdapterData) error {
+		setfn(adapter)
+
+		return nil
+	})
+	if err != nil {
+		return updated
+	}
+
+	bluetooth.AdapterEvent(bluetooth.EventActionUpdated).On(a.s.emitter).Publish(updated)
+
+	return updated
+}
+
+// adapterTimeout identifies a running discoverable or pairable timeout of an adapter.
+type adapterTimeout struct {
+	address  bluetooth.MacAddress
+	property string
+}
+
+// setTimeout stops the running timeout of the provided adapter property, and if
+// the timeout (in seconds) is non-zero, starts a new timeout after which the adapter
+// properties are updated using expirefn.
+func (a *adapter) setTimeout(property string, timeout uint32, expirefn func(adapter *bluetooth.AdapterData)) {
+	key := adapterTimeout{address: a.Address, property: property}
+
+	a.s.mu.Lock()
+	defer a.s.mu.Unlock()
+
+	if cancel, ok := a.s.timeouts[key]; ok {
+		cancel()
+		delete(a.s.timeouts, key)
+	}
+
+	if timeout == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(a.s.ctx)
+	a.s.timeouts[key] = cancel
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-time.After(time.Duration(timeout) * time.Second):
+		}
+
+		a.s.mu.Lock()
+		if ctx.Err() != nil {
+			a.s.mu.Unlock()
+
+			return
+		}
+
+		delete(a.s.timeouts, key)
+		a.s.mu.Unlock()
+
+		cancel()
+		a.setProperty(expirefn)
+	}()
+}
+
+// checkPowered checks whether the adapter exists and is powered on.
+func (a *adapter) checkPowered(errorAt string) error {
+	adapter, err := a.check()
+	if err != nil {
+		return err
+	}
+
+	if !adapter.Powered {
+		return wrapError(errorkinds.ErrMethodCall,
+			errorAt, a.Address,
+			"Adapter is not powered on",
+		)
+	}
+
+	return nil
+}
+
+// checkBlocked returns an error if the adapter is soft-blocked or hard-blocked.
+func checkBlocked(adapter bluetooth.AdapterData, errorAt string) error {
+	switch {
+	case adapter.HardBlocked:
+		return wrapError(errorkinds.ErrAdapterHardBlocked,
+			errorAt, adapter.Address,
+			"Adapter is blocked by a hardware switch (for example, an airplane mode switch)",
+		)
+
+	case adapter.SoftBlocked:
+		return wrapError(errorkinds.ErrAdapterSoftBlocked,
+			errorAt, adapter.Address,
+			"Adapter is blocked via rfkill (for example, by airplane mode)",
+		)
+	}
+
+	return nil
+}
+
+// check checks whether the session is started, and the adapter exists.
+func (a *adapter) check() (bluetooth.AdapterData, error) {
+	if a.s == nil || !a.s.isStarted() {
+		return bluetooth.AdapterData{}, wrapError(errorkinds.ErrAdapterNotFound,
+			"adapter-check-session", a.Address,
+			"Error while fetching adapter data",
+		)
+	}
+
+	if err := a.callContext().Err(); err != nil {
+		return bluetooth.AdapterData{}, wrapError(errorkinds.ErrMethodCanceled,
+			"adapter-check-context", a.Address,
+			"Method call was cancelled",
+		)
+	}
+
+	adapter, err := a.s.store.Adapter(a.Address)
+	if err != nil {
+		return adapter, wrapError(err,
+			"adapter-check-store", a.Address,
+			"Adapter does not exist",
+		)
+	}
+
+	return adapter, nil
+}
+
+// callContext returns the context which the adapter's method calls are bound to.
+func (a *adapter) callContext() context.Context {
+	if a.ctx == nil {
+		return context.Background()
+	}
+
+	return a.ctx
+}
@@ -0,0 +1,225 @@
+package simulated
+
+import (
+	"time"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/google/uuid"
+)
+
+// PairingMethod describes how a simulated device authenticates a pairing request.
+type PairingMethod int
+
+// The different pairing methods.
+const (
+	PairingConfirmPasskey PairingMethod = iota // The zero value for this type.
+	PairingDisplayPasskey
+	PairingDisplayPinCode
+	PairingAuthorize
+	PairingJustWorks
+)
+
+// Config describes the configuration of a simulated session.
+type Config struct {
+	// Adapters holds the virtual adapters.
+	Adapters []AdapterConfig
+
+	// DiscoveryInterval holds the interval between each device that is
+	// found while an adapter is discovering.
+	DiscoveryInterval time.Duration
+
+	// OperationDelay holds the time taken to complete operations like
+	// pairing, connecting and creating file transfer sessions.
+	OperationDelay time.Duration
+
+	// TransferRate holds the number of bytes that are transferred per second
+	// during a file transfer.
+	TransferRate uint64
+}
+
+// AdapterConfig describes the configuration of a virtual adapter.
+type AdapterConfig struct {
+	bluetooth.AdapterData
+
+	// Devices holds the devices which are already known to the adapter,
+	// for example, previously paired devices.
+	Devices []DeviceConfig
+
+	// Discoverable holds the devices that will be found by the adapter
+	// while it is discovering.
+	Discoverable []DeviceConfig
+}
+
+// DeviceConfig describes the configuration of a virtual device.
+type DeviceConfig struct {
+	bluetooth.DeviceData
+
+	// Pairing holds the method used to authenticate pairing requests.
+	Pairing PairingMethod
+
+	// Passkey holds the passkey or pincode used during pairing.
+	Passkey uint32
+
+	// Transport holds the transport type over which the device can be discovered.
+	// If it is not set, devices which have a device class are discovered over BR/EDR,
+	// and other devices are discovered over LE.
+	Transport bluetooth.DiscoveryTransport
+
+	// ConnectFailures holds the number of connection attempts to the device which fail
+	// with a page timeout, before the device can be connected to. This can be used to
+	// simulate devices which are out of range, and to test connection retries.
+	ConnectFailures int
+
+	// Media holds the initial media player data, if the device
+	// provides a media player. A nil value indicates that the device
+	// does not have a media player.
+	Media *bluetooth.MediaData
+}
+
+// DefaultConfig returns a configuration with a single powered adapter,
+// a paired headset, and a few devices which can be discovered.
+func DefaultConfig() Config {
+	uuids := func(ids ...uint32) []string {
+		s := make([]string, 0, len(ids))
+		for _, id := range ids {
+			s = append(s, serviceUUID(id).String())
+		}
+
+		return s
+	}
+
+	return Config{
+		DiscoveryInterval: 2 * time.Second,
+		OperationDelay:    500 * time.Millisecond,
+		TransferRate:      256 * 1024,
+		Adapters: []AdapterConfig{
+			{
+				AdapterData: bluetooth.AdapterData{
+					Name:         "simulated",
+					UniqueName:   "hci0",
+					Modalias:     bluetooth.Modalias{Source: "usb", Vendor: 0x1d6b, Product: 0x0246, Version: 0x0540},
+					Roles:        []string{"central", "peripheral"},
+					Manufacturer: 0x0002,
+					Version:      0x0c,
+					AdapterEventData: bluetooth.AdapterEventData{
+						Address:             mustParseMAC("00:1A:7D:DA:71:01"),
+						Alias:               "simulated",
+						Class:               0x0c010c,
+						Powered:             true,
+						Pairable:            true,
+						DiscoverableTimeout: 180,
+					},
+				},
+				Devices: []DeviceConfig{
+					{
+						DeviceData: bluetooth.DeviceData{
+							Name:  "Simulated Headphones",
+							Class: 0x240418,
+							DeviceEventData: bluetooth.DeviceEventData{
+								Address:    mustParseMAC("2C:41:A1:49:37:CF"),
+								Alias:      "Simulated Headphones",
+								Icon:       "audio-headphones",
+								Modalias:   bluetooth.Modalias{Source: bluetooth.ModaliasSourceBluetooth, Vendor: 0x009e, Product: 0x4020, Version: 0x0251},
+								Paired:     true,
+								Bonded:     true,
+								Trusted:    true,
+								RSSI:       -45,
+								Percentage: 80,
+								UUIDs: uuids(
+									bluetooth.HeadsetServiceClass,
+									bluetooth.AudioSinkServiceClass,
+									bluetooth.AvRemoteTargetServiceClass,
+									bluetooth.AvRemoteServiceClass,
+									bluetooth.HandsfreeServiceClass,
+								),
+							},
+						},
+						Media: &bluetooth.MediaData{
+							Status: bluetooth.MediaPaused,
+							TrackData: bluetooth.TrackData{
+								Title:       "Simulated Track",
+								Album:       "Simulated Album",
+								Artist:      "Simulated Artist",
+								Duration:    215000,
+								TrackNumber: 1,
+								TotalTracks: 12,
+							},
+						},
+					},
+				},
+				Discoverable: []DeviceConfig{
+					{
+						DeviceData: bluetooth.DeviceData{
+							Name:  "Simulated Phone",
+							Class: 0x5a020c,
+							DeviceEventData: bluetooth.DeviceEventData{
+								Address: mustParseMAC("F4:0E:22:8B:10:42"),
+								Alias:   "Simulated Phone",
+								Icon:    "phone",
+								RSSI:    -60,
+								UUIDs: uuids(
+									bluetooth.ObexObjpushServiceClass,
+									bluetooth.HandsfreeAgwServiceClass,
+									bluetooth.NapServiceClass,
+									bluetooth.PanuServiceClass,
+								),
+							},
+						},
+						Pairing: PairingConfirmPasskey,
+						Passkey: 482915,
+					},
+					{
+						DeviceData: bluetooth.DeviceData{
+							Name:  "Simulated Keyboard",
+							Class: 0x002540,
+							DeviceEventData: bluetooth.DeviceEventData{
+								Address: mustParseMAC("D0:5F:B8:30:2A:77"),
+								Alias:   "Simulated Keyboard",
+								Icon:    "input-keyboard",
+								RSSI:    -70,
+								UUIDs:   uuids(bluetooth.HidServiceClass),
+							},
+						},
+						Pairing: PairingDisplayPasskey,
+						Passkey: 937461,
+					},
+					{
+						DeviceData: bluetooth.DeviceData{
+							Name:  "Simulated Speaker",
+							Class: 0x240414,
+							DeviceEventData: bluetooth.DeviceEventData{
+								Address: mustParseMAC("5C:FB:7C:11:C3:09"),
+								Alias:   "Simulated Speaker",
+								Icon:    "audio-card",
+								RSSI:    -75,
+								UUIDs:   uuids(bluetooth.AudioSinkServiceClass, bluetooth.AvRemoteTargetServiceClass),
+							},
+						},
+						Pairing: PairingJustWorks,
+					},
+				},
+			},
+		},
+	}
+}
+
+// serviceUUID returns the full 128-bit UUID of a Bluetooth service class.
+func serviceUUID(svclass uint32) uuid.UUID {
+	base := uuid.MustParse("00000000-0000-1000-8000-00805f9b34fb")
+	base[0] = byte(svclass >> 24)
+	base[1] = byte(svclass >> 16)
+	base[2] = byte(svclass >> 8)
+	base[3] = byte(svclass)
+
+	return base
+}
+
+// mustParseMAC parses a Bluetooth address, and panics if it cannot be parsed.
+func mustParseMAC(address string) bluetooth.MacAddress {
+	mac, err := bluetooth.ParseMAC(address)
+	if err != nil {
+		panic(err)
+	}
+
+	return mac
+}
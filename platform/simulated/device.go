@@ -0,0 +1,490 @@
+package simulated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/api/helpers/connection"
+	"github.com/google/uuid"
+)
+
+// device describes a function call interface to invoke device related functions.
+type device struct {
+	s   *Session
+	ctx context.Context
+
+	Address bluetooth.MacAddress
+}
+
+// WithContext returns a copy of the device function call interface, whose
+// method calls are bound to the provided context.
+func (d *device) WithContext(ctx context.Context) bluetooth.Device {
+	copied := *d
+	copied.ctx = ctx
+
+	return &copied
+}
+
+// Pair will attempt to pair with the device, and authenticates the pairing
+// request via the session's authorization handler.
+func (d *device) Pair() error {
+	device, err := d.check()
+	if err != nil {
+		return err
+	}
+
+	if device.Paired {
+		return wrapError(errorkinds.ErrMethodCall,
+			"device-pair", d.Address,
+			"Device is already paired",
+		)
+	}
+
+	d.s.mu.Lock()
+	if _, ok := d.s.pairing[d.Address]; ok {
+		d.s.mu.Unlock()
+
+		return wrapError(errorkinds.ErrMethodCall,
+			"device-pair", d.Address,
+			"Pairing is already in progress",
+		)
+	}
+
+	ctx, cancel := context.WithCancel(d.s.ctx)
+	stop := context.AfterFunc(d.callContext(), cancel)
+	d.s.pairing[d.Address] = cancel
+	deviceConfig := d.s.devices[d.Address]
+	d.s.mu.Unlock()
+
+	defer func() {
+		d.s.mu.Lock()
+		delete(d.s.pairing, d.Address)
+		d.s.mu.Unlock()
+
+		stop()
+		cancel()
+	}()
+
+	if err := d.s.wait(ctx); err != nil {
+		return wrapError(err,
+			"device-pair", d.Address,
+			"Pairing was cancelled",
+		)
+	}
+
+	if err := d.authenticate(ctx, deviceConfig); err != nil {
+		return wrapError(err,
+			"device-pair", d.Address,
+			"Cannot pair with device",
+		)
+	}
+
+	d.setProperty(func(device *bluetooth.DeviceData) {
+		device.Paired = true
+		device.Bonded = true
+	})
+
+	return nil
+}
+
+// CancelPairing will cancel a pairing attempt.
+func (d *device) CancelPairing() error {
+	if _, err := d.check(); err != nil {
+		return err
+	}
+
+	d.s.mu.Lock()
+	cancel, ok := d.s.pairing[d.Address]
+	d.s.mu.Unlock()
+
+	if !ok {
+		return wrapError(errorkinds.ErrMethodCall,
+			"device-cancelpairing", d.Address,
+			"No pairing attempt is in progress",
+		)
+	}
+
+	cancel()
+
+	return nil
+}
+
+// Connect will attempt to connect an already paired device to its adapter.
+func (d *device) Connect() error {
+	d.s.mu.Lock()
+	d.s.reconnect.Release(d.Address)
+	d.s.mu.Unlock()
+
+	return d.connectDevice()
+}
+
+// connectDevice connects to the device, or if the device is not known yet, connects
+// to it via its adapter. Unlike Connect, a device which is held by the reconnection
+// manager is not released.
+func (d *device) connectDevice() error {
+	device, err := d.check()
+	if err != nil {
+		if !errors.Is(err, errorkinds.ErrDeviceNotFound) {
+			return err
+		}
+
+		d.s.mu.Lock()
+		deviceConfig, ok := d.s.devices[d.Address]
+		d.s.mu.Unlock()
+
+		if !ok {
+			return err
+		}
+
+		return d.s.Adapter(deviceConfig.AssociatedAdapter).WithContext(d.callContext()).
+			ConnectDevice(d.Address, bluetooth.AddressTypeBREDR)
+	}
+
+	if !device.Paired {
+		return wrapError(errorkinds.ErrMethodCall,
+			"device-connect", d.Address,
+			"Cannot connect to an unpaired device",
+		)
+	}
+
+	if device.Blocked {
+		return wrapError(errorkinds.ErrMethodCall,
+			"device-connect", d.Address,
+			"Cannot connect to a blocked device",
+		)
+	}
+
+	if device.Connected {
+		return nil
+	}
+
+	return d.connect()
+}
+
+// connect marks the device as connected after the operation delay, and starts
+// any device specific operations.
+func (d *device) connect() error {
+	if err := d.s.wait(d.callContext()); err != nil {
+		return wrapError(err,
+			"device-connect", d.Address,
+			"Cannot connect to device",
+		)
+	}
+
+	d.s.mu.Lock()
+	deviceConfig, ok := d.s.devices[d.Address]
+	if ok && deviceConfig.ConnectFailures > 0 {
+		deviceConfig.ConnectFailures--
+		d.s.devices[d.Address] = deviceConfig
+		d.s.mu.Unlock()
+
+		return wrapError(errorkinds.ErrDeviceUnreachable,
+			"device-connect", d.Address,
+			"Page timeout while connecting to device",
+		)
+	}
+	d.s.mu.Unlock()
+
+	d.setProperty(func(device *bluetooth.DeviceData) {
+		device.Connected = true
+		device.ServicesResolved = true
+	})
+
+	d.s.mu.Lock()
+	deviceConfig = d.s.devices[d.Address]
+	if deviceConfig.Media != nil {
+		d.s.players[d.Address] = newPlayer(d.s, d.Address, *deviceConfig.Media)
+	}
+	d.s.mu.Unlock()
+
+	return nil
+}
+
+// ConnectWithRetry will attempt to connect to the device, and retries the connection
+// attempt according to the provided policy if the device is unreachable.
+func (d *device) ConnectWithRetry(policy bluetooth.ConnectRetryPolicy) error {
+	d.s.mu.Lock()
+	d.s.reconnect.Release(d.Address)
+	d.s.mu.Unlock()
+
+	return d.connectWithRetry(policy)
+}
+
+// connectWithRetry retries connecting to the device according to the provided policy,
+// without releasing the device from the reconnection manager.
+func (d *device) connectWithRetry(policy bluetooth.ConnectRetryPolicy) error {
+	return connection.Retry(d.callContext(), d.s.emitter, d.Address, policy, d.connectDevice,
+		func(err error) bool {
+			return errors.Is(err, errorkinds.ErrDeviceUnreachable)
+		},
+	)
+}
+
+// Disconnect will disconnect the device from its adapter.
+func (d *device) Disconnect() error {
+	device, err := d.check()
+	if err != nil {
+		return err
+	}
+
+	if !device.Connected {
+		return wrapError(errorkinds.ErrMethodCall,
+			"device-disconnect", d.Address,
+			"Device is not connected",
+		)
+	}
+
+	d.s.mu.Lock()
+	d.s.reconnect.Hold(d.Address)
+	d.s.mu.Unlock()
+
+	d.disconnect()
+
+	return nil
+}
+
+// ConnectProfile will attempt to connect an already paired device
+// to its adapter, using a specific Bluetooth profile UUID.
+func (d *device) ConnectProfile(profileUUID uuid.UUID) error {
+	if err := d.checkProfile(profileUUID, "device-connect-profile"); err != nil {
+		return err
+	}
+
+	return d.Connect()
+}
+
+// DisconnectProfile will attempt to disconnect an already paired device
+// from its adapter, using a specific Bluetooth profile UUID.
+func (d *device) DisconnectProfile(profileUUID uuid.UUID) error {
+	if err := d.checkProfile(profileUUID, "device-disconnect-profile"); err != nil {
+		return err
+	}
+
+	return d.Disconnect()
+}
+
+// Remove removes the device from its associated adapter.
+func (d *device) Remove() error {
+	device, err := d.check()
+	if err != nil {
+		return err
+	}
+
+	if device.Connected {
+		d.disconnect()
+	}
+
+	d.s.store.RemoveDevice(d.Address)
+
+	bluetooth.DeviceEvent(bluetooth.EventActionRemoved).On(d.s.emitter).Publish(bluetooth.DeviceEventData{
+		Address:           d.Address,
+		AssociatedAdapter: device.AssociatedAdapter,
+	})
+
+	return nil
+}
+
+// SetTrusted sets the trusted state of the device.
+func (d *device) SetTrusted(enable bool) error {
+	if _, err := d.check(); err != nil {
+		return err
+	}
+
+	d.setProperty(func(device *bluetooth.DeviceData) {
+		device.Trusted = enable
+	})
+
+	return nil
+}
+
+// SetBlocked sets the blocked state of the device.
+// Blocking a connected device disconnects it.
+func (d *device) SetBlocked(enable bool) error {
+	device, err := d.check()
+	if err != nil {
+		return err
+	}
+
+	if enable && device.Connected {
+		d.disconnect()
+	}
+
+	d.setProperty(func(device *bluetooth.DeviceData) {
+		device.Blocked = enable
+	})
+
+	return nil
+}
+
+// SetAlias sets the alias of the device.
+// If the alias is empty, the device name is used as the alias.
+func (d *device) SetAlias(alias string) error {
+	if _, err := d.check(); err != nil {
+		return err
+	}
+
+	d.setProperty(func(device *bluetooth.DeviceData) {
+		device.Alias = alias
+		if alias == "" {
+			device.Alias = device.Name
+		}
+	})
+
+	return nil
+}
+
+// SetWakeAllowed sets whether the device is allowed to wake up the host from system suspend.
+func (d *device) SetWakeAllowed(enable bool) error {
+	if _, err := d.check(); err != nil {
+		return err
+	}
+
+	d.setProperty(func(device *bluetooth.DeviceData) {
+		device.WakeAllowed = enable
+	})
+
+	return nil
+}
+
+// SetAutoReconnect sets whether the device is reconnected automatically.
+func (d *device) SetAutoReconnect(enable bool) error {
+	if _, err := d.check(); err != nil {
+		return err
+	}
+
+	d.s.mu.Lock()
+	d.s.reconnect.SetEnabled(d.Address, enable)
+	d.s.mu.Unlock()
+
+	return nil
+}
+
+// Setup runs the setup workflow of the device, which pairs, trusts and connects to
+// the device, and waits for its services to be resolved.
+func (d *device) Setup(ctx context.Context, opts bluetooth.SetupOptions) (bluetooth.SetupResult, error) {
+	return connection.Setup(ctx, d.s.emitter, d, d.Address, opts)
+}
+
+// Properties returns all the properties of the device.
+func (d *device) Properties() (bluetooth.DeviceData, error) {
+	return d.check()
+}
+
+// authenticate calls the session's authorization handler according to
+// the pairing method of the device.
+func (d *device) authenticate(ctx context.Context, deviceConfig DeviceConfig) error {
+	timeout := bluetooth.NewAuthTimeout(d.s.authTimeout)
+	defer timeout.Cancel()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			timeout.Cancel()
+
+		case <-timeout.Done():
+		}
+	}()
+
+	switch deviceConfig.Pairing {
+	case PairingConfirmPasskey:
+		return d.s.authHandler.ConfirmPasskey(timeout, d.Address, deviceConfig.Passkey)
+
+	case PairingDisplayPasskey:
+		return d.s.authHandler.DisplayPasskey(timeout, d.Address, deviceConfig.Passkey, 0)
+
+	case PairingDisplayPinCode:
+		return d.s.authHandler.DisplayPinCode(timeout, d.Address, fmt.Sprintf("%06d", deviceConfig.Passkey))
+
+	case PairingAuthorize:
+		return d.s.authHandler.AuthorizePairing(timeout, d.Address)
+	}
+
+	return nil
+}
+
+// disconnect marks the device as disconnected, and stops any device specific operations.
+func (d *device) disconnect() {
+	d.s.mu.Lock()
+	if p, ok := d.s.players[d.Address]; ok {
+		p.stop()
+		delete(d.s.players, d.Address)
+	}
+
+	delete(d.s.networks, d.Address)
+	d.s.mu.Unlock()
+
+	d.setProperty(func(device *bluetooth.DeviceData) {
+		device.Connected = false
+		device.ServicesResolved = false
+	})
+}
+
+// setProperty updates the device properties in the store, and publishes a device event.
+func (d *device) setProperty(setfn func(device *bluetooth.DeviceData)) {
+	updated, err := d.s.store.UpdateDevice(d.Address, func(device *bluetooth.DeviceData) error {
+		setfn(device)
+
+		return nil
+	})
+	if err != nil {
+		return
+	}
+
+	bluetooth.DeviceEvent(bluetooth.EventActionUpdated).On(d.s.emitter).Publish(updated)
+}
+
+// checkProfile checks whether the device supports the provided profile.
+func (d *device) checkProfile(profileUUID uuid.UUID, errorAt string) error {
+	device, err := d.check()
+	if err != nil {
+		return err
+	}
+
+	if !bluetooth.ServiceExists(device.UUIDs, profileUUID.ID()) {
+		return wrapError(errorkinds.ErrMethodCall,
+			errorAt, d.Address,
+			"Device does not support the profile",
+		)
+	}
+
+	return nil
+}
+
+// check checks whether the session is started, and the device exists.
+func (d *device) check() (bluetooth.DeviceData, error) {
+	if d.s == nil || !d.s.isStarted() {
+		return bluetooth.DeviceData{}, wrapError(errorkinds.ErrDeviceNotFound,
+			"device-check-session", d.Address,
+			"Error while fetching device data",
+		)
+	}
+
+	if err := d.callContext().Err(); err != nil {
+		return bluetooth.DeviceData{}, wrapError(errorkinds.ErrMethodCanceled,
+			"device-check-context", d.Address,
+			"Method call was cancelled",
+		)
+	}
+
+	device, err := d.s.store.Device(d.Address)
+	if err != nil {
+		return device, wrapError(err,
+			"device-check-store", d.Address,
+			"Device does not exist",
+		)
+	}
+
+	return device, nil
+}
+
+// callContext returns the context which the device's method calls are bound to.
+func (d *device) callContext() context.Context {
+	if d.ctx == nil {
+		return context.Background()
+	}
+
+	return d.ctx
+}
@@ -0,0 +1,10 @@
+/*
+Package simulated provides an in-memory Bluetooth session, which
+simulates adapters, devices, file transfers, networks and media players
+without any Bluetooth hardware.
+
+It implements the same interfaces and publishes the same event streams
+as the platform-specific sessions, and is intended to be used to develop
+and test applications without a Bluetooth radio.
+*/
+package simulated
@@ -0,0 +1,252 @@
+package simulated
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/api/eventbus"
+)
+
+// positionInterval is the interval at which the track position is updated
+// while the media player is playing.
+const positionInterval = time.Second
+
+// mediaPlayer describes a function call interface to invoke media player related functions.
+type mediaPlayer struct {
+	s   *Session
+	ctx context.Context
+
+	Address bluetooth.MacAddress
+}
+
+// WithContext returns a copy of the media player function call interface, whose
+// method calls are bound to the provided context.
+func (m *mediaPlayer) WithContext(ctx context.Context) bluetooth.MediaPlayer {
+	copied := *m
+	copied.ctx = ctx
+
+	return &copied
+}
+
+// player holds the state of a simulated media player.
+type player struct {
+	address bluetooth.MacAddress
+	data    bluetooth.MediaData
+	emitter *eventbus.Emitter
+
+	cancel context.CancelFunc
+	mu     sync.Mutex
+}
+
+// Properties gets the media properties of the currently playing track.
+func (m *mediaPlayer) Properties() (bluetooth.MediaData, error) {
+	p, err := m.check()
+	if err != nil {
+		return bluetooth.MediaData{}, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.data, nil
+}
+
+// Play starts the media playback.
+func (m *mediaPlayer) Play() error {
+	return m.setStatus(bluetooth.MediaPlaying)
+}
+
+// Pause suspends the media playback.
+func (m *mediaPlayer) Pause() error {
+	return m.setStatus(bluetooth.MediaPaused)
+}
+
+// TogglePlayPause toggles the play/pause states.
+func (m *mediaPlayer) TogglePlayPause() error {
+	p, err := m.check()
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	status := p.data.Status
+	p.mu.Unlock()
+
+	switch status {
+	case bluetooth.MediaPlaying:
+		return m.Pause()
+
+	case bluetooth.MediaPaused:
+		return m.Play()
+	}
+
+	return nil
+}
+
+// Next switches to the next track.
+func (m *mediaPlayer) Next() error {
+	return m.setTrack(1)
+}
+
+// Previous switches to the previous track.
+func (m *mediaPlayer) Previous() error {
+	return m.setTrack(-1)
+}
+
+// FastForward forward-skips the currently playing track.
+func (m *mediaPlayer) FastForward() error {
+	return m.setStatus(bluetooth.MediaForwardSeek)
+}
+
+// Rewind backward-skips the currently playing track.
+func (m *mediaPlayer) Rewind() error {
+	return m.setStatus(bluetooth.MediaReverseSeek)
+}
+
+// Stop halts the media playback.
+func (m *mediaPlayer) Stop() error {
+	return m.setStatus(bluetooth.MediaStopped)
+}
+
+// setStatus sets the status of the media player.
+func (m *mediaPlayer) setStatus(status bluetooth.MediaStatus) error {
+	p, err := m.check()
+	if err != nil {
+		return err
+	}
+
+	p.update(func(data *bluetooth.MediaData) {
+		data.Status = status
+		if status == bluetooth.MediaStopped {
+			data.Position = 0
+		}
+	})
+
+	return nil
+}
+
+// setTrack switches the current track by the provided offset.
+func (m *mediaPlayer) setTrack(offset int) error {
+	p, err := m.check()
+	if err != nil {
+		return err
+	}
+
+	p.update(func(data *bluetooth.MediaData) {
+		track := int(data.TrackNumber) + offset
+		if track < 1 {
+			track = int(data.TotalTracks)
+		}
+
+		if track > int(data.TotalTracks) {
+			track = 1
+		}
+
+		data.TrackNumber = uint32(track)
+		data.Position = 0
+	})
+
+	return nil
+}
+
+// check checks whether the device exists, and has a connected media player.
+func (m *mediaPlayer) check() (*player, error) {
+	if _, err := (&device{s: m.s, ctx: m.ctx, Address: m.Address}).check(); err != nil {
+		return nil, err
+	}
+
+	m.s.mu.Lock()
+	p, ok := m.s.players[m.Address]
+	m.s.mu.Unlock()
+
+	if !ok {
+		return nil, wrapError(errorkinds.ErrMediaPlayerNotConnected,
+			"media-player-conn", m.Address,
+			"Player is not connected",
+		)
+	}
+
+	return p, nil
+}
+
+// newPlayer returns a new simulated media player, which advances the track
+// position while it is playing.
+func newPlayer(s *Session, address bluetooth.MacAddress, data bluetooth.MediaData) *player {
+	ctx, cancel := context.WithCancel(s.ctx)
+
+	p := &player{
+		address: address,
+		data:    data,
+		emitter: s.emitter,
+		cancel:  cancel,
+	}
+
+	go p.run(ctx)
+
+	return p
+}
+
+// run advances the position of the current track while the player is playing.
+func (p *player) run(ctx context.Context) {
+	ticker := time.NewTicker(positionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+		}
+
+		p.mu.Lock()
+		playing := p.data.Status == bluetooth.MediaPlaying
+		p.mu.Unlock()
+
+		if !playing {
+			continue
+		}
+
+		p.update(func(data *bluetooth.MediaData) {
+			data.Position += uint32(positionInterval.Milliseconds())
+			if data.Duration > 0 && data.Position >= data.Duration {
+				data.Position = 0
+				data.TrackNumber++
+
+				if data.TrackNumber > data.TotalTracks {
+					data.TrackNumber = 1
+				}
+			}
+		})
+	}
+}
+
+// update updates the media player data, and publishes a media event.
+func (p *player) update(updatefn func(data *bluetooth.MediaData)) {
+	p.mu.Lock()
+	updatefn(&p.data)
+	data := p.data
+	p.mu.Unlock()
+
+	bluetooth.MediaEvent(bluetooth.EventActionUpdated).On(p.emitter).Publish(bluetooth.MediaEventData{
+		Address:   p.address,
+		MediaData: data,
+	})
+}
+
+// stop stops the media player.
+func (p *player) stop() {
+	p.cancel()
+}
+
+// callContext returns the context which the media player's method calls are bound to.
+func (m *mediaPlayer) callContext() context.Context {
+	if m.ctx == nil {
+		return context.Background()
+	}
+
+	return m.ctx
+}
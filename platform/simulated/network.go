@@ -0,0 +1,78 @@
+package simulated
+
+import (
+	"context"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+)
+
+// network describes a function call interface to invoke network related functions.
+type network struct {
+	s   *Session
+	ctx context.Context
+
+	Address bluetooth.MacAddress
+}
+
+// WithContext returns a copy of the network function call interface, whose
+// method calls are bound to the provided context.
+func (n *network) WithContext(ctx context.Context) bluetooth.Network {
+	copied := *n
+	copied.ctx = ctx
+
+	return &copied
+}
+
+// Connect simulates a network connection to the device according to the provided NetworkType.
+func (n *network) Connect(_ string, nt bluetooth.NetworkType) error {
+	device, err := (&device{s: n.s, ctx: n.ctx, Address: n.Address}).check()
+	if err != nil {
+		return err
+	}
+
+	svclass := uint32(bluetooth.NapServiceClass)
+	if nt == bluetooth.NetworkDun {
+		svclass = bluetooth.DialupNetServiceClass
+	}
+
+	if !device.Connected || !bluetooth.ServiceExists(device.UUIDs, svclass) {
+		return wrapError(errorkinds.ErrNetworkEstablishError,
+			"network-connect-create", n.Address,
+			"Cannot create connection",
+		)
+	}
+
+	n.s.mu.Lock()
+	defer n.s.mu.Unlock()
+
+	if _, ok := n.s.networks[n.Address]; ok {
+		return errorkinds.ErrNetworkAlreadyActive
+	}
+
+	n.s.networks[n.Address] = nt
+
+	return nil
+}
+
+// Disconnect deactivates the connection.
+func (n *network) Disconnect() error {
+	if _, err := (&device{s: n.s, ctx: n.ctx, Address: n.Address}).check(); err != nil {
+		return err
+	}
+
+	n.s.mu.Lock()
+	delete(n.s.networks, n.Address)
+	n.s.mu.Unlock()
+
+	return nil
+}
+
+// callContext returns the context which the network's method calls are bound to.
+func (n *network) callContext() context.Context {
+	if n.ctx == nil {
+		return context.Background()
+	}
+
+	return n.ctx
+}
@@ -0,0 +1,315 @@
+package simulated
+
+import (
+	"context"
+	"mime"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/api/eventbus"
+)
+
+// transferInterval is the interval at which the file transfer progress is updated.
+const transferInterval = 250 * time.Millisecond
+
+// obex describes a function call interface to invoke obex related functions.
+type obex struct {
+	s *Session
+
+	Address bluetooth.MacAddress
+}
+
+// fileTransfer describes a simulated file transfer session.
+type fileTransfer obex
+
+// transfer holds the state of a simulated file transfer.
+type transfer struct {
+	data    bluetooth.FileTransferData
+	emitter *eventbus.Emitter
+
+	cancel context.CancelFunc
+	mu     sync.Mutex
+}
+
+// FileTransfer returns a function call interface to invoke device file transfer
+// related functions.
+func (o *obex) FileTransfer() bluetooth.ObexFileTransfer {
+	return &fileTransfer{s: o.s, Address: o.Address}
+}
+
+// CreateSession creates a new simulated Obex session with a device.
+func (o *fileTransfer) CreateSession(ctx context.Context) error {
+	if _, err := o.check(); err != nil {
+		return err
+	}
+
+	if err := o.s.wait(ctx); err != nil {
+		return wrapError(err,
+			"obex-createsession-cancelled", o.Address,
+			"Session creation was cancelled",
+		)
+	}
+
+	o.s.mu.Lock()
+	o.s.sessions[o.Address] = struct{}{}
+	o.s.mu.Unlock()
+
+	return nil
+}
+
+// RemoveSession removes a created Obex session.
+func (o *fileTransfer) RemoveSession() error {
+	if _, err := o.checkSession("obex-removesession-path"); err != nil {
+		return err
+	}
+
+	o.s.mu.Lock()
+	delete(o.s.sessions, o.Address)
+	o.s.mu.Unlock()
+
+	return nil
+}
+
+// SendFile sends a file to the device. The 'filepath' must be a full path to the file.
+func (o *fileTransfer) SendFile(path string) (bluetooth.FileTransferData, error) {
+	if _, err := o.checkSession("obex-sendfile-sessionpath"); err != nil {
+		return bluetooth.FileTransferData{}, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return bluetooth.FileTransferData{}, wrapError(err,
+			"obex-sendfile-methodcall", o.Address,
+			"Cannot send file: "+path,
+		)
+	}
+
+	data := bluetooth.FileTransferData{
+		Name:     filepath.Base(path),
+		Type:     mime.TypeByExtension(filepath.Ext(path)),
+		Status:   bluetooth.TransferQueued,
+		Filename: path,
+		FileTransferEventData: bluetooth.FileTransferEventData{
+			Address: o.Address,
+			Size:    uint64(info.Size()),
+		},
+	}
+
+	o.s.startTransfer(data)
+
+	return data, nil
+}
+
+// CancelTransfer cancels the transfer.
+func (o *fileTransfer) CancelTransfer() error {
+	t, err := o.checkTransfer("obex-canceltransfer-path")
+	if err != nil {
+		return err
+	}
+
+	t.cancel()
+
+	return nil
+}
+
+// SuspendTransfer suspends the transfer.
+func (o *fileTransfer) SuspendTransfer() error {
+	t, err := o.checkTransfer("obex-suspendtransfer-path")
+	if err != nil {
+		return err
+	}
+
+	t.setStatus(bluetooth.TransferSuspended)
+
+	return nil
+}
+
+// ResumeTransfer resumes the transfer.
+func (o *fileTransfer) ResumeTransfer() error {
+	t, err := o.checkTransfer("obex-resumetransfer-path")
+	if err != nil {
+		return err
+	}
+
+	t.setStatus(bluetooth.TransferActive)
+
+	return nil
+}
+
+// ReceiveFile simulates a file with the provided name and size being sent by a device.
+// The transfer is authorized via the session's authorization handler before it is started.
+func (s *Session) ReceiveFile(deviceAddress bluetooth.MacAddress, name string, size uint64) error {
+	if _, err := (&fileTransfer{s: s, Address: deviceAddress}).check(); err != nil {
+		return err
+	}
+
+	path := filepath.Join(os.TempDir(), name)
+	data := bluetooth.FileTransferData{
+		Name:     name,
+		Type:     mime.TypeByExtension(filepath.Ext(name)),
+		Status:   bluetooth.TransferQueued,
+		Filename: path,
+		FileTransferEventData: bluetooth.FileTransferEventData{
+			Address: deviceAddress,
+			Size:    size,
+		},
+	}
+
+	timeout := bluetooth.NewAuthTimeout(s.authTimeout)
+	defer timeout.Cancel()
+
+	if err := s.authHandler.AuthorizeTransfer(timeout, path, data); err != nil {
+		return wrapError(err,
+			"authpush-agent-authorize", deviceAddress,
+			"Transfer was not authorized",
+		)
+	}
+
+	s.startTransfer(data)
+
+	return nil
+}
+
+// startTransfer starts a simulated file transfer, which publishes
+// file transfer events until the transfer is completed or cancelled.
+func (s *Session) startTransfer(data bluetooth.FileTransferData) {
+	ctx, cancel := context.WithCancel(s.ctx)
+
+	t := &transfer{data: data, emitter: s.emitter, cancel: cancel}
+
+	s.mu.Lock()
+	if previous, ok := s.transfers[data.Address]; ok {
+		previous.cancel()
+	}
+
+	s.transfers[data.Address] = t
+	s.mu.Unlock()
+
+	bluetooth.FileTransferEvent(bluetooth.EventActionAdded).On(s.emitter).Publish(data.FileTransferEventData)
+
+	go func() {
+		defer func() {
+			s.mu.Lock()
+			if s.transfers[data.Address] == t {
+				delete(s.transfers, data.Address)
+			}
+			s.mu.Unlock()
+		}()
+
+		rate := s.cfg.TransferRate
+		if rate == 0 {
+			rate = 1024
+		}
+
+		chunk := rate * uint64(transferInterval) / uint64(time.Second)
+		if chunk == 0 {
+			chunk = 1
+		}
+
+		ticker := time.NewTicker(transferInterval)
+		defer ticker.Stop()
+
+		t.setStatus(bluetooth.TransferActive)
+
+		for {
+			select {
+			case <-ctx.Done():
+				t.setStatus(bluetooth.TransferError)
+
+				return
+
+			case <-ticker.C:
+			}
+
+			t.mu.Lock()
+			if t.data.Status != bluetooth.TransferActive {
+				t.mu.Unlock()
+
+				continue
+			}
+
+			t.data.Transferred += chunk
+			if t.data.Transferred >= t.data.Size {
+				t.data.Transferred = t.data.Size
+				t.data.Status = bluetooth.TransferComplete
+			}
+
+			status := t.data.Status
+			t.mu.Unlock()
+
+			t.publish()
+
+			if status == bluetooth.TransferComplete {
+				return
+			}
+		}
+	}()
+}
+
+// setStatus sets the status of the transfer, and publishes a file transfer event.
+func (t *transfer) setStatus(status bluetooth.FileTransferStatus) {
+	t.mu.Lock()
+	t.data.Status = status
+	t.mu.Unlock()
+
+	t.publish()
+}
+
+// publish publishes a file transfer event with the current transfer data.
+func (t *transfer) publish() {
+	t.mu.Lock()
+	data := t.data.FileTransferEventData
+	t.mu.Unlock()
+
+	bluetooth.FileTransferEvent(bluetooth.EventActionUpdated).On(t.emitter).Publish(data)
+}
+
+// checkTransfer checks whether a transfer is in progress for the device.
+func (o *fileTransfer) checkTransfer(errorAt string) (*transfer, error) {
+	if _, err := o.checkSession(errorAt); err != nil {
+		return nil, err
+	}
+
+	o.s.mu.Lock()
+	t, ok := o.s.transfers[o.Address]
+	o.s.mu.Unlock()
+
+	if !ok {
+		return nil, wrapError(errorkinds.ErrPropertyDataParse,
+			errorAt, o.Address,
+			"Cannot obtain file transfer data",
+		)
+	}
+
+	return t, nil
+}
+
+// checkSession checks whether a session was created for the device.
+func (o *fileTransfer) checkSession(errorAt string) (bluetooth.DeviceData, error) {
+	device, err := o.check()
+	if err != nil {
+		return device, err
+	}
+
+	o.s.mu.Lock()
+	_, ok := o.s.sessions[o.Address]
+	o.s.mu.Unlock()
+
+	if !ok {
+		return device, wrapError(errorkinds.ErrObexInitSession,
+			errorAt, o.Address,
+			"Cannot obtain file transfer session data",
+		)
+	}
+
+	return device, nil
+}
+
+// check checks whether the device exists.
+func (o *fileTransfer) check() (bluetooth.DeviceData, error) {
+	return (&device{s: o.s, Address: o.Address}).check()
+}
@@ -0,0 +1,324 @@
+package simulated
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	ac "github.com/bluetuith-org/api-native/api/appfeatures"
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/config"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/api/eventbus"
+	"github.com/bluetuith-org/api-native/api/helpers/discovery"
+	"github.com/bluetuith-org/api-native/api/helpers/reconnect"
+	sstore "github.com/bluetuith-org/api-native/api/helpers/sessionstore"
+)
+
+// Session describes a simulated Bluetooth session.
+type Session struct {
+	cfg Config
+
+	authHandler    bluetooth.SessionAuthorizer
+	authTimeout    time.Duration
+	defaultAdapter string
+	emitter        *eventbus.Emitter
+
+	store   sstore.SessionStore
+	devices map[bluetooth.MacAddress]DeviceConfig
+
+	discovery map[bluetooth.MacAddress]context.CancelFunc
+	filters   map[bluetooth.MacAddress]bluetooth.DiscoveryFilter
+	scans     *discovery.Scans
+	timeouts  map[adapterTimeout]context.CancelFunc
+	pairing   map[bluetooth.MacAddress]context.CancelFunc
+	sessions  map[bluetooth.MacAddress]struct{}
+	transfers map[bluetooth.MacAddress]*transfer
+	networks  map[bluetooth.MacAddress]bluetooth.NetworkType
+	players   map[bluetooth.MacAddress]*player
+	reconnect *reconnect.Manager
+
+	started bool
+	ctx     context.Context
+	cancel  context.CancelFunc
+
+	mu sync.Mutex
+}
+
+// NewSession returns a new simulated session with the provided configuration.
+func NewSession(cfg Config) *Session {
+	return &Session{cfg: cfg}
+}
+
+// Start initializes the virtual adapters and devices.
+func (s *Session) Start(authHandler bluetooth.SessionAuthorizer, cfg config.Configuration) (ac.FeatureSet, error) {
+	features, err := s.start(authHandler, cfg)
+	if err != nil {
+		return features, err
+	}
+
+	// The reconnection manager fetches the adapters and devices from the session
+	// when it is started, so it is started after the session is unlocked.
+	s.mu.Lock()
+	manager := s.reconnect
+	s.mu.Unlock()
+
+	manager.Start()
+
+	return features, nil
+}
+
+// start initializes the virtual adapters and devices, and the reconnection manager.
+func (s *Session) start(authHandler bluetooth.SessionAuthorizer, cfg config.Configuration) (ac.FeatureSet, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return ac.NilFeatureSet(), wrapError(errorkinds.ErrSessionStart,
+			"session-start", bluetooth.MacAddress{},
+			"Simulated session is already started",
+		)
+	}
+
+	if authHandler == nil {
+		authHandler = &bluetooth.DefaultAuthorizer{}
+	}
+
+	if cfg.AuthTimeout == 0 {
+		cfg.AuthTimeout = config.DefaultAuthTimeout
+	}
+
+	s.authHandler = authHandler
+	s.authTimeout = cfg.AuthTimeout
+	s.defaultAdapter = cfg.DefaultAdapter
+
+	s.emitter = cfg.EventEmitter
+	if s.emitter == nil {
+		s.emitter = eventbus.DefaultEmitter()
+	}
+	s.emitter = s.emitter.Scope()
+
+	s.publishState(bluetooth.SessionStarting, ac.NilFeatureSet())
+
+	s.store = sstore.NewSessionStore()
+	s.devices = make(map[bluetooth.MacAddress]DeviceConfig)
+	s.discovery = make(map[bluetooth.MacAddress]context.CancelFunc)
+	s.filters = make(map[bluetooth.MacAddress]bluetooth.DiscoveryFilter)
+	s.scans = discovery.NewScans()
+	s.timeouts = make(map[adapterTimeout]context.CancelFunc)
+	s.pairing = make(map[bluetooth.MacAddress]context.CancelFunc)
+	s.sessions = make(map[bluetooth.MacAddress]struct{})
+	s.transfers = make(map[bluetooth.MacAddress]*transfer)
+	s.networks = make(map[bluetooth.MacAddress]bluetooth.NetworkType)
+	s.players = make(map[bluetooth.MacAddress]*player)
+	s.reconnect = reconnect.NewManager(s, cfg.AutoReconnect, s.reconnectDevice)
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	for _, adapterConfig := range s.cfg.Adapters {
+		adapter := adapterConfig.AdapterData
+		if adapter.UniqueName == "" {
+			adapter.UniqueName = adapter.Name
+		}
+
+		s.store.AddAdapter(adapter)
+
+		for _, deviceConfig := range adapterConfig.Devices {
+			deviceConfig.AssociatedAdapter = adapter.Address
+			s.devices[deviceConfig.Address] = deviceConfig
+
+			s.store.AddDevice(newDeviceData(deviceConfig))
+		}
+
+		for _, deviceConfig := range adapterConfig.Discoverable {
+			deviceConfig.AssociatedAdapter = adapter.Address
+			s.devices[deviceConfig.Address] = deviceConfig
+		}
+	}
+
+	s.started = true
+	s.refreshDefaultAdapter()
+	s.publishState(bluetooth.SessionReady, ac.MergedFeatureSet())
+
+	return ac.MergedFeatureSet(), nil
+}
+
+// Stop stops all simulated operations, and closes all subscriptions
+// which were made via the session's event emitter (see Events).
+func (s *Session) Stop() error {
+	s.mu.Lock()
+	if !s.started {
+		s.mu.Unlock()
+
+		return wrapError(errorkinds.ErrSessionStop,
+			"session-stop", bluetooth.MacAddress{},
+			"Simulated session is not started",
+		)
+	}
+
+	s.publishState(bluetooth.SessionStopping, ac.MergedFeatureSet())
+
+	manager := s.reconnect
+	s.cancel()
+	s.started = false
+
+	s.emitter.CloseSubscriptions()
+	s.mu.Unlock()
+
+	// The pending reconnections lock the session, so the reconnection
+	// manager is stopped after the session is unlocked.
+	manager.Stop()
+
+	return nil
+}
+
+// Events returns the event emitter which the session publishes its events to.
+func (s *Session) Events() *eventbus.Emitter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.emitter == nil {
+		return eventbus.DefaultEmitter()
+	}
+
+	return s.emitter
+}
+
+// Adapters returns a list of known adapters.
+func (s *Session) Adapters() []bluetooth.AdapterData {
+	if !s.isStarted() {
+		return nil
+	}
+
+	return s.store.Adapters()
+}
+
+// DefaultAdapter returns the default adapter.
+func (s *Session) DefaultAdapter() (bluetooth.AdapterData, error) {
+	if !s.isStarted() {
+		return bluetooth.AdapterData{}, wrapError(errorkinds.ErrAdapterNotFound,
+			"session-default-adapter", bluetooth.MacAddress{},
+			"Simulated session is not started",
+		)
+	}
+
+	adapter, err := s.store.DefaultAdapter(s.defaultAdapter)
+	if err != nil {
+		return adapter, wrapError(err,
+			"session-default-adapter", bluetooth.MacAddress{},
+			"No adapters exist",
+		)
+	}
+
+	return adapter, nil
+}
+
+// Adapter returns a function call interface to invoke adapter related functions.
+func (s *Session) Adapter(adapterAddress bluetooth.MacAddress) bluetooth.Adapter {
+	return &adapter{s: s, Address: adapterAddress}
+}
+
+// Device returns a function call interface to invoke device related functions.
+func (s *Session) Device(deviceAddress bluetooth.MacAddress) bluetooth.Device {
+	return &device{s: s, Address: deviceAddress}
+}
+
+// reconnectDevice reconnects to a device on behalf of the reconnection manager.
+func (s *Session) reconnectDevice(
+	ctx context.Context, deviceAddress bluetooth.MacAddress, policy bluetooth.ConnectRetryPolicy,
+) error {
+	d := &device{s: s, Address: deviceAddress, ctx: ctx}
+
+	return d.connectWithRetry(policy)
+}
+
+// Obex returns a function call interface to invoke obex related functions.
+func (s *Session) Obex(deviceAddress bluetooth.MacAddress) bluetooth.Obex {
+	return &obex{s: s, Address: deviceAddress}
+}
+
+// Network returns a function call interface to invoke network related functions.
+func (s *Session) Network(deviceAddress bluetooth.MacAddress) bluetooth.Network {
+	return &network{s: s, Address: deviceAddress}
+}
+
+// MediaPlayer returns a function call interface to invoke media player/control
+// related functions on a device.
+func (s *Session) MediaPlayer(deviceAddress bluetooth.MacAddress) bluetooth.MediaPlayer {
+	return &mediaPlayer{s: s, Address: deviceAddress}
+}
+
+// isStarted returns whether the session has been started.
+func (s *Session) isStarted() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.started
+}
+
+// wait waits for the configured operation delay to pass, or returns
+// an error if the context or the session is cancelled before that.
+func (s *Session) wait(ctx context.Context) error {
+	timer := time.NewTimer(s.cfg.OperationDelay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return errorkinds.ErrMethodCanceled
+
+	case <-s.ctx.Done():
+		return errorkinds.ErrMethodCanceled
+
+	case <-timer.C:
+	}
+
+	return nil
+}
+
+// publishState publishes a session event with the provided state and features.
+func (s *Session) publishState(state bluetooth.SessionState, features ac.FeatureSet) {
+	bluetooth.SessionEvent().On(s.emitter).Publish(bluetooth.SessionEventData{
+		State:    state,
+		Features: features,
+	})
+}
+
+// newDeviceData returns the device data for a device configuration.
+func newDeviceData(deviceConfig DeviceConfig) bluetooth.DeviceData {
+	device := deviceConfig.DeviceData
+	if device.Type == "" {
+		device.Type = bluetooth.ResolveDeviceType(device.Appearance, device.Class, device.UUIDs)
+		device.ClassInfo = bluetooth.DecodeDeviceClass(device.Class)
+	}
+
+	if device.AddressType == "" {
+		device.AddressType = bluetooth.AddressTypePublic
+	}
+
+	device.ServicesResolved = device.Connected
+
+	return device
+}
+
+// refreshDefaultAdapter chooses the default adapter again, and publishes
+// a default adapter event if the default adapter has changed.
+func (s *Session) refreshDefaultAdapter() {
+	if data, changed := s.store.RefreshDefaultAdapter(s.defaultAdapter); changed {
+		bluetooth.DefaultAdapterEvent().On(s.emitter).Publish(data)
+	}
+}
+
+// wrapError wraps an error with the call site and address metadata.
+func wrapError(err error, errorAt string, address bluetooth.MacAddress, message string) error {
+	return fault.Wrap(err,
+		fctx.With(context.Background(),
+			"error_at", errorAt,
+			"address", address.String(),
+		),
+		ftag.With(ftag.Internal),
+		fmsg.With(message),
+	)
+}
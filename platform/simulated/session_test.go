@@ -0,0 +1,290 @@
+package simulated
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/config"
+	"github.com/bluetuith-org/api-native/api/eventbus"
+)
+
+// eventTimeout is the maximum duration to wait for an event.
+const eventTimeout = 5 * time.Second
+
+// The addresses of the default configuration's adapter and devices.
+var (
+	testAdapterAddress    = mustParseMAC("00:1A:7D:DA:71:01")
+	testHeadphonesAddress = mustParseMAC("2C:41:A1:49:37:CF")
+	testPhoneAddress      = mustParseMAC("F4:0E:22:8B:10:42")
+)
+
+// testAuthorizer describes an authorization handler which records
+// the passkey confirmation requests, and accepts them.
+type testAuthorizer struct {
+	bluetooth.DefaultAuthorizer
+
+	address bluetooth.MacAddress
+	passkey uint32
+	mu      sync.Mutex
+}
+
+// ConfirmPasskey records the passkey confirmation request.
+func (a *testAuthorizer) ConfirmPasskey(_ bluetooth.AuthTimeout, address bluetooth.MacAddress, passkey uint32) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.address, a.passkey = address, passkey
+
+	return nil
+}
+
+// startTestSession starts a simulated session with the default configuration,
+// whose operations complete quickly. The session is stopped when the test ends.
+func startTestSession(t *testing.T, authHandler bluetooth.SessionAuthorizer, modifyfn func(*Config)) *Session {
+	t.Helper()
+
+	cfg := DefaultConfig()
+	cfg.DiscoveryInterval = 10 * time.Millisecond
+	cfg.OperationDelay = 10 * time.Millisecond
+
+	if modifyfn != nil {
+		modifyfn(&cfg)
+	}
+
+	sessionCfg := config.New()
+	sessionCfg.EventEmitter = eventbus.NewEmitter(nil)
+
+	session := NewSession(cfg)
+	if _, err := session.Start(authHandler, sessionCfg); err != nil {
+		t.Fatalf("Cannot start session: %v", err)
+	}
+	t.Cleanup(func() { _ = session.Stop() })
+
+	return session
+}
+
+// waitEvent waits for an event which matches the provided function.
+func waitEvent[T bluetooth.Events](t *testing.T, sub bluetooth.Subscriber[T], match func(bluetooth.Event[T]) bool) bluetooth.Event[T] {
+	t.Helper()
+
+	timeout := time.After(eventTimeout)
+
+	for {
+		select {
+		case ev, ok := <-sub.C:
+			if !ok {
+				t.Fatal("Event subscription was closed")
+			}
+
+			if match(ev) {
+				return ev
+			}
+
+		case <-timeout:
+			t.Fatal("Timed out waiting for event")
+		}
+	}
+}
+
+// discoverDevices discovers devices until the provided number of devices are found,
+// and returns the discovery events. The discovery is stopped before returning.
+func discoverDevices(t *testing.T, session *Session, count int) []bluetooth.DiscoveryEvent {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := session.Adapter(testAdapterAddress).Discover(ctx, bluetooth.DiscoveryOptions{})
+	if err != nil {
+		t.Fatalf("Discover() returned error: %v", err)
+	}
+
+	var received []bluetooth.DiscoveryEvent
+	found := make(map[bluetooth.MacAddress]struct{})
+	timeout := time.After(eventTimeout)
+
+	for len(found) < count {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				t.Fatal("Discovery events channel was closed")
+			}
+
+			received = append(received, ev)
+			found[ev.Device.Address] = struct{}{}
+
+		case <-timeout:
+			t.Fatalf("Timed out after discovering %d of %d devices", len(found), count)
+		}
+	}
+
+	cancel()
+	for range events {
+	}
+
+	return received
+}
+
+func TestDiscover(t *testing.T) {
+	session := startTestSession(t, nil, nil)
+
+	sub := bluetooth.DeviceEvent(bluetooth.EventActionAdded).On(session.Events()).Subscribe()
+	defer sub.Unsubscribe()
+
+	discoverable := session.cfg.Adapters[0].Discoverable
+	events := discoverDevices(t, session, len(discoverable))
+
+	seen := make(map[bluetooth.MacAddress]bool)
+	for _, ev := range events {
+		if ev.Device.Address == testHeadphonesAddress {
+			t.Errorf("Known device %s was reported by the discovery", ev.Device.Address)
+		}
+
+		wantAction := bluetooth.EventActionUpdated
+		if !seen[ev.Device.Address] {
+			wantAction = bluetooth.EventActionAdded
+		}
+		seen[ev.Device.Address] = true
+
+		if ev.Action != wantAction {
+			t.Errorf("Discovery event for %s has action %q, want %q", ev.Device.Address, ev.Action, wantAction)
+		}
+	}
+
+	for _, deviceConfig := range discoverable {
+		ev := waitEvent(t, sub, func(ev bluetooth.Event[bluetooth.DeviceEventData]) bool {
+			return ev.Data.Address == deviceConfig.Address
+		})
+
+		if ev.Data.AssociatedAdapter != testAdapterAddress {
+			t.Errorf("Added device %s is associated with %s, want %s",
+				ev.Data.Address, ev.Data.AssociatedAdapter, testAdapterAddress,
+			)
+		}
+	}
+
+	devices, err := session.Adapter(testAdapterAddress).Devices()
+	if err != nil {
+		t.Fatalf("Devices() returned error: %v", err)
+	}
+
+	if want := len(discoverable) + 1; len(devices) != want {
+		t.Errorf("Devices() returned %d devices, want %d", len(devices), want)
+	}
+}
+
+func TestPairConnectDisconnect(t *testing.T) {
+	authorizer := &testAuthorizer{}
+	session := startTestSession(t, authorizer, func(cfg *Config) {
+		cfg.Adapters[0].Discoverable = cfg.Adapters[0].Discoverable[:1]
+	})
+
+	sub := bluetooth.DeviceEvent().On(session.Events()).Subscribe()
+	defer sub.Unsubscribe()
+
+	discoverDevices(t, session, 1)
+
+	// The state of the phone is tracked from its events, so that the
+	// order in which its state changes can be checked.
+	var state bluetooth.DeviceEventData
+	var transitions []string
+
+	waitState := func(match func(bluetooth.DeviceEventData) bool) {
+		t.Helper()
+
+		waitEvent(t, sub, func(ev bluetooth.Event[bluetooth.DeviceEventData]) bool {
+			if ev.Data.Address != testPhoneAddress {
+				return false
+			}
+
+			switch {
+			case ev.Action == bluetooth.EventActionAdded:
+				transitions = append(transitions, "added")
+
+			case ev.Data.Paired != state.Paired:
+				transitions = append(transitions, "paired")
+
+			case ev.Data.Connected != state.Connected && ev.Data.Connected:
+				transitions = append(transitions, "connected")
+
+			case ev.Data.Connected != state.Connected:
+				transitions = append(transitions, "disconnected")
+			}
+
+			state = ev.Data
+
+			return match(ev.Data)
+		})
+	}
+
+	waitState(func(bluetooth.DeviceEventData) bool { return true })
+
+	phone := session.Device(testPhoneAddress)
+	if err := phone.Pair(); err != nil {
+		t.Fatalf("Pair() returned error: %v", err)
+	}
+	waitState(func(device bluetooth.DeviceEventData) bool { return device.Paired })
+
+	authorizer.mu.Lock()
+	if authorizer.address != testPhoneAddress || authorizer.passkey != 482915 {
+		t.Errorf("ConfirmPasskey was called with %s and %d, want %s and 482915",
+			authorizer.address, authorizer.passkey, testPhoneAddress,
+		)
+	}
+	authorizer.mu.Unlock()
+
+	if err := phone.Connect(); err != nil {
+		t.Fatalf("Connect() returned error: %v", err)
+	}
+	waitState(func(device bluetooth.DeviceEventData) bool { return device.Connected })
+
+	if properties, err := phone.Properties(); err != nil || !properties.Connected || !properties.Paired {
+		t.Errorf("Properties() = %+v, %v, want a paired and connected device", properties.DeviceEventData, err)
+	}
+
+	if err := phone.Disconnect(); err != nil {
+		t.Fatalf("Disconnect() returned error: %v", err)
+	}
+	waitState(func(device bluetooth.DeviceEventData) bool { return !device.Connected })
+
+	want := []string{"added", "paired", "connected", "disconnected"}
+	if len(transitions) != len(want) {
+		t.Fatalf("Device state transitions = %v, want %v", transitions, want)
+	}
+
+	for i := range want {
+		if transitions[i] != want[i] {
+			t.Fatalf("Device state transitions = %v, want %v", transitions, want)
+		}
+	}
+
+	if err := phone.Disconnect(); err == nil {
+		t.Error("Disconnect() of a disconnected device returned no error")
+	}
+}
+
+func TestConnectWithRetry(t *testing.T) {
+	session := startTestSession(t, nil, func(cfg *Config) {
+		cfg.Adapters[0].Devices[0].ConnectFailures = 2
+	})
+
+	sub := bluetooth.ConnectAttemptEvent().On(session.Events()).Subscribe()
+	defer sub.Unsubscribe()
+
+	policy := bluetooth.ConnectRetryPolicy{MaxAttempts: 3, InitialDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	if err := session.Device(testHeadphonesAddress).ConnectWithRetry(policy); err != nil {
+		t.Fatalf("ConnectWithRetry() returned error: %v", err)
+	}
+
+	for attempt := 1; attempt <= 3; attempt++ {
+		ev := waitEvent(t, sub, func(bluetooth.Event[bluetooth.ConnectAttemptEventData]) bool { return true })
+
+		connected := attempt == 3
+		if ev.Data.Attempt != attempt || ev.Data.Connected != connected || ev.Data.Retrying == connected {
+			t.Errorf("Connect attempt event = %+v, want attempt %d (connected: %v)", ev.Data, attempt, connected)
+		}
+	}
+}
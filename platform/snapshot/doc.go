@@ -0,0 +1,12 @@
+/*
+Package snapshot provides an API to export the state of a Bluetooth session
+into a versioned JSON document, and a read-only session which replays an
+exported state.
+
+A snapshot holds all known adapters and devices (including their battery
+and media player states), the platform information and the supported
+features of the session. It is intended to be attached to bug reports,
+so that the state of a session can be inspected or replayed in an application
+without the original Bluetooth hardware.
+*/
+package snapshot
@@ -0,0 +1,372 @@
+package snapshot
+
+import (
+	"context"
+
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/google/uuid"
+)
+
+// adapter describes a function call interface to invoke adapter related functions.
+type adapter struct {
+	s *Session
+
+	Address bluetooth.MacAddress
+}
+
+// device describes a function call interface to invoke device related functions.
+type device struct {
+	s *Session
+
+	Address bluetooth.MacAddress
+}
+
+// obex describes a function call interface to invoke obex related functions.
+type obex struct {
+	s *Session
+
+	Address bluetooth.MacAddress
+}
+
+// fileTransfer describes a function call interface to invoke file transfer related functions.
+type fileTransfer obex
+
+// network describes a function call interface to invoke network related functions.
+type network struct {
+	s *Session
+
+	Address bluetooth.MacAddress
+}
+
+// mediaPlayer describes a function call interface to invoke media player related functions.
+type mediaPlayer struct {
+	s *Session
+
+	Address bluetooth.MacAddress
+}
+
+// WithContext returns the adapter function call interface as is, since
+// no method call of a snapshot session can block.
+func (a *adapter) WithContext(context.Context) bluetooth.Adapter {
+	return a
+}
+
+// StartDiscovery returns an error, since the session is read-only.
+func (a *adapter) StartDiscovery() error {
+	return readOnly("snapshot-adapter-startdiscovery", a.Address)
+}
+
+// StopDiscovery returns an error, since the session is read-only.
+func (a *adapter) StopDiscovery() error {
+	return readOnly("snapshot-adapter-stopdiscovery", a.Address)
+}
+
+// Discover returns an error, since the session is read-only.
+func (a *adapter) Discover(context.Context, bluetooth.DiscoveryOptions) (<-chan bluetooth.DiscoveryEvent, error) {
+	return nil, readOnly("snapshot-adapter-discover", a.Address)
+}
+
+// SetDiscoveryFilter returns an error, since the session is read-only.
+func (a *adapter) SetDiscoveryFilter(bluetooth.DiscoveryFilter) error {
+	return readOnly("snapshot-adapter-setdiscoveryfilter", a.Address)
+}
+
+// DiscoveryFilters returns no discovery filter parameters, since
+// discovery cannot be started within a read-only session.
+func (a *adapter) DiscoveryFilters() ([]string, error) {
+	if err := a.s.check("snapshot-adapter-discoveryfilters", a.Address); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// SetPoweredState returns an error, since the session is read-only.
+func (a *adapter) SetPoweredState(bool) error {
+	return readOnly("snapshot-adapter-setpowered", a.Address)
+}
+
+// SetDiscoverableState returns an error, since the session is read-only.
+func (a *adapter) SetDiscoverableState(bool) error {
+	return readOnly("snapshot-adapter-setdiscoverable", a.Address)
+}
+
+// SetPairableState returns an error, since the session is read-only.
+func (a *adapter) SetPairableState(bool) error {
+	return readOnly("snapshot-adapter-setpairable", a.Address)
+}
+
+// ConnectDevice returns an error, since the session is read-only.
+func (a *adapter) ConnectDevice(bluetooth.MacAddress, bluetooth.AddressType) error {
+	return readOnly("snapshot-adapter-connect-device", a.Address)
+}
+
+// PruneDevices returns an error, since the session is read-only.
+func (a *adapter) PruneDevices(opts bluetooth.PruneOptions) (bluetooth.PruneResult, error) {
+	return bluetooth.PruneResult{Address: a.Address, DryRun: opts.DryRun},
+		readOnly("snapshot-adapter-prune-devices", a.Address)
+}
+
+// UnblockAndPowerOn returns an error, since the session is read-only.
+func (a *adapter) UnblockAndPowerOn() error {
+	return readOnly("snapshot-adapter-unblock", a.Address)
+}
+
+// SetAlias returns an error, since the session is read-only.
+func (a *adapter) SetAlias(string) error {
+	return readOnly("snapshot-adapter-setalias", a.Address)
+}
+
+// SetDiscoverableTimeout returns an error, since the session is read-only.
+func (a *adapter) SetDiscoverableTimeout(uint32) error {
+	return readOnly("snapshot-adapter-setdiscoverable-timeout", a.Address)
+}
+
+// SetPairableTimeout returns an error, since the session is read-only.
+func (a *adapter) SetPairableTimeout(uint32) error {
+	return readOnly("snapshot-adapter-setpairable-timeout", a.Address)
+}
+
+// Properties returns the properties of the adapter.
+func (a *adapter) Properties() (bluetooth.AdapterData, error) {
+	if err := a.s.check("snapshot-adapter-properties", a.Address); err != nil {
+		return bluetooth.AdapterData{}, err
+	}
+
+	adapter, err := a.s.store.Adapter(a.Address)
+	if err != nil {
+		return bluetooth.AdapterData{}, wrapError(err,
+			"snapshot-adapter-properties", a.Address,
+			"Adapter does not exist",
+		)
+	}
+
+	return adapter, nil
+}
+
+// Devices returns the devices which are associated with the adapter.
+func (a *adapter) Devices() ([]bluetooth.DeviceData, error) {
+	if err := a.s.check("snapshot-adapter-devices", a.Address); err != nil {
+		return nil, err
+	}
+
+	devices, err := a.s.store.AdapterDevices(a.Address)
+	if err != nil {
+		return nil, wrapError(err,
+			"snapshot-adapter-devices", a.Address,
+			"Adapter does not exist",
+		)
+	}
+
+	return devices, nil
+}
+
+// WithContext returns the device function call interface as is, since
+// no method call of a snapshot session can block.
+func (d *device) WithContext(context.Context) bluetooth.Device {
+	return d
+}
+
+// Pair returns an error, since the session is read-only.
+func (d *device) Pair() error {
+	return readOnly("snapshot-device-pair", d.Address)
+}
+
+// CancelPairing returns an error, since the session is read-only.
+func (d *device) CancelPairing() error {
+	return readOnly("snapshot-device-cancelpairing", d.Address)
+}
+
+// Connect returns an error, since the session is read-only.
+func (d *device) Connect() error {
+	return readOnly("snapshot-device-connect", d.Address)
+}
+
+// ConnectWithRetry returns an error, since the session is read-only.
+func (d *device) ConnectWithRetry(bluetooth.ConnectRetryPolicy) error {
+	return readOnly("snapshot-device-connectwithretry", d.Address)
+}
+
+// Disconnect returns an error, since the session is read-only.
+func (d *device) Disconnect() error {
+	return readOnly("snapshot-device-disconnect", d.Address)
+}
+
+// ConnectProfile returns an error, since the session is read-only.
+func (d *device) ConnectProfile(uuid.UUID) error {
+	return readOnly("snapshot-device-connectprofile", d.Address)
+}
+
+// DisconnectProfile returns an error, since the session is read-only.
+func (d *device) DisconnectProfile(uuid.UUID) error {
+	return readOnly("snapshot-device-disconnectprofile", d.Address)
+}
+
+// Remove returns an error, since the session is read-only.
+func (d *device) Remove() error {
+	return readOnly("snapshot-device-remove", d.Address)
+}
+
+// SetTrusted returns an error, since the session is read-only.
+func (d *device) SetTrusted(bool) error {
+	return readOnly("snapshot-device-settrusted", d.Address)
+}
+
+// SetBlocked returns an error, since the session is read-only.
+func (d *device) SetBlocked(bool) error {
+	return readOnly("snapshot-device-setblocked", d.Address)
+}
+
+// SetAlias returns an error, since the session is read-only.
+func (d *device) SetAlias(string) error {
+	return readOnly("snapshot-device-setalias", d.Address)
+}
+
+// SetWakeAllowed returns an error, since the session is read-only.
+func (d *device) SetWakeAllowed(bool) error {
+	return readOnly("snapshot-device-setwakeallowed", d.Address)
+}
+
+// SetAutoReconnect returns an error, since the session is read-only.
+func (d *device) SetAutoReconnect(bool) error {
+	return readOnly("snapshot-device-setautoreconnect", d.Address)
+}
+
+// Setup returns an error, since the session is read-only.
+func (d *device) Setup(context.Context, bluetooth.SetupOptions) (bluetooth.SetupResult, error) {
+	return bluetooth.SetupResult{Address: d.Address}, readOnly("snapshot-device-setup", d.Address)
+}
+
+// Properties returns the properties of the device.
+func (d *device) Properties() (bluetooth.DeviceData, error) {
+	if err := d.s.check("snapshot-device-properties", d.Address); err != nil {
+		return bluetooth.DeviceData{}, err
+	}
+
+	device, err := d.s.store.Device(d.Address)
+	if err != nil {
+		return bluetooth.DeviceData{}, wrapError(err,
+			"snapshot-device-properties", d.Address,
+			"Device does not exist",
+		)
+	}
+
+	return device, nil
+}
+
+// FileTransfer returns a function call interface to invoke device file transfer
+// related functions.
+func (o *obex) FileTransfer() bluetooth.ObexFileTransfer {
+	return &fileTransfer{s: o.s, Address: o.Address}
+}
+
+// CreateSession returns an error, since the session is read-only.
+func (o *fileTransfer) CreateSession(context.Context) error {
+	return readOnly("snapshot-obex-createsession", o.Address)
+}
+
+// RemoveSession returns an error, since the session is read-only.
+func (o *fileTransfer) RemoveSession() error {
+	return readOnly("snapshot-obex-removesession", o.Address)
+}
+
+// SendFile returns an error, since the session is read-only.
+func (o *fileTransfer) SendFile(string) (bluetooth.FileTransferData, error) {
+	return bluetooth.FileTransferData{}, readOnly("snapshot-obex-sendfile", o.Address)
+}
+
+// CancelTransfer returns an error, since the session is read-only.
+func (o *fileTransfer) CancelTransfer() error {
+	return readOnly("snapshot-obex-canceltransfer", o.Address)
+}
+
+// SuspendTransfer returns an error, since the session is read-only.
+func (o *fileTransfer) SuspendTransfer() error {
+	return readOnly("snapshot-obex-suspendtransfer", o.Address)
+}
+
+// ResumeTransfer returns an error, since the session is read-only.
+func (o *fileTransfer) ResumeTransfer() error {
+	return readOnly("snapshot-obex-resumetransfer", o.Address)
+}
+
+// WithContext returns the network function call interface as is, since
+// no method call of a snapshot session can block.
+func (n *network) WithContext(context.Context) bluetooth.Network {
+	return n
+}
+
+// Connect returns an error, since the session is read-only.
+func (n *network) Connect(string, bluetooth.NetworkType) error {
+	return readOnly("snapshot-network-connect", n.Address)
+}
+
+// Disconnect returns an error, since the session is read-only.
+func (n *network) Disconnect() error {
+	return readOnly("snapshot-network-disconnect", n.Address)
+}
+
+// WithContext returns the media player function call interface as is, since
+// no method call of a snapshot session can block.
+func (m *mediaPlayer) WithContext(context.Context) bluetooth.MediaPlayer {
+	return m
+}
+
+// Properties returns the media player properties of the device,
+// as they were recorded in the snapshot.
+func (m *mediaPlayer) Properties() (bluetooth.MediaData, error) {
+	if err := m.s.check("snapshot-mediaplayer-properties", m.Address); err != nil {
+		return bluetooth.MediaData{}, err
+	}
+
+	media, ok := m.s.media[m.Address]
+	if !ok {
+		return bluetooth.MediaData{}, wrapError(errorkinds.ErrMediaPlayerNotConnected,
+			"snapshot-mediaplayer-properties", m.Address,
+			"Media player is not connected",
+		)
+	}
+
+	return media, nil
+}
+
+// Play returns an error, since the session is read-only.
+func (m *mediaPlayer) Play() error {
+	return readOnly("snapshot-mediaplayer-play", m.Address)
+}
+
+// Pause returns an error, since the session is read-only.
+func (m *mediaPlayer) Pause() error {
+	return readOnly("snapshot-mediaplayer-pause", m.Address)
+}
+
+// TogglePlayPause returns an error, since the session is read-only.
+func (m *mediaPlayer) TogglePlayPause() error {
+	return readOnly("snapshot-mediaplayer-toggleplaypause", m.Address)
+}
+
+// Next returns an error, since the session is read-only.
+func (m *mediaPlayer) Next() error {
+	return readOnly("snapshot-mediaplayer-next", m.Address)
+}
+
+// Previous returns an error, since the session is read-only.
+func (m *mediaPlayer) Previous() error {
+	return readOnly("snapshot-mediaplayer-previous", m.Address)
+}
+
+// FastForward returns an error, since the session is read-only.
+func (m *mediaPlayer) FastForward() error {
+	return readOnly("snapshot-mediaplayer-fastforward", m.Address)
+}
+
+// Rewind returns an error, since the session is read-only.
+func (m *mediaPlayer) Rewind() error {
+	return readOnly("snapshot-mediaplayer-rewind", m.Address)
+}
+
+// Stop returns an error, since the session is read-only.
+func (m *mediaPlayer) Stop() error {
+	return readOnly("snapshot-mediaplayer-stop", m.Address)
+}
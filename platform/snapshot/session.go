@@ -0,0 +1,217 @@
+package snapshot
+
+import (
+	"sync"
+
+	ac "github.com/bluetuith-org/api-native/api/appfeatures"
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/config"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/api/eventbus"
+	sstore "github.com/bluetuith-org/api-native/api/helpers/sessionstore"
+	"github.com/bluetuith-org/api-native/platform"
+)
+
+// Session describes a read-only Bluetooth session, which replays the state
+// of an exported snapshot. All methods which would modify the state of an adapter
+// or a device return an error wrapping errorkinds.ErrSessionReadOnly.
+type Session struct {
+	snapshot       Snapshot
+	emitter        *eventbus.Emitter
+	defaultAdapter string
+
+	store sstore.SessionStore
+	media map[bluetooth.MacAddress]bluetooth.MediaData
+
+	started bool
+	mu      sync.Mutex
+}
+
+// NewSession returns a new read-only session which replays the provided snapshot.
+func NewSession(snapshot Snapshot) *Session {
+	return &Session{snapshot: snapshot}
+}
+
+// Start loads the adapters and devices of the snapshot into the session.
+// The authorization handler is ignored, since no authorization requests
+// can occur within a read-only session.
+func (s *Session) Start(_ bluetooth.SessionAuthorizer, cfg config.Configuration) (ac.FeatureSet, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return ac.NilFeatureSet(), wrapError(errorkinds.ErrSessionStart,
+			"snapshot-session-start", bluetooth.MacAddress{},
+			"Snapshot session is already started",
+		)
+	}
+
+	s.emitter = cfg.EventEmitter
+	if s.emitter == nil {
+		s.emitter = eventbus.DefaultEmitter()
+	}
+	s.emitter = s.emitter.Scope()
+
+	s.publishState(bluetooth.SessionStarting, ac.NilFeatureSet())
+
+	s.defaultAdapter = cfg.DefaultAdapter
+	s.store = sstore.NewSessionStore()
+	s.media = make(map[bluetooth.MacAddress]bluetooth.MediaData)
+
+	for _, adapter := range s.snapshot.Adapters {
+		s.store.AddAdapter(adapter.AdapterData)
+
+		for _, device := range adapter.Devices {
+			device.AssociatedAdapter = adapter.Address
+			s.store.AddDevice(device.DeviceData)
+
+			if device.Media != nil {
+				s.media[device.Address] = *device.Media
+			}
+		}
+	}
+
+	features := s.snapshot.Features.FeatureSet()
+
+	s.started = true
+	s.publishState(bluetooth.SessionReady, features)
+
+	return features, nil
+}
+
+// Stop stops the session, and closes all subscriptions
+// which were made via the session's event emitter (see Events).
+func (s *Session) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.started {
+		return wrapError(errorkinds.ErrSessionStop,
+			"snapshot-session-stop", bluetooth.MacAddress{},
+			"Snapshot session is not started",
+		)
+	}
+
+	s.publishState(bluetooth.SessionStopping, s.snapshot.Features.FeatureSet())
+
+	s.started = false
+	s.store.Clear()
+	s.emitter.CloseSubscriptions()
+
+	return nil
+}
+
+// Events returns the event emitter which the session publishes its events to.
+func (s *Session) Events() *eventbus.Emitter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.emitter == nil {
+		return eventbus.DefaultEmitter()
+	}
+
+	return s.emitter
+}
+
+// Snapshot returns the snapshot which the session replays.
+func (s *Session) Snapshot() Snapshot {
+	return s.snapshot
+}
+
+// PlatformInfo returns the platform information of the snapshot.
+func (s *Session) PlatformInfo() platform.PlatformInfo {
+	return s.snapshot.Platform
+}
+
+// Adapters returns a list of known adapters.
+func (s *Session) Adapters() []bluetooth.AdapterData {
+	if !s.isStarted() {
+		return nil
+	}
+
+	return s.store.Adapters()
+}
+
+// DefaultAdapter returns the default adapter of the snapshot.
+// Since the snapshot does not change, no default adapter events are published.
+func (s *Session) DefaultAdapter() (bluetooth.AdapterData, error) {
+	if !s.isStarted() {
+		return bluetooth.AdapterData{}, wrapError(errorkinds.ErrAdapterNotFound,
+			"snapshot-default-adapter", bluetooth.MacAddress{},
+			"Snapshot session is not started",
+		)
+	}
+
+	adapter, err := s.store.DefaultAdapter(s.defaultAdapter)
+	if err != nil {
+		return adapter, wrapError(err,
+			"snapshot-default-adapter", bluetooth.MacAddress{},
+			"No adapters exist",
+		)
+	}
+
+	return adapter, nil
+}
+
+// Adapter returns a function call interface to invoke adapter related functions.
+func (s *Session) Adapter(adapterAddress bluetooth.MacAddress) bluetooth.Adapter {
+	return &adapter{s: s, Address: adapterAddress}
+}
+
+// Device returns a function call interface to invoke device related functions.
+func (s *Session) Device(deviceAddress bluetooth.MacAddress) bluetooth.Device {
+	return &device{s: s, Address: deviceAddress}
+}
+
+// Obex returns a function call interface to invoke obex related functions.
+func (s *Session) Obex(deviceAddress bluetooth.MacAddress) bluetooth.Obex {
+	return &obex{s: s, Address: deviceAddress}
+}
+
+// Network returns a function call interface to invoke network related functions.
+func (s *Session) Network(deviceAddress bluetooth.MacAddress) bluetooth.Network {
+	return &network{s: s, Address: deviceAddress}
+}
+
+// MediaPlayer returns a function call interface to invoke media player/control
+// related functions on a device.
+func (s *Session) MediaPlayer(deviceAddress bluetooth.MacAddress) bluetooth.MediaPlayer {
+	return &mediaPlayer{s: s, Address: deviceAddress}
+}
+
+// isStarted returns whether the session has been started.
+func (s *Session) isStarted() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.started
+}
+
+// check checks whether the session has been started.
+func (s *Session) check(errorAt string, address bluetooth.MacAddress) error {
+	if !s.isStarted() {
+		return wrapError(errorkinds.ErrMethodCall,
+			errorAt, address,
+			"Snapshot session is not started",
+		)
+	}
+
+	return nil
+}
+
+// publishState publishes a session event with the provided state and features.
+func (s *Session) publishState(state bluetooth.SessionState, features ac.FeatureSet) {
+	bluetooth.SessionEvent().On(s.emitter).Publish(bluetooth.SessionEventData{
+		State:    state,
+		Features: features,
+	})
+}
+
+// readOnly returns an error which indicates that a method cannot be called
+// on a read-only session.
+func readOnly(errorAt string, address bluetooth.MacAddress) error {
+	return wrapError(errorkinds.ErrSessionReadOnly,
+		errorAt, address,
+		"Cannot modify a snapshot session",
+	)
+}
@@ -0,0 +1,199 @@
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	ac "github.com/bluetuith-org/api-native/api/appfeatures"
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/platform"
+)
+
+// Version is the current version of the snapshot document format.
+const Version = 1
+
+// Snapshot describes the exported state of a Bluetooth session.
+type Snapshot struct {
+	// Version holds the version of the snapshot document format.
+	Version int `json:"version"`
+
+	// CreatedAt holds the time at which the snapshot was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Platform holds the platform information of the session.
+	Platform platform.PlatformInfo `json:"platform"`
+
+	// Features holds the supported features of the session.
+	Features Features `json:"features"`
+
+	// Adapters holds the adapters of the session, along with their devices.
+	Adapters []Adapter `json:"adapters,omitempty"`
+}
+
+// Features holds the supported features of a session,
+// and the errors of features that could not be activated.
+type Features struct {
+	// Supported holds the supported features.
+	Supported ac.Features `json:"supported"`
+
+	// Errors holds the errors of features that could not be activated.
+	Errors []FeatureError `json:"errors,omitempty"`
+}
+
+// FeatureError holds an error of a feature that could not be activated.
+type FeatureError struct {
+	// Feature holds the features that could not be activated.
+	Feature ac.Features `json:"feature"`
+
+	// Error holds the error message.
+	Error string `json:"error"`
+}
+
+// Adapter holds the exported state of an adapter.
+type Adapter struct {
+	bluetooth.AdapterData
+
+	// Devices holds the devices which are associated with the adapter.
+	Devices []Device `json:"devices,omitempty"`
+}
+
+// Device holds the exported state of a device.
+type Device struct {
+	bluetooth.DeviceData
+
+	// Media holds the media player state of the device, if a media player is connected.
+	Media *bluetooth.MediaData `json:"media,omitempty"`
+}
+
+// Capture exports the current state of the provided session. The platform information and
+// the features of the session (which are returned when the session is started) are included
+// in the snapshot as is.
+func Capture(session bluetooth.Session, info platform.PlatformInfo, features ac.FeatureSet) (Snapshot, error) {
+	snapshot := Snapshot{
+		Version:   Version,
+		CreatedAt: time.Now().UTC(),
+		Platform:  info,
+		Features:  exportFeatures(features),
+	}
+
+	for _, adapterData := range session.Adapters() {
+		devices, err := session.Adapter(adapterData.Address).Devices()
+		if err != nil {
+			return Snapshot{}, wrapError(err,
+				"snapshot-capture-devices", adapterData.Address,
+				"Cannot fetch adapter devices",
+			)
+		}
+
+		adapter := Adapter{
+			AdapterData: adapterData,
+			Devices:     make([]Device, 0, len(devices)),
+		}
+
+		for _, deviceData := range devices {
+			device := Device{DeviceData: deviceData}
+
+			if deviceData.Connected {
+				if media, err := session.MediaPlayer(deviceData.Address).Properties(); err == nil {
+					device.Media = &media
+				}
+			}
+
+			adapter.Devices = append(adapter.Devices, device)
+		}
+
+		snapshot.Adapters = append(snapshot.Adapters, adapter)
+	}
+
+	return snapshot, nil
+}
+
+// Encode writes the snapshot as an indented JSON document to the provided writer.
+func (s Snapshot) Encode(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(s); err != nil {
+		return wrapError(err,
+			"snapshot-encode", bluetooth.MacAddress{},
+			"Cannot encode snapshot",
+		)
+	}
+
+	return nil
+}
+
+// Decode reads a snapshot JSON document from the provided reader.
+// Snapshots with a newer document version than the current version are rejected.
+func Decode(r io.Reader) (Snapshot, error) {
+	var snapshot Snapshot
+
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return Snapshot{}, wrapError(err,
+			"snapshot-decode", bluetooth.MacAddress{},
+			"Cannot decode snapshot",
+		)
+	}
+
+	if snapshot.Version <= 0 || snapshot.Version > Version {
+		return Snapshot{}, wrapError(
+			fmt.Errorf("unsupported snapshot version %d", snapshot.Version),
+			"snapshot-decode-version", bluetooth.MacAddress{},
+			"Cannot decode snapshot",
+		)
+	}
+
+	return snapshot, nil
+}
+
+// FeatureSet returns the features of the snapshot as a feature set.
+func (f Features) FeatureSet() ac.FeatureSet {
+	var ce ac.Errors
+
+	for _, e := range f.Errors {
+		ce.Append(ac.NewError(e.Feature, errors.New(e.Error)))
+	}
+
+	return ac.NewFeatureSet(f.Supported, ce)
+}
+
+// exportFeatures converts a feature set to its exportable form.
+func exportFeatures(features ac.FeatureSet) Features {
+	exported := Features{Supported: features.Supported}
+
+	if errs, ok := features.Errors.Exists(); ok {
+		for _, e := range errs {
+			message := ""
+			if e.FeatureErrors != nil {
+				message = e.FeatureErrors.Error()
+			}
+
+			exported.Errors = append(exported.Errors, FeatureError{
+				Feature: e.Feature,
+				Error:   message,
+			})
+		}
+	}
+
+	return exported
+}
+
+// wrapError wraps the provided error with the error location, address and message.
+func wrapError(err error, errorAt string, address bluetooth.MacAddress, message string) error {
+	return fault.Wrap(err,
+		fctx.With(context.Background(),
+			"error_at", errorAt,
+			"address", address.String(),
+		),
+		ftag.With(ftag.Internal),
+		fmsg.With(message),
+	)
+}
@@ -0,0 +1,242 @@
+package snapshot
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	ac "github.com/bluetuith-org/api-native/api/appfeatures"
+	"github.com/bluetuith-org/api-native/api/bluetooth"
+	"github.com/bluetuith-org/api-native/api/config"
+	"github.com/bluetuith-org/api-native/api/errorkinds"
+	"github.com/bluetuith-org/api-native/api/eventbus"
+	"github.com/bluetuith-org/api-native/platform"
+	"github.com/bluetuith-org/api-native/platform/simulated"
+	"github.com/google/uuid"
+)
+
+// startSession starts the provided session with its own event emitter,
+// and stops it when the test ends.
+func startSession(t *testing.T, session bluetooth.Session) ac.FeatureSet {
+	t.Helper()
+
+	cfg := config.New()
+	cfg.EventEmitter = eventbus.NewEmitter(nil)
+
+	features, err := session.Start(nil, cfg)
+	if err != nil {
+		t.Fatalf("Cannot start session: %v", err)
+	}
+	t.Cleanup(func() { _ = session.Stop() })
+
+	return features
+}
+
+// sessionState returns the adapters of a session, and the devices of each adapter,
+// sorted by their addresses.
+func sessionState(t *testing.T, session bluetooth.Session) ([]bluetooth.AdapterData, map[bluetooth.MacAddress][]bluetooth.DeviceData) {
+	t.Helper()
+
+	byAddress := func(a, b bluetooth.MacAddress) int {
+		return cmp.Compare(a.String(), b.String())
+	}
+
+	adapters := session.Adapters()
+	slices.SortFunc(adapters, func(a, b bluetooth.AdapterData) int { return byAddress(a.Address, b.Address) })
+
+	devices := make(map[bluetooth.MacAddress][]bluetooth.DeviceData, len(adapters))
+	for _, adapter := range adapters {
+		adapterDevices, err := session.Adapter(adapter.Address).Devices()
+		if err != nil {
+			t.Fatalf("Devices() of %s returned error: %v", adapter.Address, err)
+		}
+
+		slices.SortFunc(adapterDevices, func(a, b bluetooth.DeviceData) int { return byAddress(a.Address, b.Address) })
+		devices[adapter.Address] = adapterDevices
+	}
+
+	return adapters, devices
+}
+
+func TestRoundTrip(t *testing.T) {
+	source := simulated.NewSession(simulated.DefaultConfig())
+	features := startSession(t, source)
+
+	// The headphones are connected, so that their media player is captured.
+	headphones := simulated.DefaultConfig().Adapters[0].Devices[0].Address
+	if err := source.Device(headphones).Connect(); err != nil {
+		t.Fatalf("Connect() returned error: %v", err)
+	}
+
+	info := platform.PlatformInfo{OS: "linux (amd64)", Stack: "simulated"}
+
+	captured, err := Capture(source, info, features)
+	if err != nil {
+		t.Fatalf("Capture() returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := captured.Encode(&buf); err != nil {
+		t.Fatalf("Encode() returned error: %v", err)
+	}
+
+	decoded, err := Decode(&buf)
+	if err != nil {
+		t.Fatalf("Decode() returned error: %v", err)
+	}
+
+	replay := NewSession(decoded)
+	replayFeatures := startSession(t, replay)
+
+	if replayFeatures.Supported != features.Supported {
+		t.Errorf("Replayed features = %v, want %v", replayFeatures.Supported, features.Supported)
+	}
+
+	if replay.PlatformInfo() != info {
+		t.Errorf("PlatformInfo() = %+v, want %+v", replay.PlatformInfo(), info)
+	}
+
+	wantAdapters, wantDevices := sessionState(t, source)
+	gotAdapters, gotDevices := sessionState(t, replay)
+
+	if !reflect.DeepEqual(gotAdapters, wantAdapters) {
+		t.Errorf("Adapters() = %+v, want %+v", gotAdapters, wantAdapters)
+	}
+
+	if !reflect.DeepEqual(gotDevices, wantDevices) {
+		t.Errorf("Devices() = %+v, want %+v", gotDevices, wantDevices)
+	}
+
+	wantMedia, err := source.MediaPlayer(headphones).Properties()
+	if err != nil {
+		t.Fatalf("MediaPlayer().Properties() of the source session returned error: %v", err)
+	}
+
+	gotMedia, err := replay.MediaPlayer(headphones).Properties()
+	if err != nil || gotMedia.TrackData != wantMedia.TrackData || gotMedia.Status != wantMedia.Status {
+		t.Errorf("MediaPlayer().Properties() = %+v, %v, want %+v", gotMedia, err, wantMedia)
+	}
+}
+
+func TestDecodeDocument(t *testing.T) {
+	const document = `{
+  "version": 1,
+  "created_at": "2026-01-02T03:04:05Z",
+  "platform": {"os": "linux (amd64)", "bluetooth_stack": "bluez"},
+  "features": {
+    "supported": 1,
+    "errors": [{"feature": 2, "error": "pairing unavailable"}]
+  },
+  "adapters": [
+    {
+      "address": "00:11:22:33:44:55",
+      "devices": [{"address": "AA:BB:CC:DD:EE:01"}]
+    }
+  ]
+}`
+
+	snapshot, err := Decode(strings.NewReader(document))
+	if err != nil {
+		t.Fatalf("Decode() returned error: %v", err)
+	}
+
+	if snapshot.Version != 1 || !snapshot.CreatedAt.Equal(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("Decode() = version %d created at %s, want version 1 created at 2026-01-02T03:04:05Z",
+			snapshot.Version, snapshot.CreatedAt,
+		)
+	}
+
+	wantFeatures := Features{
+		Supported: 1,
+		Errors:    []FeatureError{{Feature: 2, Error: "pairing unavailable"}},
+	}
+	if !reflect.DeepEqual(snapshot.Features, wantFeatures) {
+		t.Errorf("Features = %+v, want %+v", snapshot.Features, wantFeatures)
+	}
+
+	if len(snapshot.Adapters) != 1 || len(snapshot.Adapters[0].Devices) != 1 ||
+		snapshot.Adapters[0].Devices[0].Address.String() != "AA:BB:CC:DD:EE:01" {
+		t.Errorf("Adapters = %+v, want one adapter with device AA:BB:CC:DD:EE:01", snapshot.Adapters)
+	}
+
+	var buf bytes.Buffer
+	if err := snapshot.Encode(&buf); err != nil {
+		t.Fatalf("Encode() returned error: %v", err)
+	}
+
+	for _, key := range []string{`"version"`, `"created_at"`, `"platform"`, `"features"`, `"supported"`, `"errors"`, `"adapters"`, `"devices"`} {
+		if !strings.Contains(buf.String(), key) {
+			t.Errorf("Encoded snapshot does not contain the %s key:\n%s", key, buf.String())
+		}
+	}
+
+	for _, version := range []string{`{"version": 0}`, `{"version": 2}`} {
+		if _, err := Decode(strings.NewReader(version)); err == nil {
+			t.Errorf("Decode(%s) returned no error", version)
+		}
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	adapterAddress, _ := bluetooth.ParseMAC("00:11:22:33:44:55")
+	deviceAddress, _ := bluetooth.ParseMAC("AA:BB:CC:DD:EE:01")
+
+	adapterData := bluetooth.AdapterData{}
+	adapterData.Address = adapterAddress
+
+	deviceData := bluetooth.DeviceData{}
+	deviceData.Address = deviceAddress
+
+	session := NewSession(Snapshot{
+		Version: Version,
+		Adapters: []Adapter{{
+			AdapterData: adapterData,
+			Devices:     []Device{{DeviceData: deviceData}},
+		}},
+	})
+	startSession(t, session)
+
+	adapter := session.Adapter(adapterAddress)
+	device := session.Device(deviceAddress)
+
+	calls := map[string]func() error{
+		"Adapter.StartDiscovery":    adapter.StartDiscovery,
+		"Adapter.SetPoweredState":   func() error { return adapter.SetPoweredState(true) },
+		"Adapter.SetAlias":          func() error { return adapter.SetAlias("alias") },
+		"Adapter.ConnectDevice":     func() error { return adapter.ConnectDevice(deviceAddress, bluetooth.AddressTypeBREDR) },
+		"Adapter.UnblockAndPowerOn": adapter.UnblockAndPowerOn,
+		"Adapter.Discover": func() error {
+			_, err := adapter.Discover(context.Background(), bluetooth.DiscoveryOptions{})
+			return err
+		},
+		"Device.Pair":           device.Pair,
+		"Device.Connect":        device.Connect,
+		"Device.Disconnect":     device.Disconnect,
+		"Device.Remove":         device.Remove,
+		"Device.SetTrusted":     func() error { return device.SetTrusted(true) },
+		"Device.SetAlias":       func() error { return device.SetAlias("alias") },
+		"Device.ConnectProfile": func() error { return device.ConnectProfile(uuid.Nil) },
+		"MediaPlayer.Play":      session.MediaPlayer(deviceAddress).Play,
+		"Network.Disconnect":    session.Network(deviceAddress).Disconnect,
+		"Obex.SendFile": func() error {
+			_, err := session.Obex(deviceAddress).FileTransfer().SendFile("file")
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		if err := call(); !errors.Is(err, errorkinds.ErrSessionReadOnly) {
+			t.Errorf("%s returned %v, want %v", name, err, errorkinds.ErrSessionReadOnly)
+		}
+	}
+
+	if _, err := device.Properties(); err != nil {
+		t.Errorf("Properties() returned error: %v", err)
+	}
+}